@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/coocood/freecache"
 	"github.com/jmoiron/sqlx"
@@ -11,14 +13,36 @@ import (
 
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/auth"
 	authStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/auth/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache"
 	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/config"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content"
+	contentStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	decksStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	experimentStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/export"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history"
+	historyStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/storage"
 	router "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/http/router"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports"
+	importsStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/paymenttransactions"
 	ptStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/paymenttransactions/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz"
+	quizStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/ratelimit"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews"
+	reviewsStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews/storage"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/srs"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions"
 	subscriptionStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage"
+	usageStorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage/storage"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
 	commonDb "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/db"
 	commonlogger "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/logger"
@@ -44,12 +68,32 @@ func main() {
 
 	// In bytes, where 1024 * 1024 represents a single Megabyte, and 100 * 1024*1024 represents 100 Megabytes.
 	cacheSize := 100 * 1024 * 1024
-	cache := freecache.NewCache(cacheSize)
+	localCache := freecache.NewCache(cacheSize)
 
-	openAiClient := openai.NewClient(cfg.OpenAIAPIKey, logger)
+	appCache, err := cache.NewProvider(cfg, localCache)
+	if err != nil {
+		logger.Sugar().Fatalf("failed to create cache: %v", err)
+	}
+
+	openAiClient, err := openai.NewProvider(cfg, logger)
+	if err != nil {
+		logger.Sugar().Fatalf("failed to create llm provider: %v", err)
+	}
+
+	// Count the tokens of every completion towards the quota of the user it was made for.
+	openAiClient = usage.NewMeteredClient(openAiClient)
+
+	prompts, err := prompt.NewRegistry()
+	if err != nil {
+		logger.Sugar().Fatalf("failed to load prompt templates: %v", err)
+	}
 
-	wordService := word.NewWordService(logger, openAiClient, cache) // todo: make a db to store words and sentences rather than a cache
-	sentenceService := sentence.NewSentenceService(logger, openAiClient, cache)
+	contentRepository := contentStorage.NewContentRepository(db)
+	contentStore := content.NewStore(logger, contentRepository, appCache)
+
+	wordService := word.NewWordService(logger, openAiClient, contentStore, prompts, cfg.LookupMode)
+	sentenceService := sentence.NewSentenceService(logger, openAiClient, contentStore, prompts)
+	languageDetector := langdetect.NewDetector(logger, openAiClient, contentStore, prompts)
 
 	userRepository := authStorage.NewUserRepository(db)
 	userService := auth.NewUserService(logger, userRepository, cfg.JwtSecret, cfg.StripeSecretKey)
@@ -68,6 +112,47 @@ func main() {
 		cfg.CheckoutSuccessURL,
 		cfg.CheckoutCancelURL,
 	)
+	// Every AI request checks the subscription, so it is read from memory for a little while.
+	subscriptionService = subscriptions.NewCachedSubscriptionService(subscriptionService, cfg.SubscriptionCacheTTL)
+
+	experimentRepository := experimentStorage.NewExperimentRepository(db)
+	experimentService := experiment.NewExperimentService(logger, experimentRepository)
+
+	usageRepository := usageStorage.NewUsageRepository(db)
+	usageService := usage.NewUsageService(logger, usageRepository, subscriptionService, usage.NewQuotas(cfg))
+
+	historyRepository := historyStorage.NewHistoryRepository(db)
+	historyService := history.NewHistoryService(logger, historyRepository)
+
+	deckRepository := decksStorage.NewDeckRepository(db)
+	deckService := decks.NewDeckService(logger, deckRepository, wordService)
+
+	scheduler, err := srs.NewScheduler(cfg.ReviewScheduler)
+	if err != nil {
+		logger.Sugar().Fatalf("failed to create review scheduler: %v", err)
+	}
+
+	reviewRepository := reviewsStorage.NewReviewRepository(db)
+	reviewService := reviews.NewReviewService(logger, reviewRepository, scheduler, time.Now)
+
+	quizRepository := quizStorage.NewQuizRepository(db)
+	quizService := quiz.NewQuizService(logger, quizRepository, openAiClient, prompts, wordService, reviewService)
+
+	exportService := export.NewExportService(logger, deckService, time.Now)
+
+	importRepository := importsStorage.NewImportRepository(db)
+	importService := imports.NewImportService(logger, importRepository, deckService, usageService)
+
+	if err = importService.FailInterrupted(context.Background()); err != nil {
+		logger.Sugar().Errorf("failed to fail interrupted import jobs: %v", err)
+	}
+
+	rateLimitStore, err := ratelimit.NewProvider(cfg)
+	if err != nil {
+		logger.Sugar().Fatalf("failed to create rate limit store: %v", err)
+	}
+
+	freeTier := ratelimit.FreeTier(logger, rateLimitStore, subscriptionService, ratelimit.NewTiers(cfg), cfg.ClientIPHeader)
 
 	mux := router.New(
 		logger,
@@ -75,8 +160,19 @@ func main() {
 		sentenceService,
 		userService,
 		subscriptionService,
+		experimentService,
+		usageService,
+		historyService,
+		deckService,
+		reviewService,
+		quizService,
+		exportService,
+		importService,
+		languageDetector,
+		freeTier,
 		cfg.JwtSecret,
 		cfg.StripeWebhookSecret,
+		cfg.AdminUserIDs,
 	)
 
 	logger.Sugar().Infof("Server starting on port %s", cfg.Port)
@@ -0,0 +1,26 @@
+package apierror
+
+import (
+	"errors"
+	"net/http"
+
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/messages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+// Render writes the response for an error returned by a service, mapping known failures to their status code.
+func Render(w http.ResponseWriter, err error) {
+	status, message := Resolve(err)
+	render.Json(w, status, message)
+}
+
+// Resolve returns the status code and user facing message for an error returned by a service.
+func Resolve(err error) (int, string) {
+	switch {
+	case errors.Is(err, openaierrors.ErrProviderUnavailable):
+		return http.StatusServiceUnavailable, messages.AIProviderUnavailableMsg
+	default:
+		return http.StatusInternalServerError, messages.InternalServerErrorMsg
+	}
+}
@@ -0,0 +1,81 @@
+package apierror
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/validation"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+// ValidationResponse is the body of a 400 for input a service refused.
+type ValidationResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+//go:embed translations/*.yaml
+var translationFiles embed.FS
+
+// translations holds the translated messages by language code, then error code. Each file in translations is named
+// after the language code and maps error codes to messages. English is the message of the error itself.
+var translations = func() map[string]map[string]string {
+	files, err := translationFiles.ReadDir("translations")
+	if err != nil {
+		panic(err)
+	}
+
+	byLanguage := make(map[string]map[string]string, len(files))
+
+	for _, file := range files {
+		raw, err := translationFiles.ReadFile(path.Join("translations", file.Name()))
+		if err != nil {
+			panic(err)
+		}
+
+		var messages map[string]string
+		if err := yaml.Unmarshal(raw, &messages); err != nil {
+			panic(fmt.Errorf("invalid translations %s: %w", file.Name(), err))
+		}
+
+		byLanguage[strings.TrimSuffix(file.Name(), path.Ext(file.Name()))] = messages
+	}
+
+	return byLanguage
+}()
+
+// RenderValidation writes a 400 with the code and message of err, a *validation.Error, with the message in
+// nativeLanguage when it has been translated. Other errors are rendered by Render.
+func RenderValidation(w http.ResponseWriter, err error, nativeLanguage string) {
+	var validationErr *validation.Error
+	if !errors.As(err, &validationErr) {
+		Render(w, err)
+		return
+	}
+
+	render.Json(w, http.StatusBadRequest, ValidationResponse{
+		Code:    validationErr.Code,
+		Message: Translate(validationErr, nativeLanguage),
+	})
+}
+
+// Translate returns the message of err in nativeLanguage, falling back to English.
+func Translate(err *validation.Error, nativeLanguage string) string {
+	language, ok := languages.Find(nativeLanguage)
+	if !ok {
+		return err.Error()
+	}
+
+	if message, ok := translations[language.Code][err.Code]; ok {
+		return message
+	}
+
+	return err.Error()
+}
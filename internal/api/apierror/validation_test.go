@@ -0,0 +1,91 @@
+package apierror_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/apierror"
+	sentencehandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/sentence"
+	wordhandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/validation"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/messages"
+)
+
+var validationErrors = []*validation.Error{
+	wordhandler.ErrInvalidRequest,
+	sentencehandler.ErrInvalidRequest,
+	languages.ErrUnsupported,
+	word.ErrEmptyWord,
+	word.ErrWordContainsDigits,
+	word.ErrWordTooLong,
+	word.ErrLooksLikePhrase,
+	word.ErrNonsensicalWord,
+	sentence.ErrEmptySentence,
+	sentence.ErrSentenceTooLong,
+	sentence.ErrInvalidLevel,
+}
+
+func TestEveryErrorIsTranslated(t *testing.T) {
+	for _, nativeLanguage := range []string{"Spanish", "French", "German", "Italian", "Portuguese", "Japanese", "Korean", "Chinese"} {
+		for _, err := range validationErrors {
+			assert.NotEqual(t, err.Error(), apierror.Translate(err, nativeLanguage), "%s in %s", err.Code, nativeLanguage)
+		}
+	}
+}
+
+func TestRenderValidation(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		nativeLanguage string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "translated",
+			err:            fmt.Errorf("%w: %q", sentence.ErrInvalidLevel, "D1"),
+			nativeLanguage: "ja",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"code":"invalid_level","message":"A1からC2のレベルを選んでください。"}`,
+		},
+		{
+			name:           "without a translation",
+			err:            word.ErrEmptyWord,
+			nativeLanguage: "Swahili",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"code":"empty_word","message":"Please provide a word."}`,
+		},
+		{
+			name:           "unsupported language",
+			err:            word.ErrEmptyWord,
+			nativeLanguage: "Klingon",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"code":"empty_word","message":"Please provide a word."}`,
+		},
+		{
+			name:           "not a validation error",
+			err:            errors.New("connection refused"),
+			nativeLanguage: "French",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   fmt.Sprintf("%q", messages.InternalServerErrorMsg),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			apierror.RenderValidation(rec, tc.err, tc.nativeLanguage)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.JSONEq(t, tc.expectedBody, rec.Body.String())
+		})
+	}
+}
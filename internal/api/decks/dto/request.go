@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+)
+
+// DeckRequest creates or renames a deck.
+type DeckRequest struct {
+	Name string `json:"name"`
+}
+
+func (dr DeckRequest) Validate() error {
+	return nil
+}
+
+type AddEntryRequest struct {
+	Word           string `json:"word"`
+	NativeLanguage string `json:"nativeLanguage" validate:"language"`
+}
+
+func (ar AddEntryRequest) Validate() error {
+	return languages.ValidateStruct(ar)
+}
+
+type ReorderEntriesRequest struct {
+	// EntryIDs lists every entry of the deck in its new order.
+	EntryIDs []string `json:"entryIds" validate:"required,dive,uuid"`
+}
+
+func (rr ReorderEntriesRequest) Validate() error {
+	return validator.New().Struct(rr)
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"time"
+
+	worddto "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word/dto"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word/dto/mapper"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/domain"
+)
+
+// DeckSummaryResponse is a deck in the list of decks.
+type DeckSummaryResponse struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	EntryCount int       `json:"entryCount"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+type DeckResponse struct {
+	DeckSummaryResponse
+	Entries []EntryResponse `json:"entries"`
+}
+
+// EntryResponse is a saved word, with the sections of its lookup as they were when it was saved.
+type EntryResponse struct {
+	ID             string                      `json:"id"`
+	Word           string                      `json:"word"`
+	NativeLanguage string                      `json:"nativeLanguage"`
+	Position       int                         `json:"position"`
+	Definition     *worddto.DefinitionResponse `json:"definition"`
+	Synonyms       []worddto.SynonymResponse   `json:"synonyms"`
+	History        *worddto.HistoryResponse    `json:"history"`
+	Difficulty     *worddto.DifficultyResponse `json:"difficulty,omitempty"`
+	SavedAt        time.Time                   `json:"savedAt"`
+}
+
+func ToDeckSummaryResponses(decks []domain.Deck) []DeckSummaryResponse {
+	responses := make([]DeckSummaryResponse, 0, len(decks))
+	for _, deck := range decks {
+		responses = append(responses, ToDeckSummaryResponse(deck))
+	}
+
+	return responses
+}
+
+func ToDeckSummaryResponse(deck domain.Deck) DeckSummaryResponse {
+	return DeckSummaryResponse{
+		ID:         deck.ID,
+		Name:       deck.Name,
+		EntryCount: deck.EntryCount,
+		CreatedAt:  deck.CreatedAt,
+		UpdatedAt:  deck.UpdatedAt,
+	}
+}
+
+func ToDeckResponse(deck *domain.Deck) DeckResponse {
+	return DeckResponse{
+		DeckSummaryResponse: ToDeckSummaryResponse(*deck),
+		Entries:             ToEntryResponses(deck.Entries),
+	}
+}
+
+func ToEntryResponses(entries []domain.Entry) []EntryResponse {
+	responses := make([]EntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		responses = append(responses, ToEntryResponse(entry))
+	}
+
+	return responses
+}
+
+func ToEntryResponse(entry domain.Entry) EntryResponse {
+	lookup := mapper.MapToLookUpResponse(&entry.Details)
+
+	return EntryResponse{
+		ID:             entry.ID,
+		Word:           entry.Word,
+		NativeLanguage: entry.NativeLanguage,
+		Position:       entry.Position,
+		Definition:     lookup.Definition,
+		Synonyms:       lookup.Synonyms,
+		History:        lookup.History,
+		Difficulty:     lookup.Difficulty,
+		SavedAt:        entry.CreatedAt,
+	}
+}
@@ -0,0 +1,292 @@
+package decks
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/apierror"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/decks/dto"
+	wordhandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/validation"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/request"
+)
+
+type Handler interface {
+	ListDecks() http.HandlerFunc
+	GetDeck() http.HandlerFunc
+	CreateDeck() http.HandlerFunc
+	RenameDeck() http.HandlerFunc
+	DeleteDeck() http.HandlerFunc
+	AddEntry() http.HandlerFunc
+	RemoveEntry() http.HandlerFunc
+	ReorderEntries() http.HandlerFunc
+}
+
+type handler struct {
+	logger  *zap.Logger
+	service decks.Service
+}
+
+func NewDecksHandler(
+	logger *zap.Logger,
+	service decks.Service,
+) Handler {
+	return &handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+const (
+	deckNotFoundMsg  = "Deck not found"
+	entryNotFoundMsg = "Deck entry not found"
+	invalidNameMsg   = "Please give the deck a name of at most 100 characters"
+	nameTakenMsg     = "You already have a deck with that name"
+	invalidOrderMsg  = "Please list every entry of the deck once"
+)
+
+// userID writes a 401 and returns false when the request has no user.
+func (h *handler) userID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID, err := context.GetUserIDString(r.Context())
+	if err != nil {
+		h.logger.Sugar().Errorw("user ID not found in session", "error", err)
+		render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+		return "", false
+	}
+
+	return userID, true
+}
+
+// renderError writes the response for an error returned by the service.
+func (h *handler) renderError(w http.ResponseWriter, err error, message string, keysAndValues ...any) {
+	switch {
+	case errors.Is(err, decks.ErrDeckNotFound):
+		render.Json(w, http.StatusNotFound, deckNotFoundMsg)
+	case errors.Is(err, decks.ErrEntryNotFound):
+		render.Json(w, http.StatusNotFound, entryNotFoundMsg)
+	case errors.Is(err, decks.ErrInvalidName):
+		render.Json(w, http.StatusBadRequest, invalidNameMsg)
+	case errors.Is(err, decks.ErrNameTaken):
+		render.Json(w, http.StatusConflict, nameTakenMsg)
+	default:
+		h.logger.Sugar().Errorw(message, append([]any{"error", err}, keysAndValues...)...)
+		apierror.Render(w, err)
+	}
+}
+
+func (h *handler) ListDecks() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		result, err := h.service.ListDecks(r.Context(), userID)
+		if err != nil {
+			h.renderError(w, err, "failed to list decks", "userID", userID)
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToDeckSummaryResponses(result))
+	}
+}
+
+// GetDeck returns a deck with its entries in order.
+func (h *handler) GetDeck() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deckID := chi.URLParam(r, "deckID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		deck, err := h.service.GetDeck(r.Context(), userID, deckID)
+		if err != nil {
+			h.renderError(w, err, "failed to get deck", "userID", userID, "deckID", deckID)
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToDeckResponse(deck))
+	}
+}
+
+func (h *handler) CreateDeck() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		var requestBody dto.DeckRequest
+
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate deck request body",
+				"error", err)
+			render.Json(w, http.StatusBadRequest, invalidNameMsg)
+
+			return
+		}
+
+		deck, err := h.service.CreateDeck(r.Context(), userID, requestBody.Name)
+		if err != nil {
+			h.renderError(w, err, "failed to create deck", "userID", userID)
+			return
+		}
+
+		render.Json(w, http.StatusCreated, dto.ToDeckSummaryResponse(*deck))
+	}
+}
+
+func (h *handler) RenameDeck() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deckID := chi.URLParam(r, "deckID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		var requestBody dto.DeckRequest
+
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate deck request body",
+				"error", err)
+			render.Json(w, http.StatusBadRequest, invalidNameMsg)
+
+			return
+		}
+
+		deck, err := h.service.RenameDeck(r.Context(), userID, deckID, requestBody.Name)
+		if err != nil {
+			h.renderError(w, err, "failed to rename deck", "userID", userID, "deckID", deckID)
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToDeckSummaryResponse(*deck))
+	}
+}
+
+// DeleteDeck deletes a deck with its entries.
+func (h *handler) DeleteDeck() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deckID := chi.URLParam(r, "deckID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		if err := h.service.DeleteDeck(r.Context(), userID, deckID); err != nil {
+			h.renderError(w, err, "failed to delete deck", "userID", userID, "deckID", deckID)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// AddEntry looks a word up and saves it with its lookup at the end of a deck.
+func (h *handler) AddEntry() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deckID := chi.URLParam(r, "deckID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		var requestBody dto.AddEntryRequest
+
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate deck entry request body",
+				"error", err)
+
+			if languages.IsUnsupported(err) {
+				apierror.RenderValidation(w, languages.ErrUnsupported, requestBody.NativeLanguage)
+				return
+			}
+
+			apierror.RenderValidation(w, wordhandler.ErrInvalidRequest, requestBody.NativeLanguage)
+
+			return
+		}
+
+		entry, err := h.service.AddEntry(r.Context(), userID, deckID, requestBody.Word, requestBody.NativeLanguage)
+
+		var validationErr *validation.Error
+
+		switch {
+		case errors.As(err, &validationErr):
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
+		case errors.Is(err, decks.ErrEntryExists):
+			render.Json(w, http.StatusConflict, "This word is already in the deck")
+		case err != nil:
+			h.renderError(w, err, "failed to add deck entry", "userID", userID, "deckID", deckID)
+		default:
+			render.Json(w, http.StatusCreated, dto.ToEntryResponse(*entry))
+		}
+	}
+}
+
+func (h *handler) RemoveEntry() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deckID := chi.URLParam(r, "deckID")
+		entryID := chi.URLParam(r, "entryID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		if err := h.service.RemoveEntry(r.Context(), userID, deckID, entryID); err != nil {
+			h.renderError(w, err, "failed to remove deck entry", "userID", userID, "deckID", deckID, "entryID", entryID)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// ReorderEntries puts the entries of a deck in a new order and returns them in it.
+func (h *handler) ReorderEntries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deckID := chi.URLParam(r, "deckID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		var requestBody dto.ReorderEntriesRequest
+
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate deck order request body",
+				"error", err)
+			render.Json(w, http.StatusBadRequest, invalidOrderMsg)
+
+			return
+		}
+
+		entries, err := h.service.ReorderEntries(r.Context(), userID, deckID, requestBody.EntryIDs)
+		if errors.Is(err, decks.ErrInvalidOrder) {
+			render.Json(w, http.StatusBadRequest, invalidOrderMsg)
+			return
+		}
+
+		if err != nil {
+			h.renderError(w, err, "failed to reorder deck", "userID", userID, "deckID", deckID)
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToEntryResponses(entries))
+	}
+}
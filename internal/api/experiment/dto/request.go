@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/go-playground/validator/v10"
+
+type FeedbackRequest struct {
+	// Helpful is true for a thumbs up and false for a thumbs down.
+	Helpful *bool `json:"helpful" validate:"required"`
+}
+
+func (fr FeedbackRequest) Validate() error {
+	return validator.New().Struct(fr)
+}
@@ -0,0 +1,39 @@
+package dto
+
+import "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/domain"
+
+type ResultsResponse struct {
+	Experiment string            `json:"experiment"`
+	Variants   []VariantResponse `json:"variants"`
+}
+
+type VariantResponse struct {
+	Variant          string `json:"variant"`
+	Responses        int    `json:"responses"`
+	PromptTokens     int    `json:"promptTokens"`
+	CompletionTokens int    `json:"completionTokens"`
+	TotalTokens      int    `json:"totalTokens"`
+	ThumbsUp         int    `json:"thumbsUp"`
+	ThumbsDown       int    `json:"thumbsDown"`
+}
+
+func ToResultsResponse(experiment string, results []domain.VariantResult) ResultsResponse {
+	response := ResultsResponse{
+		Experiment: experiment,
+		Variants:   make([]VariantResponse, 0, len(results)),
+	}
+
+	for _, result := range results {
+		response.Variants = append(response.Variants, VariantResponse{
+			Variant:          result.Variant,
+			Responses:        result.Responses,
+			PromptTokens:     result.PromptTokens,
+			CompletionTokens: result.CompletionTokens,
+			TotalTokens:      result.TotalTokens,
+			ThumbsUp:         result.ThumbsUp,
+			ThumbsDown:       result.ThumbsDown,
+		})
+	}
+
+	return response
+}
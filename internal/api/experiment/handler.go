@@ -0,0 +1,105 @@
+package experiment
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/experiment/dto"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/ratelimit"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/request"
+)
+
+type Handler interface {
+	Feedback() http.HandlerFunc
+	Results() http.HandlerFunc
+}
+
+type handler struct {
+	logger  *zap.Logger
+	service experiment.Service
+}
+
+func NewExperimentHandler(
+	logger *zap.Logger,
+	service experiment.Service,
+) Handler {
+	return &handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// Feedback records a thumbs up or down on a response listed in the experiment.ResponseIDHeader. Anonymous callers
+// are recognised by the ratelimit.DeviceIDHeader they were served the response with.
+func (h *handler) Feedback() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		responseID := chi.URLParam(r, "responseID")
+
+		var requestBody dto.FeedbackRequest
+
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate feedback request body",
+				"error", err)
+
+			render.Json(w, http.StatusBadRequest, "Please say whether the response was helpful")
+
+			return
+		}
+
+		rater := domain.Rater{SessionID: r.Header.Get(ratelimit.DeviceIDHeader)}
+
+		if userID, err := commonContext.GetUserIDString(ctx); err == nil {
+			rater.UserID = userID
+		}
+
+		err := h.service.Feedback(ctx, responseID, rater, *requestBody.Helpful)
+		if errors.Is(err, experiment.ErrResponseNotFound) {
+			render.Json(w, http.StatusNotFound, "Response not found")
+			return
+		}
+
+		if errors.Is(err, experiment.ErrAlreadyRated) {
+			render.Json(w, http.StatusConflict, "This response has already been rated")
+			return
+		}
+
+		if err != nil {
+			h.logger.Sugar().Errorw("failed to record feedback",
+				"error", err,
+				"responseID", responseID)
+			render.Json(w, http.StatusInternalServerError, "Unable to record feedback")
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// Results returns the responses, token usage and feedback of every variant of an experiment.
+func (h *handler) Results() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		experimentID := chi.URLParam(r, "experimentID")
+
+		results, err := h.service.Results(ctx, experimentID)
+		if err != nil {
+			h.logger.Sugar().Errorw("failed to get experiment results",
+				"error", err,
+				"experiment", experimentID)
+			render.Json(w, http.StatusInternalServerError, "Unable to get experiment results")
+
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToResultsResponse(experimentID, results))
+	}
+}
@@ -0,0 +1,172 @@
+package experiment_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/coocood/freecache"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	experimenthandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/experiment"
+	wordhandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache"
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	mockopenai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	mockstorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/storage/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
+	langdetectmock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/ratelimit"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
+)
+
+// newShippedRegistry returns a Registry of the shipped definition experiment, enabled.
+func newShippedRegistry(t *testing.T) prompt.Registry {
+	t.Helper()
+
+	files := fstest.MapFS{}
+
+	for _, name := range []string{
+		"templates/word_lookup_definition.yaml",
+		"templates/word_lookup_definition_concise.yaml",
+		"experiments/word_lookup_definition_concise.yaml",
+	} {
+		raw, err := os.ReadFile("../../prompt/" + name)
+		require.NoError(t, err)
+
+		files[name] = &fstest.MapFile{Data: bytes.Replace(raw, []byte("enabled: false"), []byte("enabled: true"), 1)}
+	}
+
+	registry, err := prompt.NewRegistryFromFS(files)
+	require.NoError(t, err)
+
+	return registry
+}
+
+// newResponseRepository returns an ExperimentRepository keeping responses in memory.
+func newResponseRepository(ctrl *gomock.Controller) (*mockstorage.MockExperimentRepository, map[string]*entity.ExperimentResponse) {
+	var mu sync.Mutex
+
+	responses := map[string]*entity.ExperimentResponse{}
+	repository := mockstorage.NewMockExperimentRepository(ctrl)
+
+	repository.EXPECT().InsertResponse(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, response *entity.ExperimentResponse) (*entity.ExperimentResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			response.ID = uuid.NewString()
+			responses[response.ID] = response
+
+			return response, nil
+		})
+	repository.EXPECT().GetResponse(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, responseID string) (*entity.ExperimentResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			response, ok := responses[responseID]
+			if !ok {
+				return nil, sql.ErrNoRows
+			}
+
+			stored := *response
+
+			return &stored, nil
+		})
+	repository.EXPECT().RateResponse(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, responseID string, rating int16) (bool, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			response, ok := responses[responseID]
+			if !ok || response.Rating.Valid {
+				return false, nil
+			}
+
+			response.Rating = null.Int16From(rating)
+
+			return true, nil
+		})
+
+	return repository, responses
+}
+
+func TestFeedbackOnAnExperimentResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := zaptest.NewLogger(t)
+
+	repository, responses := newResponseRepository(ctrl)
+	experimentService := experiment.NewExperimentService(logger, repository)
+
+	mockOpenAiClient := mockopenai.NewMockClient(ctrl)
+	mockOpenAiClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Times(1).Return(&openai.ChatCompletion{
+		Choices: []openai.Choice{{Message: openai.Message{
+			Role:    "assistant",
+			Content: `{"readings":[],"ipa":"kæt","partOfSpeech":"noun","senses":[{"gloss":"a small feline","notes":""}],"examples":[]}`,
+		}}},
+	}, nil)
+
+	detector := langdetectmock.NewMockDetector(ctrl)
+	detector.EXPECT().Guess(gomock.Any(), gomock.Any()).
+		Return(&langdetect.Detection{Language: "en", Confidence: 0.97, Method: langdetect.MethodWords}).AnyTimes()
+	detector.EXPECT().Detect(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&langdetect.Detection{Language: "en", Confidence: 0.97, Method: langdetect.MethodModel}, nil).AnyTimes()
+
+	store := content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1*1024*1024)))
+	wordService := word.NewWordService(logger, mockOpenAiClient, store, newShippedRegistry(t), word.LookupModeSingle)
+
+	r := chi.NewRouter()
+	r.Use(experiment.Track(logger, experimentService))
+	r.Post("/api/v4/word/definition", wordhandler.NewWordHandler(logger, wordService, detector).DefineWord())
+	r.Post("/api/v4/experiments/responses/{responseID}/feedback", experimenthandler.NewExperimentHandler(logger, experimentService).Feedback())
+
+	send := func(path, body, deviceID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set(ratelimit.DeviceIDHeader, deviceID)
+		rec := httptest.NewRecorder()
+
+		r.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := send("/api/v4/word/definition", `{"word":"cat","nativeLanguage":"english"}`, "device-1")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	responseID := rec.Header().Get(experiment.ResponseIDHeader)
+	require.Contains(t, responses, responseID)
+	assert.Equal(t, "word_lookup_definition_concise", responses[responseID].Experiment)
+	assert.Contains(t, []string{"control", "concise"}, responses[responseID].Variant)
+	assert.Equal(t, null.StringFrom("device-1"), responses[responseID].SessionID)
+
+	feedback := "/api/v4/experiments/responses/" + responseID + "/feedback"
+
+	rec = send(feedback, `{"helpful":true}`, "device-2")
+	assert.Equal(t, http.StatusNotFound, rec.Code, "only the device served the response can rate it")
+
+	rec = send(feedback, `{"helpful":true}`, "device-1")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, null.Int16From(1), responses[responseID].Rating)
+
+	rec = send(feedback, `{"helpful":false}`, "device-1")
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, null.Int16From(1), responses[responseID].Rating)
+}
@@ -0,0 +1,80 @@
+package export
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/apierror"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/export"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/export/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+type Handler interface {
+	Export() http.HandlerFunc
+}
+
+type handler struct {
+	logger  *zap.Logger
+	service export.Service
+}
+
+func NewExportHandler(
+	logger *zap.Logger,
+	service export.Service,
+) Handler {
+	return &handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// Export downloads the words of a deck, or of every deck when the route has no deck ID, as an attachment in the
+// format of the query: apkg, the default, csv or tsv.
+func (h *handler) Export() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deckID := chi.URLParam(r, "deckID")
+
+		userID, err := context.GetUserIDString(r.Context())
+		if err != nil {
+			h.logger.Sugar().Errorw("user ID not found in session", "error", err)
+			render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+			return
+		}
+
+		format := r.URL.Query().Get("format")
+		if format == "" {
+			format = domain.FormatAPKG
+		}
+
+		file, err := h.service.Export(r.Context(), userID, deckID, format)
+
+		switch {
+		case errors.Is(err, export.ErrInvalidFormat):
+			render.Json(w, http.StatusBadRequest, "Please choose a format among apkg, csv and tsv")
+		case errors.Is(err, decks.ErrDeckNotFound):
+			render.Json(w, http.StatusNotFound, "Deck not found")
+		case err != nil:
+			h.logger.Sugar().Errorw("failed to export words", "error", err, "userID", userID, "deckID", deckID)
+			apierror.Render(w, err)
+		default:
+			w.Header().Set("Content-Type", file.ContentType)
+			w.Header().Set("Content-Disposition",
+				mime.FormatMediaType("attachment", map[string]string{"filename": file.Name}))
+			w.Header().Set("Content-Length", strconv.Itoa(len(file.Content)))
+			w.WriteHeader(http.StatusOK)
+
+			if _, err = w.Write(file.Content); err != nil {
+				h.logger.Sugar().Warnw("failed to write export", "error", err, "userID", userID)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/domain"
+)
+
+type PageResponse struct {
+	Entries  []EntryResponse `json:"entries"`
+	Page     int             `json:"page"`
+	PageSize int             `json:"pageSize"`
+	Total    int             `json:"total"`
+}
+
+type EntryResponse struct {
+	ID             string `json:"id"`
+	Kind           string `json:"kind"`
+	Query          string `json:"query"`
+	NativeLanguage string `json:"nativeLanguage"`
+	// Result is the body the lookup was answered with, as it was sent.
+	Result    json.RawMessage `json:"result"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+func ToPageResponse(page *domain.Page) PageResponse {
+	response := PageResponse{
+		Entries:  make([]EntryResponse, 0, len(page.Entries)),
+		Page:     page.Page,
+		PageSize: page.PageSize,
+		Total:    page.Total,
+	}
+
+	for _, entry := range page.Entries {
+		response.Entries = append(response.Entries, ToEntryResponse(entry))
+	}
+
+	return response
+}
+
+func ToEntryResponse(entry domain.Entry) EntryResponse {
+	result := json.RawMessage(entry.Result)
+	if !json.Valid(result) {
+		result, _ = json.Marshal(entry.Result)
+	}
+
+	return EntryResponse{
+		ID:             entry.ID,
+		Kind:           entry.Kind,
+		Query:          entry.Query,
+		NativeLanguage: entry.NativeLanguage,
+		Result:         result,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
@@ -0,0 +1,183 @@
+package history
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/history/dto"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+type Handler interface {
+	List() http.HandlerFunc
+	Get() http.HandlerFunc
+	Delete() http.HandlerFunc
+	Clear() http.HandlerFunc
+}
+
+type handler struct {
+	logger  *zap.Logger
+	service history.Service
+}
+
+func NewHistoryHandler(
+	logger *zap.Logger,
+	service history.Service,
+) Handler {
+	return &handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// List returns a page of the history of the user, newest first. The query parameters are q, to search the words
+// and sentences, kind, e.g. "word" or "sentence_correction", page and pageSize.
+func (h *handler) List() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		userID, err := context.GetUserIDString(ctx)
+		if err != nil {
+			h.logger.Sugar().Errorw("user ID not found in session", "error", err)
+			render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+			return
+		}
+
+		query := r.URL.Query()
+		filter := domain.Filter{
+			Search: query.Get("q"),
+			Kind:   query.Get("kind"),
+		}
+
+		if filter.Page, err = intParam(query.Get("page")); err != nil {
+			render.Json(w, http.StatusBadRequest, "Please provide a valid page number")
+			return
+		}
+
+		if filter.PageSize, err = intParam(query.Get("pageSize")); err != nil {
+			render.Json(w, http.StatusBadRequest, "Please provide a valid page size")
+			return
+		}
+
+		page, err := h.service.List(ctx, userID, filter)
+		if err != nil {
+			h.logger.Sugar().Errorw("failed to list lookup history",
+				"error", err,
+				"userID", userID)
+			render.Json(w, http.StatusInternalServerError, "Unable to get your history")
+
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToPageResponse(page))
+	}
+}
+
+// intParam parses an optional integer query parameter, 0 when it is missing.
+func intParam(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(value)
+}
+
+func (h *handler) Get() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		entryID := chi.URLParam(r, "entryID")
+
+		userID, err := context.GetUserIDString(ctx)
+		if err != nil {
+			h.logger.Sugar().Errorw("user ID not found in session", "error", err)
+			render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+			return
+		}
+
+		entry, err := h.service.Get(ctx, userID, entryID)
+		if errors.Is(err, history.ErrEntryNotFound) {
+			render.Json(w, http.StatusNotFound, "History entry not found")
+			return
+		}
+
+		if err != nil {
+			h.logger.Sugar().Errorw("failed to get lookup history entry",
+				"error", err,
+				"userID", userID,
+				"entryID", entryID)
+			render.Json(w, http.StatusInternalServerError, "Unable to get your history")
+
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToEntryResponse(*entry))
+	}
+}
+
+func (h *handler) Delete() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		entryID := chi.URLParam(r, "entryID")
+
+		userID, err := context.GetUserIDString(ctx)
+		if err != nil {
+			h.logger.Sugar().Errorw("user ID not found in session", "error", err)
+			render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+			return
+		}
+
+		err = h.service.Delete(ctx, userID, entryID)
+		if errors.Is(err, history.ErrEntryNotFound) {
+			render.Json(w, http.StatusNotFound, "History entry not found")
+			return
+		}
+
+		if err != nil {
+			h.logger.Sugar().Errorw("failed to delete lookup history entry",
+				"error", err,
+				"userID", userID,
+				"entryID", entryID)
+			render.Json(w, http.StatusInternalServerError, "Unable to delete the history entry")
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// Clear deletes the whole history of the user.
+func (h *handler) Clear() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		userID, err := context.GetUserIDString(ctx)
+		if err != nil {
+			h.logger.Sugar().Errorw("user ID not found in session", "error", err)
+			render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+			return
+		}
+
+		if _, err := h.service.Clear(ctx, userID); err != nil {
+			h.logger.Sugar().Errorw("failed to clear lookup history",
+				"error", err,
+				"userID", userID)
+			render.Json(w, http.StatusInternalServerError, "Unable to clear your history")
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+
+// ImportRequest holds the query of an import. The word list is the body.
+type ImportRequest struct {
+	NativeLanguage string `validate:"language"`
+}
+
+func (ir ImportRequest) Validate() error {
+	return languages.ValidateStruct(ir)
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports/domain"
+)
+
+type JobResponse struct {
+	ID             string            `json:"id"`
+	DeckID         string            `json:"deckId"`
+	NativeLanguage string            `json:"nativeLanguage"`
+	Status         string            `json:"status"`
+	Total          int               `json:"total"`
+	Imported       int               `json:"imported"`
+	Skipped        int               `json:"skipped"`
+	Failed         int               `json:"failed"`
+	Failures       []FailureResponse `json:"failures"`
+	CreatedAt      time.Time         `json:"createdAt"`
+	FinishedAt     *time.Time        `json:"finishedAt"`
+}
+
+type FailureResponse struct {
+	Word    string `json:"word"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func ToJobResponse(job domain.Job) JobResponse {
+	response := JobResponse{
+		ID:             job.ID,
+		DeckID:         job.DeckID,
+		NativeLanguage: job.NativeLanguage,
+		Status:         job.Status,
+		Total:          job.Total,
+		Imported:       job.Imported,
+		Skipped:        job.Skipped,
+		Failed:         job.Failed,
+		Failures:       make([]FailureResponse, 0, len(job.Failures)),
+		CreatedAt:      job.CreatedAt,
+		FinishedAt:     job.FinishedAt,
+	}
+
+	for _, failure := range job.Failures {
+		response.Failures = append(response.Failures, FailureResponse{
+			Word:    failure.Word,
+			Code:    failure.Code,
+			Message: failure.Message,
+		})
+	}
+
+	return response
+}
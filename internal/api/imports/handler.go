@@ -0,0 +1,147 @@
+package imports
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/apierror"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/imports/dto"
+	wordhandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+// maxListBytes is the largest word list accepted, far above MaxWords words.
+const maxListBytes = 1 << 20
+
+type Handler interface {
+	Start() http.HandlerFunc
+	Get() http.HandlerFunc
+}
+
+type handler struct {
+	logger  *zap.Logger
+	service imports.Service
+}
+
+func NewImportsHandler(
+	logger *zap.Logger,
+	service imports.Service,
+) Handler {
+	return &handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+const invalidListMsg = "Please send a CSV or TSV list of at most 200 words"
+
+// userID writes a 401 and returns false when the request has no user.
+func (h *handler) userID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID, err := context.GetUserIDString(r.Context())
+	if err != nil {
+		h.logger.Sugar().Errorw("user ID not found in session", "error", err)
+		render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+		return "", false
+	}
+
+	return userID, true
+}
+
+// Start reads the word list of the body, a CSV file or a TSV one when its content type is
+// text/tab-separated-values, and adds its words to a deck in the background. It answers 202 with the job, which
+// reports its progress.
+func (h *handler) Start() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deckID := chi.URLParam(r, "deckID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		query := dto.ImportRequest{NativeLanguage: r.URL.Query().Get("nativeLanguage")}
+
+		if err := query.Validate(); err != nil {
+			h.logger.Sugar().Warnw("failed to validate import request", "error", err)
+
+			if languages.IsUnsupported(err) {
+				apierror.RenderValidation(w, languages.ErrUnsupported, query.NativeLanguage)
+				return
+			}
+
+			apierror.RenderValidation(w, wordhandler.ErrInvalidRequest, query.NativeLanguage)
+
+			return
+		}
+
+		comma := ','
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "text/tab-separated-values" {
+			comma = '\t'
+		}
+
+		words, err := imports.ReadWords(http.MaxBytesReader(w, r.Body, maxListBytes), comma)
+
+		var tooLarge *http.MaxBytesError
+
+		switch {
+		case errors.As(err, &tooLarge):
+			render.Json(w, http.StatusRequestEntityTooLarge, invalidListMsg)
+			return
+		case err != nil:
+			h.logger.Sugar().Warnw("failed to read word list", "error", err)
+			render.Json(w, http.StatusBadRequest, invalidListMsg)
+
+			return
+		}
+
+		job, err := h.service.Start(r.Context(), userID, deckID, query.NativeLanguage, words)
+
+		switch {
+		case errors.Is(err, imports.ErrNoWords), errors.Is(err, imports.ErrTooManyWords):
+			render.Json(w, http.StatusBadRequest, invalidListMsg)
+		case errors.Is(err, decks.ErrDeckNotFound):
+			render.Json(w, http.StatusNotFound, "Deck not found")
+		case errors.Is(err, imports.ErrJobRunning):
+			render.Json(w, http.StatusConflict, "Please wait for your import to finish before starting another")
+		case err != nil:
+			h.logger.Sugar().Errorw("failed to start import", "error", err, "userID", userID, "deckID", deckID)
+			apierror.Render(w, err)
+		default:
+			render.Json(w, http.StatusAccepted, dto.ToJobResponse(*job))
+		}
+	}
+}
+
+// Get returns the progress of an import, and the words that failed so far.
+func (h *handler) Get() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deckID := chi.URLParam(r, "deckID")
+		jobID := chi.URLParam(r, "jobID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		job, err := h.service.Get(r.Context(), userID, deckID, jobID)
+
+		switch {
+		case errors.Is(err, imports.ErrJobNotFound):
+			render.Json(w, http.StatusNotFound, "Import not found")
+		case err != nil:
+			h.logger.Sugar().Errorw("failed to get import", "error", err, "userID", userID, "jobID", jobID)
+			apierror.Render(w, err)
+		default:
+			render.Json(w, http.StatusOK, dto.ToJobResponse(*job))
+		}
+	}
+}
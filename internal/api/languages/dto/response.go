@@ -0,0 +1,30 @@
+package dto
+
+import "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+
+type LanguageResponse struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Endonym string `json:"endonym"`
+	Script  string `json:"script"`
+	RTL     bool   `json:"rtl"`
+	// Reading is "furigana", "pinyin" or "romanization", and empty for languages written in the latin alphabet.
+	Reading string `json:"reading,omitempty"`
+}
+
+func ToLanguageResponses(all []languages.Language) []LanguageResponse {
+	response := make([]LanguageResponse, 0, len(all))
+
+	for _, language := range all {
+		response = append(response, LanguageResponse{
+			Code:    language.Code,
+			Name:    language.Name,
+			Endonym: language.Endonym,
+			Script:  language.Script,
+			RTL:     language.RTL,
+			Reading: language.Reading,
+		})
+	}
+
+	return response
+}
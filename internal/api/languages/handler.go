@@ -0,0 +1,34 @@
+package languages
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/languages/dto"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+type Handler interface {
+	List() http.HandlerFunc
+}
+
+type handler struct {
+	logger *zap.Logger
+}
+
+func NewLanguagesHandler(logger *zap.Logger) Handler {
+	return &handler{
+		logger: logger,
+	}
+}
+
+// List returns the supported languages, for the native language dropdown. The catalogue only changes with a deploy,
+// so browsers may keep it for a day.
+func (h *handler) List() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=86400")
+		render.Json(w, http.StatusOK, dto.ToLanguageResponses(languages.All()))
+	}
+}
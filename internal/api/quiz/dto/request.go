@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+)
+
+type StartQuizRequest struct {
+	NativeLanguage string `json:"nativeLanguage" validate:"language"`
+	// DeckID restricts the quiz to the words of one deck.
+	DeckID string `json:"deckId" validate:"omitempty,uuid"`
+	// Size is the number of questions, 10 by default.
+	Size int `json:"size" validate:"gte=0"`
+	// Types restricts the questions to some types, e.g. ["cloze"].
+	Types []string `json:"types" validate:"dive,oneof=multiple_choice cloze translation"`
+}
+
+func (sr StartQuizRequest) Validate() error {
+	return languages.ValidateStruct(sr)
+}
+
+type SubmitAnswersRequest struct {
+	Answers []AnswerRequest `json:"answers" validate:"dive"`
+}
+
+// AnswerRequest is the option chosen for a question, both by index from 0.
+type AnswerRequest struct {
+	Question int `json:"question" validate:"gte=0"`
+	Option   int `json:"option" validate:"gte=0"`
+}
+
+func (sr SubmitAnswersRequest) Validate() error {
+	return validator.New().Struct(sr)
+}
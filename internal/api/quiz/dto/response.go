@@ -0,0 +1,114 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz/domain"
+)
+
+// QuizResponse is a quiz without its answers.
+type QuizResponse struct {
+	ID          string             `json:"id"`
+	Questions   []QuestionResponse `json:"questions"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	SubmittedAt *time.Time         `json:"submittedAt"`
+}
+
+type QuestionResponse struct {
+	Index int    `json:"index"`
+	Type  string `json:"type"`
+	// Word is left out of the cloze questions of a quiz not yet submitted, as it is the answer.
+	Word     string   `json:"word,omitempty"`
+	Prompt   string   `json:"prompt"`
+	Sentence string   `json:"sentence,omitempty"`
+	Options  []string `json:"options"`
+}
+
+// ScoreResponse is the score of a submitted quiz, overall, by type of question and by question.
+type ScoreResponse struct {
+	QuizID      string                   `json:"quizId"`
+	Correct     int                      `json:"correct"`
+	Total       int                      `json:"total"`
+	Percentage  int                      `json:"percentage"`
+	ByType      map[string]TallyResponse `json:"byType"`
+	Questions   []QuestionResultResponse `json:"questions"`
+	SubmittedAt *time.Time               `json:"submittedAt"`
+}
+
+type TallyResponse struct {
+	Correct int `json:"correct"`
+	Total   int `json:"total"`
+}
+
+type QuestionResultResponse struct {
+	QuestionResponse
+	// Chosen is the index of the chosen option, -1 when the question was not answered.
+	Chosen      int    `json:"chosen"`
+	Answer      int    `json:"answer"`
+	Correct     bool   `json:"correct"`
+	Explanation string `json:"explanation"`
+}
+
+func ToQuizResponse(quiz domain.Quiz) QuizResponse {
+	response := QuizResponse{
+		ID:          quiz.ID,
+		Questions:   make([]QuestionResponse, 0, len(quiz.Questions)),
+		CreatedAt:   quiz.CreatedAt,
+		SubmittedAt: quiz.SubmittedAt,
+	}
+
+	for i, question := range quiz.Questions {
+		response.Questions = append(response.Questions, toQuestionResponse(i, question, quiz.SubmittedAt != nil))
+	}
+
+	return response
+}
+
+func ToScoreResponse(result domain.Result) ScoreResponse {
+	response := ScoreResponse{
+		QuizID:      result.Quiz.ID,
+		Correct:     result.Total.Correct,
+		Total:       result.Total.Total,
+		ByType:      make(map[string]TallyResponse, len(result.ByType)),
+		Questions:   make([]QuestionResultResponse, 0, len(result.Quiz.Questions)),
+		SubmittedAt: result.Quiz.SubmittedAt,
+	}
+
+	if result.Total.Total > 0 {
+		response.Percentage = result.Total.Correct * 100 / result.Total.Total
+	}
+
+	for questionType, tally := range result.ByType {
+		response.ByType[questionType] = TallyResponse{Correct: tally.Correct, Total: tally.Total}
+	}
+
+	for i, question := range result.Quiz.Questions {
+		response.Questions = append(response.Questions, QuestionResultResponse{
+			QuestionResponse: toQuestionResponse(i, question, true),
+			Chosen:           result.Quiz.Answers[i],
+			Answer:           question.Answer,
+			Correct:          result.Quiz.Answers[i] == question.Answer,
+			Explanation:      question.Explanation,
+		})
+	}
+
+	return response
+}
+
+// toQuestionResponse returns the question, with its word unless it gives away the answer of a quiz not submitted.
+func toQuestionResponse(index int, question domain.Question, submitted bool) QuestionResponse {
+	response := QuestionResponse{
+		Index:    index,
+		Type:     question.Type,
+		Word:     question.Word,
+		Prompt:   question.Prompt,
+		Sentence: question.Sentence,
+		Options:  question.Options,
+	}
+
+	if question.Type == domain.TypeCloze && !submitted {
+		response.Word = ""
+	}
+
+	return response
+}
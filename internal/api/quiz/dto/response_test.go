@@ -0,0 +1,36 @@
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/quiz/dto"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz/domain"
+)
+
+func quiz() domain.Quiz {
+	return domain.Quiz{
+		ID: "quiz-1",
+		Questions: []domain.Question{
+			{Word: "猫", Type: domain.TypeMultipleChoice, Prompt: "What does 猫 mean?", Options: []string{"cat", "dog"}},
+			{Word: "犬", Type: domain.TypeCloze, Prompt: "Fill the blank.", Sentence: "___が吠えた。", Options: []string{"猫", "犬"}, Answer: 1},
+		},
+		Answers: []int{0, 1},
+	}
+}
+
+func TestQuizResponseHidesTheAnswerOfClozeQuestions(t *testing.T) {
+	response := dto.ToQuizResponse(quiz())
+
+	assert.Equal(t, "猫", response.Questions[0].Word)
+	assert.Empty(t, response.Questions[1].Word)
+
+	submitted := quiz()
+	submittedAt := time.Date(2025, 10, 17, 8, 0, 0, 0, time.UTC)
+	submitted.SubmittedAt = &submittedAt
+
+	assert.Equal(t, "犬", dto.ToQuizResponse(submitted).Questions[1].Word)
+	assert.Equal(t, "犬", dto.ToScoreResponse(domain.Result{Quiz: submitted}).Questions[1].Word)
+}
@@ -0,0 +1,203 @@
+package quiz
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/apierror"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/quiz/dto"
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/request"
+)
+
+type Handler interface {
+	Start() http.HandlerFunc
+	Get() http.HandlerFunc
+	Submit() http.HandlerFunc
+	Score() http.HandlerFunc
+}
+
+type handler struct {
+	logger  *zap.Logger
+	service quiz.Service
+}
+
+func NewQuizHandler(
+	logger *zap.Logger,
+	service quiz.Service,
+) Handler {
+	return &handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+const (
+	quizNotFoundMsg  = "Quiz not found"
+	invalidQuizMsg   = "Please provide a deck ID, a size of at most 20 and question types among multiple_choice, cloze and translation"
+	invalidAnswerMsg = "Please choose one of the options of each question"
+)
+
+// userID writes a 401 and returns false when the request has no user.
+func (h *handler) userID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID, err := context.GetUserIDString(r.Context())
+	if err != nil {
+		h.logger.Sugar().Errorw("user ID not found in session", "error", err)
+		render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+		return "", false
+	}
+
+	return userID, true
+}
+
+// renderError writes the response for an error returned by the service.
+func (h *handler) renderError(w http.ResponseWriter, err error, message string, keysAndValues ...any) {
+	switch {
+	case errors.Is(err, quiz.ErrQuizNotFound):
+		render.Json(w, http.StatusNotFound, quizNotFoundMsg)
+	case errors.Is(err, quiz.ErrInvalidType):
+		render.Json(w, http.StatusBadRequest, invalidQuizMsg)
+	case errors.Is(err, quiz.ErrInvalidAnswer):
+		render.Json(w, http.StatusBadRequest, invalidAnswerMsg)
+	case errors.Is(err, quiz.ErrNoWords):
+		render.Json(w, http.StatusUnprocessableEntity, "None of your saved words are due for review")
+	case errors.Is(err, quiz.ErrAlreadySubmitted):
+		render.Json(w, http.StatusConflict, "This quiz has already been submitted")
+	case errors.Is(err, quiz.ErrNotSubmitted):
+		render.Json(w, http.StatusConflict, "This quiz has not been submitted yet")
+	case errors.Is(err, openaierrors.ErrInvalidStructuredOutput):
+		h.logger.Sugar().Warnw(message, append([]any{"error", err}, keysAndValues...)...)
+		render.Json(w, http.StatusBadGateway, "Unable to generate a quiz, please try again")
+	default:
+		h.logger.Sugar().Errorw(message, append([]any{"error", err}, keysAndValues...)...)
+		apierror.Render(w, err)
+	}
+}
+
+// Start generates a quiz on the saved words most due for review.
+func (h *handler) Start() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		var requestBody dto.StartQuizRequest
+
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate quiz request body",
+				"error", err)
+
+			if languages.IsUnsupported(err) {
+				apierror.RenderValidation(w, languages.ErrUnsupported, requestBody.NativeLanguage)
+				return
+			}
+
+			render.Json(w, http.StatusBadRequest, invalidQuizMsg)
+
+			return
+		}
+
+		if requestBody.Size > quiz.MaxSize {
+			render.Json(w, http.StatusBadRequest, invalidQuizMsg)
+			return
+		}
+
+		result, err := h.service.Start(r.Context(), userID, domain.Request{
+			DeckID:         requestBody.DeckID,
+			NativeLanguage: requestBody.NativeLanguage,
+			Size:           requestBody.Size,
+			Types:          requestBody.Types,
+		})
+		if err != nil {
+			h.renderError(w, err, "failed to start quiz", "userID", userID)
+			return
+		}
+
+		render.Json(w, http.StatusCreated, dto.ToQuizResponse(*result))
+	}
+}
+
+// Get returns a quiz without its answers.
+func (h *handler) Get() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		quizID := chi.URLParam(r, "quizID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		result, err := h.service.Get(r.Context(), userID, quizID)
+		if err != nil {
+			h.renderError(w, err, "failed to get quiz", "userID", userID, "quizID", quizID)
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToQuizResponse(*result))
+	}
+}
+
+// Submit scores the answers to a quiz and returns the score breakdown.
+func (h *handler) Submit() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		quizID := chi.URLParam(r, "quizID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		var requestBody dto.SubmitAnswersRequest
+
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate quiz answers request body",
+				"error", err)
+			render.Json(w, http.StatusBadRequest, invalidAnswerMsg)
+
+			return
+		}
+
+		answers := make(map[int]int, len(requestBody.Answers))
+		for _, answer := range requestBody.Answers {
+			answers[answer.Question] = answer.Option
+		}
+
+		result, err := h.service.Submit(r.Context(), userID, quizID, answers)
+		if err != nil {
+			h.renderError(w, err, "failed to submit quiz", "userID", userID, "quizID", quizID)
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToScoreResponse(*result))
+	}
+}
+
+// Score returns the score breakdown of a submitted quiz.
+func (h *handler) Score() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		quizID := chi.URLParam(r, "quizID")
+
+		userID, ok := h.userID(w, r)
+		if !ok {
+			return
+		}
+
+		result, err := h.service.Score(r.Context(), userID, quizID)
+		if err != nil {
+			h.renderError(w, err, "failed to get quiz score", "userID", userID, "quizID", quizID)
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToScoreResponse(*result))
+	}
+}
@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"github.com/go-playground/validator/v10"
+)
+
+type GradeRequest struct {
+	// Rating is how well the word was remembered: again, hard, good or easy.
+	Rating string `json:"rating" validate:"required,oneof=again hard good easy"`
+}
+
+func (gr GradeRequest) Validate() error {
+	return validator.New().Struct(gr)
+}
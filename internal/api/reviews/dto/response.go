@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	decksdto "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/decks/dto"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews/domain"
+)
+
+// ItemResponse is a saved word with its review schedule.
+type ItemResponse struct {
+	DeckID   string                 `json:"deckId"`
+	DeckName string                 `json:"deckName"`
+	Entry    decksdto.EntryResponse `json:"entry"`
+	Review   ReviewResponse         `json:"review"`
+}
+
+// ReviewResponse is the review schedule of a word. The dates are null for words never reviewed.
+type ReviewResponse struct {
+	New            bool       `json:"new"`
+	Repetitions    int        `json:"repetitions"`
+	Lapses         int        `json:"lapses"`
+	IntervalDays   int        `json:"intervalDays"`
+	DueAt          *time.Time `json:"dueAt"`
+	LastReviewedAt *time.Time `json:"lastReviewedAt"`
+}
+
+func ToItemResponses(items []domain.Item) []ItemResponse {
+	responses := make([]ItemResponse, 0, len(items))
+	for _, item := range items {
+		responses = append(responses, ToItemResponse(item))
+	}
+
+	return responses
+}
+
+func ToItemResponse(item domain.Item) ItemResponse {
+	response := ItemResponse{
+		DeckID:   item.Entry.DeckID,
+		DeckName: item.DeckName,
+		Entry:    decksdto.ToEntryResponse(item.Entry),
+		Review: ReviewResponse{
+			New:          item.Card.New(),
+			Repetitions:  item.Card.Repetitions,
+			Lapses:       item.Card.Lapses,
+			IntervalDays: item.Card.IntervalDays,
+		},
+	}
+
+	if !item.Card.New() {
+		response.Review.DueAt = &item.Card.Due
+		response.Review.LastReviewedAt = &item.Card.LastReviewed
+	}
+
+	return response
+}
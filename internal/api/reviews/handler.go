@@ -0,0 +1,126 @@
+package reviews
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/reviews/dto"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/srs"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/request"
+)
+
+type Handler interface {
+	Due() http.HandlerFunc
+	Grade() http.HandlerFunc
+}
+
+type handler struct {
+	logger  *zap.Logger
+	service reviews.Service
+}
+
+func NewReviewsHandler(
+	logger *zap.Logger,
+	service reviews.Service,
+) Handler {
+	return &handler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+const invalidRatingMsg = "Please rate the word again, hard, good or easy"
+
+// Due returns the saved words to review now. The query parameters are deckId, to review a single deck, and limit.
+func (h *handler) Due() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		userID, err := context.GetUserIDString(ctx)
+		if err != nil {
+			h.logger.Sugar().Errorw("user ID not found in session", "error", err)
+			render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+			return
+		}
+
+		query := r.URL.Query()
+
+		limit := 0
+		if value := query.Get("limit"); value != "" {
+			if limit, err = strconv.Atoi(value); err != nil {
+				render.Json(w, http.StatusBadRequest, "Please provide a valid limit")
+				return
+			}
+		}
+
+		items, err := h.service.Due(ctx, userID, query.Get("deckId"), limit)
+		if err != nil {
+			h.logger.Sugar().Errorw("failed to list due reviews",
+				"error", err,
+				"userID", userID)
+			render.Json(w, http.StatusInternalServerError, "Unable to get your reviews")
+
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToItemResponses(items))
+	}
+}
+
+// Grade records how well the user remembered a saved word and returns it with its next review.
+func (h *handler) Grade() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		entryID := chi.URLParam(r, "entryID")
+
+		userID, err := context.GetUserIDString(ctx)
+		if err != nil {
+			h.logger.Sugar().Errorw("user ID not found in session", "error", err)
+			render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+			return
+		}
+
+		var requestBody dto.GradeRequest
+
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate grade request body",
+				"error", err)
+			render.Json(w, http.StatusBadRequest, invalidRatingMsg)
+
+			return
+		}
+
+		rating, err := srs.ParseRating(requestBody.Rating)
+		if err != nil {
+			render.Json(w, http.StatusBadRequest, invalidRatingMsg)
+			return
+		}
+
+		item, err := h.service.Grade(ctx, userID, entryID, rating)
+		if errors.Is(err, reviews.ErrEntryNotFound) {
+			render.Json(w, http.StatusNotFound, "Deck entry not found")
+			return
+		}
+
+		if err != nil {
+			h.logger.Sugar().Errorw("failed to grade review",
+				"error", err,
+				"userID", userID,
+				"entryID", entryID)
+			render.Json(w, http.StatusInternalServerError, "Unable to save your review")
+
+			return
+		}
+
+		render.Json(w, http.StatusOK, dto.ToItemResponse(*item))
+	}
+}
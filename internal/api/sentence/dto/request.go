@@ -1,13 +1,29 @@
 package dto
 
-import "github.com/go-playground/validator/v10"
+import "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
 
 type DefineSentenceRequest struct {
 	Sentence       string `json:"sentence"`
-	NativeLanguage string `json:"nativeLanguage" `
+	NativeLanguage string `json:"nativeLanguage" validate:"language"`
 	IsDetailed     bool   `json:"isDetailed"`
+	// IncludeDifficulty asks the explain endpoint for an ExplanationResponse rather than the bare explanation.
+	IncludeDifficulty bool `json:"includeDifficulty"`
+	// TargetLanguage is the language of the sentence, when the client knows it. It skips the language detection.
+	TargetLanguage string `json:"targetLanguage" validate:"omitempty,language"`
 }
 
 func (dsr DefineSentenceRequest) Validate() error {
-	return validator.New().Struct(dsr)
+	return languages.ValidateStruct(dsr)
+}
+
+type SimplifySentenceRequest struct {
+	Sentence       string `json:"sentence"`
+	NativeLanguage string `json:"nativeLanguage" validate:"language"`
+	TargetLanguage string `json:"targetLanguage" validate:"omitempty,language"`
+	// Level is the CEFR level to simplify to, A1 to C2.
+	Level string `json:"level"`
+}
+
+func (ssr SimplifySentenceRequest) Validate() error {
+	return languages.ValidateStruct(ssr)
 }
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence/domain"
+)
+
+type SimplificationResponse struct {
+	Level       string `json:"level"`
+	Simplified  string `json:"simplified"`
+	Explanation string `json:"explanation"`
+	// SourceLanguage is omitted when the language could not be detected.
+	SourceLanguage *SourceLanguageResponse `json:"sourceLanguage,omitempty"`
+}
+
+func ToSimplificationResponse(simplification *domain.Simplification) SimplificationResponse {
+	return SimplificationResponse{
+		Level:       simplification.Level,
+		Simplified:  simplification.Simplified,
+		Explanation: simplification.Explanation,
+	}
+}
+
+// ExplanationResponse holds an explanation and the difficulty of the sentence, which is null when it could not be assessed.
+type ExplanationResponse struct {
+	Explanation string              `json:"explanation"`
+	Difficulty  *DifficultyResponse `json:"difficulty"`
+	// SourceLanguage is omitted when the language could not be detected.
+	SourceLanguage *SourceLanguageResponse `json:"sourceLanguage,omitempty"`
+}
+
+type DifficultyResponse struct {
+	CEFR      string `json:"cefr"`
+	JLPT      string `json:"jlpt,omitempty"`
+	HSK       string `json:"hsk,omitempty"`
+	Rationale string `json:"rationale"`
+}
+
+func ToExplanationResponse(explanation string, assessment *difficulty.Assessment) ExplanationResponse {
+	response := ExplanationResponse{Explanation: explanation}
+
+	if assessment != nil {
+		response.Difficulty = &DifficultyResponse{
+			CEFR:      assessment.CEFR,
+			JLPT:      assessment.JLPT,
+			HSK:       assessment.HSK,
+			Rationale: assessment.Rationale,
+		}
+	}
+
+	return response
+}
+
+// SourceLanguageResponse is the language the input is written in.
+type SourceLanguageResponse struct {
+	// Language is an ISO 639-1 code.
+	Language   string  `json:"language"`
+	Confidence float64 `json:"confidence"`
+	// Method is "request" when the client gave the language, "script", "words" or "model" when it was detected.
+	Method string `json:"method"`
+}
+
+func ToSourceLanguageResponse(detection *langdetect.Detection) *SourceLanguageResponse {
+	if detection == nil {
+		return nil
+	}
+
+	return &SourceLanguageResponse{
+		Language:   detection.Language,
+		Confidence: detection.Confidence,
+		Method:     detection.Method,
+	}
+}
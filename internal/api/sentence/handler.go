@@ -1,14 +1,19 @@
 package sentence
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
 	"go.uber.org/zap"
 
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/apierror"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/sentence/dto"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/stream"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence"
-	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/messages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/validation"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/request"
 )
@@ -17,24 +22,38 @@ type Handler interface {
 	ExplainSentence() http.HandlerFunc
 	CorrectSentence() http.HandlerFunc
 	Simplify() http.HandlerFunc
+	StreamExplanation() http.HandlerFunc
 }
 
 type handler struct {
-	logger  *zap.Logger
-	service sentence.Service
+	logger   *zap.Logger
+	service  sentence.Service
+	detector langdetect.Detector
 }
 
 func NewSentenceHandler(
 	logger *zap.Logger,
 	service sentence.Service,
+	detector langdetect.Detector,
 ) Handler {
 	return &handler{
-		logger:  logger,
-		service: service,
+		logger:   logger,
+		service:  service,
+		detector: detector,
 	}
 }
 
-var FailedToProcessSentence = "Failed to process your sentence(s).Please make sure you remove any line breaks and large gaps between your sentences and try again"
+// ErrInvalidRequest is the error for a request body that could not be decoded or validated.
+var ErrInvalidRequest = validation.New(validation.CodeInvalidSentenceRequest, "Failed to process your sentence(s). Please make sure you remove any line breaks and large gaps between your sentences and try again")
+
+// decodeFailure returns the error to render for a request body that could not be decoded or validated.
+func decodeFailure(err error) error {
+	if languages.IsUnsupported(err) {
+		return languages.ErrUnsupported
+	}
+
+	return ErrInvalidRequest
+}
 
 func (h *handler) ExplainSentence() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -47,7 +66,7 @@ func (h *handler) ExplainSentence() http.HandlerFunc {
 			h.logger.Sugar().Warnw("failed to decode and validate explain sentence request body",
 				"error", err)
 
-			render.Json(w, http.StatusBadRequest, FailedToProcessSentence)
+			apierror.RenderValidation(w, decodeFailure(err), requestBody.NativeLanguage)
 
 			return
 		}
@@ -58,23 +77,48 @@ func (h *handler) ExplainSentence() http.HandlerFunc {
 		if err != nil {
 			h.logger.Sugar().Infow("sentence validation failed",
 				"sentence", trimmedSentence, "error", err)
-			render.Json(w, http.StatusBadRequest, err.Error())
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
 
 			return
 		}
 
+		detected := h.detectLanguage(ctx, trimmedSentence, requestBody.TargetLanguage)
+
 		response, err := h.service.GetSentenceExplanation(ctx, trimmedSentence, requestBody.NativeLanguage, requestBody.IsDetailed)
 		if err != nil {
 			h.logger.Sugar().Errorw("sentence explanation failed",
 				"sentence", trimmedSentence,
 				"nativeLanguage", requestBody.NativeLanguage,
 				"error", err)
-			render.Json(w, http.StatusInternalServerError, messages.InternalServerErrorMsg)
+			apierror.Render(w, err)
 
 			return
 		}
 
-		render.Json(w, http.StatusOK, response)
+		if !requestBody.IncludeDifficulty {
+			langdetect.SetHeaders(w, detected())
+
+			render.Json(w, http.StatusOK, response)
+
+			return
+		}
+
+		// The explanation is still useful on its own, so a failed assessment is left out rather than failing the request.
+		assessment, err := h.service.GetSentenceDifficulty(ctx, trimmedSentence, requestBody.NativeLanguage)
+		if err != nil {
+			h.logger.Sugar().Warnw("sentence difficulty assessment failed",
+				"sentence", trimmedSentence,
+				"nativeLanguage", requestBody.NativeLanguage,
+				"error", err)
+		}
+
+		sourceLanguage := detected()
+		langdetect.SetHeaders(w, sourceLanguage)
+
+		explanation := dto.ToExplanationResponse(*response, assessment)
+		explanation.SourceLanguage = dto.ToSourceLanguageResponse(sourceLanguage)
+
+		render.Json(w, http.StatusOK, explanation)
 	}
 }
 
@@ -90,7 +134,7 @@ func (h *handler) CorrectSentence() http.HandlerFunc {
 				"failed to decode and validate correct sentence request body",
 				"error", err)
 
-			render.Json(w, http.StatusBadRequest, FailedToProcessSentence)
+			apierror.RenderValidation(w, decodeFailure(err), requestBody.NativeLanguage)
 
 			return
 		}
@@ -102,27 +146,160 @@ func (h *handler) CorrectSentence() http.HandlerFunc {
 			h.logger.Sugar().Infow(
 				"sentence validation failed",
 				"sentence", trimmedSentence, "error", err)
-			render.Json(w, http.StatusBadRequest, err.Error())
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
 
 			return
 		}
 
+		detected := h.detectLanguage(ctx, trimmedSentence, requestBody.TargetLanguage)
+
 		response, err := h.service.GetSentenceCorrection(ctx, trimmedSentence, requestBody.NativeLanguage)
 		if err != nil {
 			h.logger.Sugar().Errorw("sentence correction failed",
 				"sentence", trimmedSentence,
 				"nativeLanguage", requestBody.NativeLanguage,
 				"error", err)
-			render.Json(w, http.StatusInternalServerError, messages.InternalServerErrorMsg)
+			apierror.Render(w, err)
 
 			return
 		}
 
+		langdetect.SetHeaders(w, detected())
+
 		render.Json(w, http.StatusOK, response)
 	}
 }
 
+// Simplify rewrites the sentence for learners of the requested CEFR level.
 func (h *handler) Simplify() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var requestBody dto.SimplifySentenceRequest
+
+		// Validates and decodes request
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate simplify sentence request body",
+				"error", err)
+
+			apierror.RenderValidation(w, decodeFailure(err), requestBody.NativeLanguage)
+
+			return
+		}
+
+		trimmedSentence := strings.TrimSpace(requestBody.Sentence)
+
+		err := h.service.ValidateSentence(trimmedSentence)
+		if err != nil {
+			h.logger.Sugar().Infow("sentence validation failed",
+				"sentence", trimmedSentence, "error", err)
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
+
+			return
+		}
+
+		err = h.service.ValidateLevel(requestBody.Level)
+		if err != nil {
+			h.logger.Sugar().Infow("simplification level validation failed",
+				"level", requestBody.Level, "error", err)
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
+
+			return
+		}
+
+		detected := h.detectLanguage(ctx, trimmedSentence, requestBody.TargetLanguage)
+
+		simplification, err := h.service.GetSentenceSimplification(ctx, trimmedSentence, requestBody.NativeLanguage, requestBody.Level)
+		if err != nil {
+			h.logger.Sugar().Errorw("sentence simplification failed",
+				"sentence", trimmedSentence,
+				"nativeLanguage", requestBody.NativeLanguage,
+				"level", requestBody.Level,
+				"error", err)
+			apierror.Render(w, err)
+
+			return
+		}
+
+		sourceLanguage := detected()
+		langdetect.SetHeaders(w, sourceLanguage)
+
+		simplified := dto.ToSimplificationResponse(simplification)
+		simplified.SourceLanguage = dto.ToSourceLanguageResponse(sourceLanguage)
+
+		render.Json(w, http.StatusOK, simplified)
+	}
+}
+
+// StreamExplanation relays the sentence explanation to the browser as Server-Sent Events while it is generated.
+func (h *handler) StreamExplanation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var requestBody dto.DefineSentenceRequest
+
+		// Validates and decodes request
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate stream explanation request body",
+				"error", err)
+
+			apierror.RenderValidation(w, decodeFailure(err), requestBody.NativeLanguage)
+
+			return
+		}
+
+		trimmedSentence := strings.TrimSpace(requestBody.Sentence)
+
+		err := h.service.ValidateSentence(trimmedSentence)
+		if err != nil {
+			h.logger.Sugar().Infow("sentence validation failed",
+				"sentence", trimmedSentence, "error", err)
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
+
+			return
+		}
+
+		// Headers must be set before the first event, so they only report the guess. The done event reports
+		// the detected language.
+		h.guessLanguage(w, trimmedSentence, requestBody.TargetLanguage)
+		detected := h.detectLanguage(ctx, trimmedSentence, requestBody.TargetLanguage)
+
+		stream.Relay(w, h.logger, detected, func(onDelta func(delta string) error) (*string, error) {
+			response, err := h.service.StreamSentenceExplanation(ctx, trimmedSentence, requestBody.NativeLanguage, requestBody.IsDetailed, onDelta)
+			if err != nil {
+				h.logger.Sugar().Errorw("sentence explanation stream failed",
+					"sentence", trimmedSentence,
+					"nativeLanguage", requestBody.NativeLanguage,
+					"error", err)
+			}
+
+			return response, err
+		})
+	}
+}
+
+// guessLanguage reports the language of sentence in the headers of w, as far as it can be told without the model.
+func (h *handler) guessLanguage(w http.ResponseWriter, sentence, targetLanguage string) {
+	langdetect.SetHeaders(w, h.detector.Guess(sentence, targetLanguage))
+}
+
+// detectLanguage detects the language of sentence while the request is answered, and returns a function waiting for
+// it. The response does not depend on it, so a failed detection is only logged and the function returns nil.
+func (h *handler) detectLanguage(ctx context.Context, sentence, targetLanguage string) func() *langdetect.Detection {
+	detected := make(chan *langdetect.Detection, 1)
+
+	go func() {
+		detection, err := h.detector.Detect(ctx, sentence, targetLanguage)
+		if err != nil {
+			h.logger.Sugar().Warnw("failed to detect sentence language",
+				"error", err,
+				"sentence", sentence)
+		}
+
+		detected <- detection
+	}()
+
+	return func() *langdetect.Detection {
+		return <-detected
 	}
 }
@@ -0,0 +1,191 @@
+package sentence_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	sentencehandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/sentence"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/sentence/dto"
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
+	langdetectmock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence/domain"
+	sentencemock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/messages"
+)
+
+func TestExplainSentenceHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockService := sentencemock.NewMockService(ctrl)
+	detector := langdetectmock.NewMockDetector(ctrl)
+	detector.EXPECT().Detect(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&langdetect.Detection{Language: "ja", Confidence: 0.99, Method: langdetect.MethodScript}, nil).AnyTimes()
+	detector.EXPECT().Guess(gomock.Any(), gomock.Any()).
+		Return(&langdetect.Detection{Language: "ja", Confidence: 0.99, Method: langdetect.MethodScript}).AnyTimes()
+	handler := sentencehandler.NewSentenceHandler(zaptest.NewLogger(t), mockService, detector)
+
+	r := chi.NewRouter()
+	r.Post("/api/v4/sentence/explanation", handler.ExplainSentence())
+
+	const text = "猫が好きです。"
+
+	explanation := "I like cats."
+
+	testCases := []struct {
+		name         string
+		requestBody  dto.DefineSentenceRequest
+		mockSetup    func()
+		expectedBody string
+	}{
+		{
+			name:        "bare explanation",
+			requestBody: dto.DefineSentenceRequest{Sentence: text, NativeLanguage: "English"},
+			mockSetup: func() {
+				mockService.EXPECT().ValidateSentence(text).Return(nil)
+				mockService.EXPECT().GetSentenceExplanation(gomock.Any(), text, "English", false).Return(&explanation, nil)
+			},
+			expectedBody: `"I like cats."`,
+		},
+		{
+			name:        "with difficulty",
+			requestBody: dto.DefineSentenceRequest{Sentence: text, NativeLanguage: "English", IncludeDifficulty: true},
+			mockSetup: func() {
+				mockService.EXPECT().ValidateSentence(text).Return(nil)
+				mockService.EXPECT().GetSentenceExplanation(gomock.Any(), text, "English", false).Return(&explanation, nil)
+				mockService.EXPECT().GetSentenceDifficulty(gomock.Any(), text, "English").
+					Return(&difficulty.Assessment{CEFR: "A1", JLPT: "N5", Rationale: "Basic words."}, nil)
+			},
+			expectedBody: `{"explanation":"I like cats.","difficulty":{"cefr":"A1","jlpt":"N5","rationale":"Basic words."},` +
+				`"sourceLanguage":{"language":"ja","confidence":0.99,"method":"script"}}`,
+		},
+		{
+			name:        "difficulty assessment fails",
+			requestBody: dto.DefineSentenceRequest{Sentence: text, NativeLanguage: "English", IncludeDifficulty: true},
+			mockSetup: func() {
+				mockService.EXPECT().ValidateSentence(text).Return(nil)
+				mockService.EXPECT().GetSentenceExplanation(gomock.Any(), text, "English", false).Return(&explanation, nil)
+				mockService.EXPECT().GetSentenceDifficulty(gomock.Any(), text, "English").
+					Return(nil, openaierrors.ErrProviderUnavailable)
+			},
+			expectedBody: `{"explanation":"I like cats.","difficulty":null,` +
+				`"sourceLanguage":{"language":"ja","confidence":0.99,"method":"script"}}`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			body, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/api/v4/sentence/explanation", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, "ja", rr.Header().Get(langdetect.HeaderSourceLanguage))
+			assert.Equal(t, "0.99", rr.Header().Get(langdetect.HeaderSourceLanguageConfidence))
+			assert.JSONEq(t, tt.expectedBody, rr.Body.String())
+		})
+	}
+}
+
+func TestSimplifyHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockService := sentencemock.NewMockService(ctrl)
+	detector := langdetectmock.NewMockDetector(ctrl)
+	detector.EXPECT().Detect(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&langdetect.Detection{Language: "en", Confidence: 0.98, Method: langdetect.MethodModel}, nil).AnyTimes()
+	handler := sentencehandler.NewSentenceHandler(zaptest.NewLogger(t), mockService, detector)
+
+	r := chi.NewRouter()
+	r.Post("/api/v4/sentence/simplify", handler.Simplify())
+
+	const text = "Notwithstanding the inclement weather, we proceeded."
+
+	testCases := []struct {
+		name           string
+		requestBody    dto.SimplifySentenceRequest
+		mockSetup      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "empty sentence",
+			requestBody: dto.SimplifySentenceRequest{Sentence: " ", NativeLanguage: "French", Level: "A2"},
+			mockSetup: func() {
+				mockService.EXPECT().ValidateSentence("").Return(sentence.ErrEmptySentence)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"code":"empty_sentence","message":"Veuillez saisir une phrase."}`,
+		},
+		{
+			name:        "invalid level",
+			requestBody: dto.SimplifySentenceRequest{Sentence: text, NativeLanguage: "French", Level: "D1"},
+			mockSetup: func() {
+				mockService.EXPECT().ValidateSentence(text).Return(nil)
+				mockService.EXPECT().ValidateLevel("D1").Return(fmt.Errorf("%w: %q", sentence.ErrInvalidLevel, "D1"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"code":"invalid_level","message":"Veuillez choisir un niveau entre A1 et C2."}`,
+		},
+		{
+			name:        "AI provider unavailable",
+			requestBody: dto.SimplifySentenceRequest{Sentence: text, NativeLanguage: "French", Level: "A2"},
+			mockSetup: func() {
+				mockService.EXPECT().ValidateSentence(text).Return(nil)
+				mockService.EXPECT().ValidateLevel("A2").Return(nil)
+				mockService.EXPECT().GetSentenceSimplification(gomock.Any(), text, "French", "A2").
+					Return(nil, fmt.Errorf("failed to make open ai request: %w", openaierrors.ErrProviderUnavailable))
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   messages.AIProviderUnavailableMsg,
+		},
+		{
+			name:        "successful simplification",
+			requestBody: dto.SimplifySentenceRequest{Sentence: text, NativeLanguage: "French", Level: "a2"},
+			mockSetup: func() {
+				mockService.EXPECT().ValidateSentence(text).Return(nil)
+				mockService.EXPECT().ValidateLevel("a2").Return(nil)
+				mockService.EXPECT().GetSentenceSimplification(gomock.Any(), text, "French", "a2").Return(&domain.Simplification{
+					Level:       "A2",
+					Simplified:  "The weather was bad, but we went.",
+					Explanation: "Mots difficiles remplacés.",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"level":"A2","simplified":"The weather was bad, but we went.","explanation":"Mots difficiles remplacés.",` +
+				`"sourceLanguage":{"language":"en","confidence":0.98,"method":"model"}}`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			body, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/api/v4/sentence/simplify", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedBody)
+		})
+	}
+}
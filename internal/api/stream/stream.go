@@ -0,0 +1,95 @@
+package stream
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/apierror"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/messages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+// Names of the events sent to the browser.
+const (
+	EventToken = "token"
+	EventDone  = "done"
+	EventError = "error"
+)
+
+type (
+	TokenEvent struct {
+		Delta string `json:"delta"`
+	}
+
+	DoneEvent struct {
+		Content string `json:"content"`
+		// SourceLanguage is omitted when the language could not be detected.
+		SourceLanguage *SourceLanguage `json:"sourceLanguage,omitempty"`
+	}
+
+	// SourceLanguage is the language the input is written in.
+	SourceLanguage struct {
+		// Language is an ISO 639-1 code.
+		Language   string  `json:"language"`
+		Confidence float64 `json:"confidence"`
+		// Method is "request" when the client gave the language, "script", "words" or "model" when it was detected.
+		Method string `json:"method"`
+	}
+
+	ErrorEvent struct {
+		Status  int    `json:"status"`
+		Message string `json:"message"`
+	}
+)
+
+// Generate produces a completion, calling onDelta with every chunk of text as it is generated.
+type Generate func(onDelta func(delta string) error) (*string, error)
+
+// Relay runs generate and relays its output as Server-Sent Events: a token event per chunk,
+// then a done event with the full text and the language detected returns. Errors raised before the first token
+// are answered with a regular JSON error response, later ones with an error event.
+func Relay(w http.ResponseWriter, logger *zap.Logger, detected func() *langdetect.Detection, generate Generate) {
+	events, err := render.NewEventStream(w)
+	if err != nil {
+		logger.Error("failed to start event stream", zap.Error(err))
+		render.Json(w, http.StatusInternalServerError, messages.InternalServerErrorMsg)
+
+		return
+	}
+
+	response, err := generate(func(delta string) error {
+		return events.Event(EventToken, TokenEvent{Delta: delta})
+	})
+	if err != nil {
+		if !events.Started() {
+			apierror.Render(w, err)
+			return
+		}
+
+		status, message := apierror.Resolve(err)
+
+		err = events.Event(EventError, ErrorEvent{Status: status, Message: message})
+		if err != nil {
+			logger.Warn("failed to send error event", zap.Error(err))
+		}
+
+		return
+	}
+
+	done := DoneEvent{Content: *response}
+
+	if detection := detected(); detection != nil {
+		done.SourceLanguage = &SourceLanguage{
+			Language:   detection.Language,
+			Confidence: detection.Confidence,
+			Method:     detection.Method,
+		}
+	}
+
+	err = events.Event(EventDone, done)
+	if err != nil {
+		logger.Warn("failed to send done event", zap.Error(err))
+	}
+}
@@ -1,7 +1,10 @@
 package mapper
 
 import (
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/apierror"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word/dto"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
 )
 
@@ -10,9 +13,116 @@ func MapToLookUpResponse(details *domain.LookupDetails) dto.LookupResponse {
 		return dto.LookupResponse{}
 	}
 
-	return dto.LookupResponse{
-		Definition: details.Definition,
-		Synonyms:   details.Synonyms,
-		History:    details.History,
+	response := dto.LookupResponse{
+		Definition: mapToDefinitionResponse(details.Definition),
+		History:    mapToHistoryResponse(details.History),
+		Difficulty: mapToDifficultyResponse(details.Difficulty),
+		Meta: dto.LookupMeta{
+			Cache: make(map[string]string, len(details.CacheHits)),
+		},
 	}
+
+	for section, hit := range details.CacheHits {
+		response.Meta.Cache[section] = cacheStatus(hit)
+	}
+
+	if _, failed := details.SectionErrors[domain.SectionSynonyms]; !failed {
+		response.Synonyms = mapToSynonymResponses(details.Synonyms)
+	}
+
+	for section, err := range details.SectionErrors {
+		if response.Errors == nil {
+			response.Errors = map[string]string{}
+		}
+
+		_, message := apierror.Resolve(err)
+		response.Errors[section] = message
+	}
+
+	return response
+}
+
+func mapToDefinitionResponse(definition *domain.Definition) *dto.DefinitionResponse {
+	if definition == nil {
+		return nil
+	}
+
+	response := &dto.DefinitionResponse{
+		PartOfSpeech: definition.PartOfSpeech,
+		IPA:          definition.IPA,
+		Readings:     make([]dto.ReadingResponse, 0, len(definition.Readings)),
+		Senses:       make([]dto.SenseResponse, 0, len(definition.Senses)),
+		Examples:     make([]dto.ExampleResponse, 0, len(definition.Examples)),
+	}
+
+	for _, reading := range definition.Readings {
+		response.Readings = append(response.Readings, dto.ReadingResponse{System: reading.System, Value: reading.Value})
+	}
+
+	for _, sense := range definition.Senses {
+		response.Senses = append(response.Senses, dto.SenseResponse{Gloss: sense.Gloss, Notes: sense.Notes})
+	}
+
+	for _, example := range definition.Examples {
+		response.Examples = append(response.Examples, dto.ExampleResponse{
+			Sentence:    example.Sentence,
+			Reading:     example.Reading,
+			Translation: example.Translation,
+		})
+	}
+
+	return response
+}
+
+func mapToSynonymResponses(synonyms []domain.Synonym) []dto.SynonymResponse {
+	response := make([]dto.SynonymResponse, 0, len(synonyms))
+	for _, synonym := range synonyms {
+		response = append(response, dto.SynonymResponse{Word: synonym.Word, Reading: synonym.Reading, Nuance: synonym.Nuance})
+	}
+
+	return response
+}
+
+func mapToHistoryResponse(history *domain.Etymology) *dto.HistoryResponse {
+	if history == nil {
+		return nil
+	}
+
+	return &dto.HistoryResponse{
+		Origin:      history.Origin,
+		Explanation: history.Explanation,
+	}
+}
+
+func mapToDifficultyResponse(assessment *difficulty.Assessment) *dto.DifficultyResponse {
+	if assessment == nil {
+		return nil
+	}
+
+	return &dto.DifficultyResponse{
+		CEFR:      assessment.CEFR,
+		JLPT:      assessment.JLPT,
+		HSK:       assessment.HSK,
+		Rationale: assessment.Rationale,
+	}
+}
+
+func MapToSourceLanguageResponse(detection *langdetect.Detection) *dto.SourceLanguageResponse {
+	if detection == nil {
+		return nil
+	}
+
+	return &dto.SourceLanguageResponse{
+		Language:   detection.Language,
+		Confidence: detection.Confidence,
+		Method:     detection.Method,
+	}
+}
+
+func cacheStatus(hit bool) string {
+	if hit {
+		return "hit"
+	}
+
+	return "miss"
 }
@@ -1,12 +1,14 @@
 package dto
 
-import "github.com/go-playground/validator/v10"
+import "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
 
 type WordRequest struct {
 	Word           string `json:"word" `
-	NativeLanguage string `json:"nativeLanguage" `
+	NativeLanguage string `json:"nativeLanguage" validate:"language"`
+	// TargetLanguage is the language of the word, when the client knows it. It skips the language detection.
+	TargetLanguage string `json:"targetLanguage" validate:"omitempty,language"`
 }
 
 func (wr WordRequest) Validate() error {
-	return validator.New().Struct(wr)
+	return languages.ValidateStruct(wr)
 }
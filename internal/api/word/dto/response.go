@@ -1,7 +1,70 @@
 package dto
 
+// LookupResponse holds the sections of a lookup. A section that could not be generated is null
+// and Errors holds a message for it under the section name.
 type LookupResponse struct {
-	Definition string `json:"definition"`
-	Synonyms   string `json:"synonyms"`
-	History    string `json:"history"`
+	Definition *DefinitionResponse `json:"definition"`
+	Synonyms   []SynonymResponse   `json:"synonyms"`
+	History    *HistoryResponse    `json:"history"`
+	Difficulty *DifficultyResponse `json:"difficulty,omitempty"`
+	// SourceLanguage is omitted when the language could not be detected.
+	SourceLanguage *SourceLanguageResponse `json:"sourceLanguage,omitempty"`
+	Errors         map[string]string       `json:"errors,omitempty"`
+	Meta           LookupMeta              `json:"meta"`
+}
+
+type LookupMeta struct {
+	// Cache is "hit" or "miss" for every section.
+	Cache map[string]string `json:"cache"`
+}
+
+type DefinitionResponse struct {
+	PartOfSpeech string            `json:"partOfSpeech"`
+	Readings     []ReadingResponse `json:"readings"`
+	IPA          string            `json:"ipa"`
+	Senses       []SenseResponse   `json:"senses"`
+	Examples     []ExampleResponse `json:"examples"`
+}
+
+type ReadingResponse struct {
+	System string `json:"system"`
+	Value  string `json:"value"`
+}
+
+type SenseResponse struct {
+	Gloss string `json:"gloss"`
+	Notes string `json:"notes,omitempty"`
+}
+
+type ExampleResponse struct {
+	Sentence    string `json:"sentence"`
+	Reading     string `json:"reading,omitempty"`
+	Translation string `json:"translation"`
+}
+
+type SynonymResponse struct {
+	Word    string `json:"word"`
+	Reading string `json:"reading,omitempty"`
+	Nuance  string `json:"nuance"`
+}
+
+type HistoryResponse struct {
+	Origin      string `json:"origin"`
+	Explanation string `json:"explanation"`
+}
+
+type DifficultyResponse struct {
+	CEFR      string `json:"cefr"`
+	JLPT      string `json:"jlpt,omitempty"`
+	HSK       string `json:"hsk,omitempty"`
+	Rationale string `json:"rationale"`
+}
+
+// SourceLanguageResponse is the language the input is written in.
+type SourceLanguageResponse struct {
+	// Language is an ISO 639-1 code.
+	Language   string  `json:"language"`
+	Confidence float64 `json:"confidence"`
+	// Method is "request" when the client gave the language, "script", "words" or "model" when it was detected.
+	Method string `json:"method"`
 }
@@ -1,15 +1,20 @@
 package word
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
 	"go.uber.org/zap"
 
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/apierror"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/stream"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word/dto"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word/dto/mapper"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/validation"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
-	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/messages"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/request"
 )
@@ -19,24 +24,38 @@ type Handler interface {
 	GetSynonyms() http.HandlerFunc
 	GetHistory() http.HandlerFunc
 	Lookup() http.HandlerFunc
+	StreamDefinition() http.HandlerFunc
 }
 
 type handler struct {
-	logger  *zap.Logger
-	service word.Service
+	logger   *zap.Logger
+	service  word.Service
+	detector langdetect.Detector
 }
 
 func NewWordHandler(
 	logger *zap.Logger,
 	service word.Service,
+	detector langdetect.Detector,
 ) Handler {
 	return &handler{
-		logger:  logger,
-		service: service,
+		logger:   logger,
+		service:  service,
+		detector: detector,
 	}
 }
 
-var FailedToProcessWord = "Failed to process your word. Please make sure you remove any extra spaces and special characters and try again"
+// ErrInvalidRequest is the error for a request body that could not be decoded or validated.
+var ErrInvalidRequest = validation.New(validation.CodeInvalidWordRequest, "Failed to process your word. Please make sure you remove any extra spaces and special characters and try again")
+
+// decodeFailure returns the error to render for a request body that could not be decoded or validated.
+func decodeFailure(err error) error {
+	if languages.IsUnsupported(err) {
+		return languages.ErrUnsupported
+	}
+
+	return ErrInvalidRequest
+}
 
 func (h *handler) GetHistory() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -49,7 +68,7 @@ func (h *handler) GetHistory() http.HandlerFunc {
 			h.logger.Sugar().Warnw("failed to decode and validate word history request body",
 				"error", err)
 
-			render.Json(w, http.StatusBadRequest, FailedToProcessWord)
+			apierror.RenderValidation(w, decodeFailure(err), requestBody.NativeLanguage)
 
 			return
 		}
@@ -64,11 +83,13 @@ func (h *handler) GetHistory() http.HandlerFunc {
 				"word", spaceTrimmedWord,
 				"nativeLanguage", requestBody.NativeLanguage)
 
-			render.Json(w, http.StatusBadRequest, err.Error())
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
 
 			return
 		}
 
+		detected := h.detectLanguage(ctx, spaceTrimmedWord, requestBody.TargetLanguage)
+
 		response, err := h.service.GetWordHistory(ctx, spaceTrimmedWord, requestBody.NativeLanguage)
 		if err != nil {
 			h.logger.Sugar().Errorw(
@@ -76,11 +97,13 @@ func (h *handler) GetHistory() http.HandlerFunc {
 				"error", err,
 				"word", spaceTrimmedWord,
 				"nativeLanguage", requestBody.NativeLanguage)
-			render.Json(w, http.StatusInternalServerError, messages.InternalServerErrorMsg)
+			apierror.Render(w, err)
 
 			return
 		}
 
+		langdetect.SetHeaders(w, detected())
+
 		render.Json(w, http.StatusOK, response)
 	}
 }
@@ -96,7 +119,7 @@ func (h *handler) DefineWord() http.HandlerFunc {
 			h.logger.Sugar().Warnw("failed to decode and validate define word request body",
 				"error", err)
 
-			render.Json(w, http.StatusBadRequest, FailedToProcessWord)
+			apierror.RenderValidation(w, decodeFailure(err), requestBody.NativeLanguage)
 
 			return
 		}
@@ -110,11 +133,13 @@ func (h *handler) DefineWord() http.HandlerFunc {
 				"error", err,
 				"word", spaceTrimmedWord,
 				"nativeLanguage", requestBody.NativeLanguage)
-			render.Json(w, http.StatusBadRequest, err.Error())
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
 
 			return
 		}
 
+		detected := h.detectLanguage(ctx, spaceTrimmedWord, requestBody.TargetLanguage)
+
 		response, err := h.service.GetWordDefinition(ctx, spaceTrimmedWord, requestBody.NativeLanguage)
 		if err != nil {
 			h.logger.Sugar().Errorw(
@@ -122,11 +147,13 @@ func (h *handler) DefineWord() http.HandlerFunc {
 				"error", err,
 				"word", spaceTrimmedWord,
 				"nativeLanguage", requestBody.NativeLanguage)
-			render.Json(w, http.StatusInternalServerError, messages.InternalServerErrorMsg)
+			apierror.Render(w, err)
 
 			return
 		}
 
+		langdetect.SetHeaders(w, detected())
+
 		render.Json(w, http.StatusOK, response)
 	}
 }
@@ -143,7 +170,7 @@ func (h *handler) GetSynonyms() http.HandlerFunc {
 			h.logger.Sugar().Warnw("failed to decode and validate define word request body",
 				"error", err)
 
-			render.Json(w, http.StatusBadRequest, FailedToProcessWord)
+			apierror.RenderValidation(w, decodeFailure(err), requestBody.NativeLanguage)
 
 			return
 		}
@@ -156,11 +183,13 @@ func (h *handler) GetSynonyms() http.HandlerFunc {
 				"failed to validate word",
 				"error", err,
 				"word", spaceTrimmedWord)
-			render.Json(w, http.StatusBadRequest, err.Error())
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
 
 			return
 		}
 
+		detected := h.detectLanguage(ctx, spaceTrimmedWord, requestBody.TargetLanguage)
+
 		response, err := h.service.GetWordSynonyms(ctx, spaceTrimmedWord, requestBody.NativeLanguage)
 		if err != nil {
 			h.logger.Sugar().Errorw("failed to get word synonyms",
@@ -169,11 +198,13 @@ func (h *handler) GetSynonyms() http.HandlerFunc {
 				"word", spaceTrimmedWord,
 				"nativeLanguage", requestBody.NativeLanguage)
 
-			render.Json(w, http.StatusInternalServerError, messages.InternalServerErrorMsg)
+			apierror.Render(w, err)
 
 			return
 		}
 
+		langdetect.SetHeaders(w, detected())
+
 		render.Json(w, http.StatusOK, response)
 	}
 }
@@ -189,7 +220,7 @@ func (h *handler) Lookup() http.HandlerFunc {
 			h.logger.Sugar().Warnw("failed to decode and validate word request body",
 				"error", err)
 
-			render.Json(w, http.StatusBadRequest, FailedToProcessWord)
+			apierror.RenderValidation(w, decodeFailure(err), requestBody.NativeLanguage)
 
 			return
 		}
@@ -204,11 +235,13 @@ func (h *handler) Lookup() http.HandlerFunc {
 				"word", spaceTrimmedWord,
 				"nativeLanguage", requestBody.NativeLanguage)
 
-			render.Json(w, http.StatusBadRequest, err.Error())
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
 
 			return
 		}
 
+		detected := h.detectLanguage(ctx, spaceTrimmedWord, requestBody.TargetLanguage)
+
 		response, err := h.service.Lookup(ctx, spaceTrimmedWord, requestBody.NativeLanguage)
 		if err != nil {
 			h.logger.Sugar().Errorw(
@@ -216,11 +249,94 @@ func (h *handler) Lookup() http.HandlerFunc {
 				"error", err,
 				"word", spaceTrimmedWord,
 				"nativeLanguage", requestBody.NativeLanguage)
-			render.Json(w, http.StatusInternalServerError, messages.InternalServerErrorMsg)
+			apierror.Render(w, err)
 
 			return
 		}
 
-		render.Json(w, http.StatusOK, mapper.MapToLookUpResponse(response))
+		sourceLanguage := detected()
+		langdetect.SetHeaders(w, sourceLanguage)
+
+		lookup := mapper.MapToLookUpResponse(response)
+		lookup.SourceLanguage = mapper.MapToSourceLanguageResponse(sourceLanguage)
+
+		render.Json(w, http.StatusOK, lookup)
+	}
+}
+
+// StreamDefinition relays the word definition to the browser as Server-Sent Events while it is generated.
+func (h *handler) StreamDefinition() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var requestBody dto.WordRequest
+
+		// Validates and decodes request
+		if err := request.DecodeAndValidate(r.Body, &requestBody); err != nil {
+			h.logger.Sugar().Warnw("failed to decode and validate stream definition request body",
+				"error", err)
+
+			apierror.RenderValidation(w, decodeFailure(err), requestBody.NativeLanguage)
+
+			return
+		}
+
+		spaceTrimmedWord := strings.TrimSpace(requestBody.Word)
+
+		err := h.service.ValidateWord(spaceTrimmedWord)
+		if err != nil {
+			h.logger.Sugar().Infow(
+				"failed to validate word",
+				"error", err,
+				"word", spaceTrimmedWord,
+				"nativeLanguage", requestBody.NativeLanguage)
+			apierror.RenderValidation(w, err, requestBody.NativeLanguage)
+
+			return
+		}
+
+		// Headers must be set before the first event, so they only report the guess. The done event reports
+		// the detected language.
+		h.guessLanguage(w, spaceTrimmedWord, requestBody.TargetLanguage)
+		detected := h.detectLanguage(ctx, spaceTrimmedWord, requestBody.TargetLanguage)
+
+		stream.Relay(w, h.logger, detected, func(onDelta func(delta string) error) (*string, error) {
+			response, err := h.service.StreamWordDefinition(ctx, spaceTrimmedWord, requestBody.NativeLanguage, onDelta)
+			if err != nil {
+				h.logger.Sugar().Errorw(
+					"failed to stream word definition",
+					"error", err,
+					"word", spaceTrimmedWord,
+					"nativeLanguage", requestBody.NativeLanguage)
+			}
+
+			return response, err
+		})
+	}
+}
+
+// guessLanguage reports the language of word in the headers of w, as far as it can be told without the model.
+func (h *handler) guessLanguage(w http.ResponseWriter, word, targetLanguage string) {
+	langdetect.SetHeaders(w, h.detector.Guess(word, targetLanguage))
+}
+
+// detectLanguage detects the language of word while the request is answered, and returns a function waiting for
+// it. The response does not depend on it, so a failed detection is only logged and the function returns nil.
+func (h *handler) detectLanguage(ctx context.Context, word, targetLanguage string) func() *langdetect.Detection {
+	detected := make(chan *langdetect.Detection, 1)
+
+	go func() {
+		detection, err := h.detector.Detect(ctx, word, targetLanguage)
+		if err != nil {
+			h.logger.Sugar().Warnw("failed to detect word language",
+				"error", err,
+				"word", word)
+		}
+
+		detected <- detection
+	}()
+
+	return func() *langdetect.Detection {
+		return <-detected
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -16,17 +17,33 @@ import (
 
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word/dto"
-	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
+	langdetectmock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect/mock"
+	wordservice "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
 	wordmock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/messages"
 )
 
+// newDetector returns a Detector finding English in every word.
+func newDetector(ctrl *gomock.Controller) langdetect.Detector {
+	detector := langdetectmock.NewMockDetector(ctrl)
+	detector.EXPECT().Detect(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&langdetect.Detection{Language: "en", Confidence: 0.97, Method: langdetect.MethodModel}, nil).AnyTimes()
+	detector.EXPECT().Guess(gomock.Any(), gomock.Any()).
+		Return(&langdetect.Detection{Language: "en", Confidence: 0.97, Method: langdetect.MethodWords}).AnyTimes()
+
+	return detector
+}
+
 func TestDefineWordHandler(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockService := wordmock.NewMockService(ctrl)
 	mockLogger := zaptest.NewLogger(t)
-	handler := word.NewWordHandler(mockLogger, mockService)
+	handler := word.NewWordHandler(mockLogger, mockService, newDetector(ctrl))
 
 	r := chi.NewRouter()
 	r.Post("/api/v1/word/definition", handler.DefineWord())
@@ -38,6 +55,16 @@ func TestDefineWordHandler(t *testing.T) {
 		expectedStatus int
 		expectedBody   string
 	}{
+		{
+			name: "unsupported native language",
+			requestBody: dto.WordRequest{
+				Word:           "hello",
+				NativeLanguage: "Klingon",
+			},
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"code":"unsupported_language","message":"Please choose one of the supported languages."}`,
+		},
 		{
 			name: "empty word",
 			requestBody: dto.WordRequest{
@@ -45,10 +72,22 @@ func TestDefineWordHandler(t *testing.T) {
 				NativeLanguage: "english",
 			},
 			mockSetup: func() {
-				mockService.EXPECT().ValidateWord("").Return(errors.New("please provide a word"))
+				mockService.EXPECT().ValidateWord("").Return(wordservice.ErrEmptyWord)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"code":"empty_word","message":"Please provide a word."}`,
+		},
+		{
+			name: "empty word in the native language",
+			requestBody: dto.WordRequest{
+				Word:           "",
+				NativeLanguage: "French",
+			},
+			mockSetup: func() {
+				mockService.EXPECT().ValidateWord("").Return(wordservice.ErrEmptyWord)
 			},
 			expectedStatus: http.StatusBadRequest,
-			expectedBody:   "please provide a word",
+			expectedBody:   `{"code":"empty_word","message":"Veuillez saisir un mot."}`,
 		},
 		{
 			name: "valid word but API fails",
@@ -60,8 +99,22 @@ func TestDefineWordHandler(t *testing.T) {
 				mockService.EXPECT().ValidateWord("hello").Return(nil)
 				mockService.EXPECT().GetWordDefinition(gomock.Any(), "hello", "english").Return(nil, errors.New("api error"))
 			},
-			expectedStatus: http.StatusBadRequest,
-			expectedBody:   "Failed to process your word",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   messages.InternalServerErrorMsg,
+		},
+		{
+			name: "valid word but AI provider unavailable",
+			requestBody: dto.WordRequest{
+				Word:           "hello",
+				NativeLanguage: "english",
+			},
+			mockSetup: func() {
+				mockService.EXPECT().ValidateWord("hello").Return(nil)
+				mockService.EXPECT().GetWordDefinition(gomock.Any(), "hello", "english").
+					Return(nil, fmt.Errorf("failed to make open ai request: %w", openaierrors.ErrProviderUnavailable))
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   messages.AIProviderUnavailableMsg,
 		},
 		{
 			name: "successful definition",
@@ -71,15 +124,8 @@ func TestDefineWordHandler(t *testing.T) {
 			},
 			mockSetup: func() {
 				mockService.EXPECT().ValidateWord("hello").Return(nil)
-				mockService.EXPECT().GetWordDefinition(gomock.Any(), "hello", "english").Return(&openai.ChatCompletion{
-					Choices: []openai.Choice{
-						{
-							Message: openai.Message{
-								Content: "Definition of hello",
-							},
-						},
-					},
-				}, nil)
+				definition := "Definition of hello"
+				mockService.EXPECT().GetWordDefinition(gomock.Any(), "hello", "english").Return(&definition, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody:   "Definition of hello",
@@ -102,3 +148,218 @@ func TestDefineWordHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamDefinitionHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := wordmock.NewMockService(ctrl)
+	mockLogger := zaptest.NewLogger(t)
+	handler := word.NewWordHandler(mockLogger, mockService, newDetector(ctrl))
+
+	r := chi.NewRouter()
+	r.Post("/api/v4/word/definition/stream", handler.StreamDefinition())
+
+	testCases := []struct {
+		name                string
+		mockSetup           func()
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        []string
+	}{
+		{
+			name: "relays tokens then the full definition",
+			mockSetup: func() {
+				mockService.EXPECT().ValidateWord("hello").Return(nil)
+				mockService.EXPECT().StreamWordDefinition(gomock.Any(), "hello", "english", gomock.Any()).
+					DoAndReturn(func(_ any, _, _ string, onDelta func(string) error) (*string, error) {
+						_ = onDelta("A ")
+						_ = onDelta("greeting")
+						definition := "A greeting"
+
+						return &definition, nil
+					})
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/event-stream",
+			expectedBody: []string{
+				"event: token\ndata: {\"delta\":\"A \"}\n\n",
+				"event: token\ndata: {\"delta\":\"greeting\"}\n\n",
+				"event: done\ndata: {\"content\":\"A greeting\",\"sourceLanguage\":{\"language\":\"en\",\"confidence\":0.97,\"method\":\"model\"}}\n\n",
+			},
+		},
+		{
+			name: "fails before the first token",
+			mockSetup: func() {
+				mockService.EXPECT().ValidateWord("hello").Return(nil)
+				mockService.EXPECT().StreamWordDefinition(gomock.Any(), "hello", "english", gomock.Any()).
+					Return(nil, openaierrors.ErrProviderUnavailable)
+			},
+			expectedStatus:      http.StatusServiceUnavailable,
+			expectedContentType: "application/json",
+			expectedBody:        []string{messages.AIProviderUnavailableMsg},
+		},
+		{
+			name: "fails mid stream",
+			mockSetup: func() {
+				mockService.EXPECT().ValidateWord("hello").Return(nil)
+				mockService.EXPECT().StreamWordDefinition(gomock.Any(), "hello", "english", gomock.Any()).
+					DoAndReturn(func(_ any, _, _ string, onDelta func(string) error) (*string, error) {
+						_ = onDelta("A ")
+						return nil, errors.New("connection reset")
+					})
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/event-stream",
+			expectedBody: []string{
+				"event: token\ndata: {\"delta\":\"A \"}\n\n",
+				"event: error\ndata: {\"status\":500,\"message\":\"" + messages.InternalServerErrorMsg + "\"}\n\n",
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			body, _ := json.Marshal(dto.WordRequest{Word: "hello", NativeLanguage: "english"})
+			req := httptest.NewRequest(http.MethodPost, "/api/v4/word/definition/stream", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expectedContentType, rr.Header().Get("Content-Type"))
+
+			for _, expected := range tt.expectedBody {
+				assert.Contains(t, rr.Body.String(), expected)
+			}
+		})
+	}
+}
+
+func TestLookupHandlerReportsSourceLanguage(t *testing.T) {
+	testCases := []struct {
+		name           string
+		targetLanguage string
+		detection      *langdetect.Detection
+		detectionErr   error
+		expectedHeader string
+		expectedBody   string
+	}{
+		{
+			name:           "detected",
+			detection:      &langdetect.Detection{Language: "ja", Confidence: 0.99, Method: langdetect.MethodScript},
+			expectedHeader: "ja",
+			expectedBody:   `"sourceLanguage":{"language":"ja","confidence":0.99,"method":"script"}`,
+		},
+		{
+			name:           "given by the client",
+			targetLanguage: "Japanese",
+			detection:      &langdetect.Detection{Language: "ja", Confidence: 1, Method: langdetect.MethodRequest},
+			expectedHeader: "ja",
+			expectedBody:   `"sourceLanguage":{"language":"ja","confidence":1,"method":"request"}`,
+		},
+		{
+			name:         "detection fails",
+			detectionErr: openaierrors.ErrProviderUnavailable,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockService := wordmock.NewMockService(ctrl)
+			mockService.EXPECT().ValidateWord("猫").Return(nil)
+			mockService.EXPECT().Lookup(gomock.Any(), "猫", "english").Return(&domain.LookupDetails{}, nil)
+
+			detector := langdetectmock.NewMockDetector(ctrl)
+			detector.EXPECT().Detect(gomock.Any(), "猫", tt.targetLanguage).Return(tt.detection, tt.detectionErr)
+
+			r := chi.NewRouter()
+			r.Post("/api/v4/word/lookup", word.NewWordHandler(zaptest.NewLogger(t), mockService, detector).Lookup())
+
+			body, _ := json.Marshal(dto.WordRequest{Word: "猫", NativeLanguage: "english", TargetLanguage: tt.targetLanguage})
+			req := httptest.NewRequest(http.MethodPost, "/api/v4/word/lookup", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, tt.expectedHeader, rr.Header().Get(langdetect.HeaderSourceLanguage))
+
+			if tt.expectedBody == "" {
+				assert.NotContains(t, rr.Body.String(), "sourceLanguage")
+			} else {
+				assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestTextHandlersReportDetectedLanguage(t *testing.T) {
+	testCases := []struct {
+		name    string
+		path    string
+		handler func(word.Handler) http.HandlerFunc
+		mock    func(service *wordmock.MockService, response *string)
+	}{
+		{
+			name:    "definition",
+			path:    "/api/v4/word/definition",
+			handler: word.Handler.DefineWord,
+			mock: func(service *wordmock.MockService, response *string) {
+				service.EXPECT().GetWordDefinition(gomock.Any(), "chat", "english").Return(response, nil)
+			},
+		},
+		{
+			name:    "synonyms",
+			path:    "/api/v4/word/synonyms",
+			handler: word.Handler.GetSynonyms,
+			mock: func(service *wordmock.MockService, response *string) {
+				service.EXPECT().GetWordSynonyms(gomock.Any(), "chat", "english").Return(response, nil)
+			},
+		},
+		{
+			name:    "history",
+			path:    "/api/v4/word/history",
+			handler: word.Handler.GetHistory,
+			mock: func(service *wordmock.MockService, response *string) {
+				service.EXPECT().GetWordHistory(gomock.Any(), "chat", "english").Return(response, nil)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockService := wordmock.NewMockService(ctrl)
+			mockService.EXPECT().ValidateWord("chat").Return(nil)
+			response := "A small feline."
+			tt.mock(mockService, &response)
+
+			// "chat" could be English or French, so the model settles it.
+			detector := langdetectmock.NewMockDetector(ctrl)
+			detector.EXPECT().Detect(gomock.Any(), "chat", "").
+				Return(&langdetect.Detection{Language: "fr", Confidence: 0.8, Method: langdetect.MethodModel}, nil)
+
+			r := chi.NewRouter()
+			r.Post(tt.path, tt.handler(word.NewWordHandler(zaptest.NewLogger(t), mockService, detector)))
+
+			body, _ := json.Marshal(dto.WordRequest{Word: "chat", NativeLanguage: "english"})
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, "fr", rr.Header().Get(langdetect.HeaderSourceLanguage))
+			assert.Equal(t, "0.80", rr.Header().Get(langdetect.HeaderSourceLanguageConfidence))
+		})
+	}
+}
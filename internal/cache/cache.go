@@ -0,0 +1,17 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrCacheMiss = errors.New("cache miss")
+
+// Cache is a byte cache shared by the services. Get returns ErrCacheMiss when the key is not cached.
+//
+//go:generate mockgen -source=cache.go -destination=mock/cache.go
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
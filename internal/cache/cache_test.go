@@ -0,0 +1,97 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/coocood/freecache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache/cachetest"
+)
+
+func TestCaches(t *testing.T) {
+	testCases := []struct {
+		name     string
+		newCache func(t *testing.T) cache.Cache
+	}{
+		{
+			name: "freecache",
+			newCache: func(t *testing.T) cache.Cache {
+				return cache.NewFreecache(freecache.NewCache(1024 * 1024))
+			},
+		},
+		{
+			name: "redis",
+			newCache: func(t *testing.T) cache.Cache {
+				redisCache, _ := cachetest.NewRedis(t)
+				return redisCache
+			},
+		},
+		{
+			name: "tiered",
+			newCache: func(t *testing.T) cache.Cache {
+				redisCache, _ := cachetest.NewRedis(t)
+				return cache.NewTiered(cache.NewFreecache(freecache.NewCache(1024*1024)), redisCache, time.Minute)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := tc.newCache(t)
+
+			_, err := c.Get(ctx, "cat word definition in english")
+			assert.ErrorIs(t, err, cache.ErrCacheMiss)
+
+			require.NoError(t, c.Set(ctx, "cat word definition in english", []byte("A small feline."), time.Hour))
+
+			value, err := c.Get(ctx, "cat word definition in english")
+			require.NoError(t, err)
+			assert.Equal(t, "A small feline.", string(value))
+		})
+	}
+}
+
+func TestRedisExpiresEntries(t *testing.T) {
+	ctx := context.Background()
+	redisCache, server := cachetest.NewRedis(t)
+
+	require.NoError(t, redisCache.Set(ctx, "cat", []byte("A small feline."), time.Minute))
+	assert.True(t, server.Exists("test:cat"))
+
+	server.FastForward(time.Minute + time.Second)
+
+	_, err := redisCache.Get(ctx, "cat")
+	assert.ErrorIs(t, err, cache.ErrCacheMiss)
+}
+
+func TestTieredSharesEntriesBetweenInstances(t *testing.T) {
+	ctx := context.Background()
+	remote, server := cachetest.NewRedis(t)
+
+	first := cache.NewTiered(cache.NewFreecache(freecache.NewCache(1024*1024)), remote, time.Minute)
+	secondLocal := cache.NewFreecache(freecache.NewCache(1024 * 1024))
+	second := cache.NewTiered(secondLocal, remote, time.Minute)
+
+	require.NoError(t, first.Set(ctx, "cat", []byte("A small feline."), time.Hour))
+
+	value, err := second.Get(ctx, "cat")
+	require.NoError(t, err)
+	assert.Equal(t, "A small feline.", string(value))
+
+	// The remote hit has been copied into the local tier, which keeps serving it while Redis is down.
+	server.Close()
+
+	value, err = secondLocal.Get(ctx, "cat")
+	require.NoError(t, err)
+	assert.Equal(t, "A small feline.", string(value))
+
+	value, err = second.Get(ctx, "cat")
+	require.NoError(t, err)
+	assert.Equal(t, "A small feline.", string(value))
+}
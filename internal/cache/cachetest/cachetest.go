@@ -0,0 +1,34 @@
+// Package cachetest runs the Redis backed caches against an in-memory Redis server, so they can be tested without a live one.
+package cachetest
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache"
+)
+
+// NewRedis returns a Redis Cache backed by a miniredis server that is stopped when the test ends.
+// The server is returned so tests can inspect keys or move time forward with FastForward.
+func NewRedis(t testing.TB) (cache.Cache, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := NewRedisClient(t, server)
+
+	return cache.NewRedis(client, "test:"), server
+}
+
+// NewRedisClient returns a client connected to server, closed when the test ends.
+func NewRedisClient(t testing.TB, server *miniredis.Miniredis) *redis.Client {
+	t.Helper()
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
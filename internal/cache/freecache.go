@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coocood/freecache"
+)
+
+type freecacheCache struct {
+	cache *freecache.Cache
+}
+
+// NewFreecache returns an in-process Cache. Entries are lost on restart and are not shared between instances.
+func NewFreecache(cache *freecache.Cache) Cache {
+	return &freecacheCache{
+		cache: cache,
+	}
+}
+
+func (c *freecacheCache) Get(_ context.Context, key string) ([]byte, error) {
+	value, err := c.cache.Get([]byte(key))
+	if errors.Is(err, freecache.ErrNotFound) {
+		return nil, ErrCacheMiss
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key from freecache: %w", err)
+	}
+
+	return value, nil
+}
+
+func (c *freecacheCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	err := c.cache.Set([]byte(key), value, int(ttl.Seconds()))
+	if err != nil {
+		return fmt.Errorf("failed to set key in freecache: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/coocood/freecache"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/config"
+)
+
+// Supported values for the CACHE_BACKEND setting.
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+	BackendTiered = "tiered"
+)
+
+// keyPrefix namespaces the keys of the API in a Redis server that may be shared with other applications.
+const keyPrefix = "aibou:"
+
+// NewProvider builds the Cache selected by cfg.CacheBackend. local is the in-process cache used by
+// the memory and tiered backends.
+func NewProvider(cfg *config.Config, local *freecache.Cache) (Cache, error) {
+	switch cfg.CacheBackend {
+	case BackendMemory, "":
+		return NewFreecache(local), nil
+	case BackendRedis, BackendTiered:
+		client, err := NewRedisClient(cfg.RedisURL)
+		if err != nil {
+			return nil, err
+		}
+
+		remote := NewRedis(client, keyPrefix)
+		if cfg.CacheBackend == BackendRedis {
+			return remote, nil
+		}
+
+		return NewTiered(NewFreecache(local), remote, cfg.CacheLocalTTL), nil
+	default:
+		return nil, fmt.Errorf("unknown cache backend %q", cfg.CacheBackend)
+	}
+}
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type tieredCache struct {
+	local    Cache
+	remote   Cache
+	localTTL time.Duration
+}
+
+// NewTiered returns a Cache that reads from local first and falls back to remote, copying remote hits into
+// local for at most localTTL. Writes go to both, so every instance benefits from a value generated by any of them.
+func NewTiered(local, remote Cache, localTTL time.Duration) Cache {
+	return &tieredCache{
+		local:    local,
+		remote:   remote,
+		localTTL: localTTL,
+	}
+}
+
+func (c *tieredCache) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.local.Get(ctx, key)
+	if err == nil {
+		return value, nil
+	}
+
+	value, err = c.remote.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// A failing local cache must not hide a remote hit.
+	_ = c.local.Set(ctx, key, value, c.localTTL)
+
+	return value, nil
+}
+
+func (c *tieredCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	localTTL := c.localTTL
+	if ttl > 0 && ttl < localTTL {
+		localTTL = ttl
+	}
+
+	localErr := c.local.Set(ctx, key, value, localTTL)
+	remoteErr := c.remote.Set(ctx, key, value, ttl)
+
+	return errors.Join(localErr, remoteErr)
+}
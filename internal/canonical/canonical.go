@@ -0,0 +1,43 @@
+// Package canonical turns user input into the canonical form used in cache and storage keys,
+// so that "Cat", "cat" and "ｃａｔ" asked in "English" or "en" share one answer.
+package canonical
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+)
+
+// lower lowercases without folding, which would merge distinct words such as "Maße" and "Masse" by turning ß into ss.
+var lower = cases.Lower(language.Und)
+
+// Word canonicalizes a single word or short phrase: NFKC normalization (full-width and compatibility
+// characters become their plain form), lowercasing and whitespace collapse.
+// Lowercasing leaves scripts without case (kana, hanzi, hangul...) untouched.
+func Word(word string) string {
+	return collapseWhitespace(lower.String(norm.NFKC.String(word)))
+}
+
+// Sentence canonicalizes a sentence: NFC normalization and whitespace collapse only.
+// The case and the full-width punctuation of a sentence are kept, as they matter when correcting it.
+func Sentence(sentence string) string {
+	return collapseWhitespace(norm.NFC.String(sentence))
+}
+
+// Language returns the ISO 639-1 code of a language of the languages catalogue given by name, endonym,
+// or ISO 639-1/639-2 code, optionally with a region ("en-GB", "pt_BR"). Unknown languages are returned folded and trimmed.
+func Language(name string) string {
+	if language, ok := languages.Find(name); ok {
+		return language.Code
+	}
+
+	return languages.Fold(name)
+}
+
+func collapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,110 @@
+package canonical_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/canonical"
+)
+
+func TestWord(t *testing.T) {
+	testCases := []struct {
+		name     string
+		word     string
+		expected string
+	}{
+		{name: "lowercase", word: "cat", expected: "cat"},
+		{name: "title case", word: "Cat", expected: "cat"},
+		{name: "upper case", word: "CAT", expected: "cat"},
+		{name: "full-width latin", word: "ｃａｔ", expected: "cat"},
+		{name: "full-width upper case latin", word: "ＣＡＴ", expected: "cat"},
+		{name: "surrounding whitespace", word: "  cat\t", expected: "cat"},
+		{name: "inner whitespace", word: "ice   cream", expected: "ice cream"},
+		{name: "ideographic space", word: "ice　cream", expected: "ice cream"},
+		{name: "decomposed accent", word: "café", expected: "café"},
+		{name: "composed accent", word: "Café", expected: "café"},
+		{name: "german sharp s is kept", word: "Straße", expected: "straße"},
+		{name: "german capital sharp s", word: "STRAẞE", expected: "straße"},
+		{name: "greek final sigma", word: "ΛΌΓΟΣ", expected: "λόγος"},
+		{name: "cyrillic", word: "Привет", expected: "привет"},
+		{name: "kanji is untouched", word: "猫", expected: "猫"},
+		{name: "hiragana is untouched", word: "ねこ", expected: "ねこ"},
+		{name: "half-width katakana", word: "ﾈｺ", expected: "ネコ"},
+		{name: "hangul is untouched", word: "어떻게", expected: "어떻게"},
+		{name: "decomposed hangul", word: "가", expected: "가"},
+		{name: "arabic is untouched", word: "كتاب", expected: "كتاب"},
+		{name: "empty", word: "", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, canonical.Word(tc.word))
+		})
+	}
+}
+
+func TestWordKeepsSharpSAndDoubleSApart(t *testing.T) {
+	// "Maße" (measures) and "Masse" (mass) are different words, which case folding would merge.
+	assert.NotEqual(t, canonical.Word("Maße"), canonical.Word("Masse"))
+	assert.Equal(t, canonical.Word("Masse"), canonical.Word("MASSE"))
+	assert.Equal(t, canonical.Word("Maße"), canonical.Word("MAẞE"))
+}
+
+func TestSentence(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sentence string
+		expected string
+	}{
+		{name: "unchanged", sentence: "I like cats.", expected: "I like cats."},
+		{name: "case is kept", sentence: "i like cats.", expected: "i like cats."},
+		{name: "whitespace is collapsed", sentence: "  I  like\n cats. ", expected: "I like cats."},
+		{name: "decomposed accent is composed", sentence: "J'aime le café.", expected: "J'aime le café."},
+		{name: "full-width punctuation is kept", sentence: "猫が好きです！", expected: "猫が好きです！"},
+		{name: "full-width latin is kept", sentence: "ＡＢＣ", expected: "ＡＢＣ"},
+		{name: "empty", sentence: "", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, canonical.Sentence(tc.sentence))
+		})
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		language string
+		expected string
+	}{
+		{name: "english name", language: "English", expected: "en"},
+		{name: "lower case name", language: "english", expected: "en"},
+		{name: "upper case name", language: "ENGLISH", expected: "en"},
+		{name: "iso 639-1 code", language: "en", expected: "en"},
+		{name: "upper case code", language: "EN", expected: "en"},
+		{name: "iso 639-2 code", language: "eng", expected: "en"},
+		{name: "code with region", language: "en-GB", expected: "en"},
+		{name: "code with underscore region", language: "pt_BR", expected: "pt"},
+		{name: "code with script", language: "zh-Hant", expected: "zh"},
+		{name: "surrounding whitespace", language: " Japanese ", expected: "ja"},
+		{name: "endonym", language: "日本語", expected: "ja"},
+		{name: "endonym with accent", language: "Español", expected: "es"},
+		{name: "endonym without accent", language: "espanol", expected: "es"},
+		{name: "cyrillic endonym", language: "Русский", expected: "ru"},
+		{name: "hangul endonym", language: "한국어", expected: "ko"},
+		{name: "multi word name", language: "Bahasa  Indonesia", expected: "id"},
+		{name: "bibliographic code", language: "ger", expected: "de"},
+		{name: "alternative name", language: "Mandarin", expected: "zh"},
+		{name: "full-width name", language: "Ｆｒｅｎｃｈ", expected: "fr"},
+		{name: "unknown language", language: " Klingon ", expected: "klingon"},
+		{name: "empty", language: "", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, canonical.Language(tc.language))
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package openai
+
+import (
+	"sync"
+	"time"
+)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// circuitBreaker stops sending requests to a provider that keeps failing.
+// After failureThreshold consecutive failures it opens and rejects every call for openTimeout,
+// then lets a single probe request through. A successful probe closes it again.
+type circuitBreaker struct {
+	mu                  sync.Mutex
+	failureThreshold    int
+	openTimeout         time.Duration
+	state               breakerState
+	consecutiveFailures int
+	openedAt            time.Time
+	probeInFlight       bool
+	now                 func() time.Time
+}
+
+func newCircuitBreaker(failureThreshold int, openTimeout time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		now:              time.Now,
+	}
+}
+
+// Allow reports whether a request may be sent to the provider.
+func (b *circuitBreaker) Allow() bool {
+	if b.failureThreshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return false
+		}
+
+		b.state = breakerHalfOpen
+		b.probeInFlight = true
+
+		return true
+	case breakerHalfOpen:
+		if b.probeInFlight {
+			return false
+		}
+
+		b.probeInFlight = true
+
+		return true
+	default:
+		return true
+	}
+}
+
+// Success records a request the provider answered.
+func (b *circuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.consecutiveFailures = 0
+	b.probeInFlight = false
+}
+
+// Failure records a request the provider could not serve.
+func (b *circuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures++
+	b.probeInFlight = false
+
+	if b.state == breakerHalfOpen || b.consecutiveFailures >= b.failureThreshold {
+		b.state = breakerOpen
+		b.openedAt = b.now()
+	}
+}
+
+// Release gives back a probe slot without counting the outcome, e.g. when the caller cancelled the request.
+func (b *circuitBreaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probeInFlight = false
+}
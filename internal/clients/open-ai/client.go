@@ -1,18 +1,32 @@
 package openai
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
+
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
 )
 
+const defaultBaseURL = "https://api.openai.com/v1"
+
+// Client is the provider-agnostic interface the services use to talk to an LLM.
+// Implementations take a typed chat request and return the parsed completion, including token usage.
+//
 //go:generate mockgen -source=client.go -destination=mock/client.go
 type Client interface {
-	MakeRequest(ctx context.Context, body io.Reader) (*http.Response, []byte, error)
+	CreateChatCompletion(ctx context.Context, request *OpenAIRequest) (*ChatCompletion, error)
+	// CreateChatCompletionStream streams the completion, calling onDelta with every chunk of text as it arrives.
+	// The assembled completion is returned once the stream ends.
+	CreateChatCompletionStream(ctx context.Context, request *OpenAIRequest, onDelta func(delta string) error) (*ChatCompletion, error)
 }
 
 type (
@@ -21,6 +35,26 @@ type (
 		Messages    []Message `json:"messages"`
 		Temperature float32   `json:"temperature"`
 		MaxTokens   int       `json:"max_tokens"`
+
+		Stream         bool            `json:"stream,omitempty"`
+		StreamOptions  *StreamOptions  `json:"stream_options,omitempty"`
+		ResponseFormat *ResponseFormat `json:"response_format,omitempty"`
+	}
+
+	// ResponseFormat constrains the completion. With Type "json_schema" the model has to answer with JSON matching JSONSchema.
+	ResponseFormat struct {
+		Type       string      `json:"type"`
+		JSONSchema *JSONSchema `json:"json_schema,omitempty"`
+	}
+
+	JSONSchema struct {
+		Name   string          `json:"name"`
+		Strict bool            `json:"strict"`
+		Schema json.RawMessage `json:"schema"`
+	}
+
+	StreamOptions struct {
+		IncludeUsage bool `json:"include_usage"`
 	}
 
 	ChatCompletion struct {
@@ -52,34 +86,177 @@ type (
 	}
 )
 
+// NewJSONSchemaFormat returns a strict json_schema response format.
+func NewJSONSchemaFormat(name string, schema json.RawMessage) *ResponseFormat {
+	return &ResponseFormat{
+		Type: "json_schema",
+		JSONSchema: &JSONSchema{
+			Name:   name,
+			Strict: true,
+			Schema: schema,
+		},
+	}
+}
+
+// Content returns the message content of the first choice.
+func (c *ChatCompletion) Content() string {
+	if c == nil || len(c.Choices) == 0 {
+		return ""
+	}
+
+	return c.Choices[0].Message.Content
+}
+
 type openAiClient struct {
-	Key    string
-	logger *zap.Logger
+	Key        string
+	baseURL    string
+	model      string
+	logger     *zap.Logger
+	settings   Settings
+	httpClient *http.Client
+	breaker    *circuitBreaker
 }
 
+// NewClient returns a Client that talks to the OpenAI chat completions API using DefaultSettings.
 func NewClient(apiKey string, logger *zap.Logger) Client {
+	return NewClientWithSettings(apiKey, DefaultSettings, logger)
+}
+
+// NewClientWithSettings returns a Client that talks to the OpenAI chat completions API.
+func NewClientWithSettings(apiKey string, settings Settings, logger *zap.Logger) Client {
+	return newOpenAiClient(defaultBaseURL, apiKey, "", settings, logger)
+}
+
+// NewCompatibleClient returns a Client for any server that speaks the OpenAI chat completions protocol
+// (llama.cpp, vLLM, Ollama...). The api key is optional. When model is set it replaces the model
+// requested by the services, as local servers rarely serve "gpt-4o".
+func NewCompatibleClient(baseURL, apiKey, model string, settings Settings, logger *zap.Logger) Client {
+	return newOpenAiClient(strings.TrimRight(baseURL, "/"), apiKey, model, settings, logger)
+}
+
+func newOpenAiClient(baseURL, apiKey, model string, settings Settings, logger *zap.Logger) *openAiClient {
+	settings = settings.withDefaults()
+
 	return &openAiClient{
-		Key:    apiKey,
-		logger: logger,
+		Key:      apiKey,
+		baseURL:  baseURL,
+		model:    model,
+		logger:   logger,
+		settings: settings,
+		httpClient: &http.Client{
+			Timeout:   settings.Timeout,
+			Transport: sharedTransport,
+		},
+		breaker: newCircuitBreaker(settings.BreakerFailureThreshold, settings.BreakerOpenTimeout),
 	}
 }
 
-func (c openAiClient) MakeRequest(ctx context.Context, body io.Reader) (*http.Response, []byte, error) {
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.openai.com/v1/chat/completions", body)
+func (c *openAiClient) CreateChatCompletion(ctx context.Context, request *OpenAIRequest) (*ChatCompletion, error) {
+	if c.model != "" {
+		overridden := *request
+		overridden.Model = c.model
+		request = &overridden
+	}
+
+	body, err := json.Marshal(request)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create post request : %w", err)
+		return nil, fmt.Errorf("failed to marshal chat completion request: %w", err)
 	}
 
-	req.Header.Add("Content-Type", `application/json`)
-	req.Header.Add("Authorization", `Bearer `+c.Key)
+	var responseBody []byte
 
-	client := &http.Client{
-		Timeout: time.Second * 45,
+	err = c.withRetries(ctx, func() error {
+		responseBody, err = c.makeRequest(ctx, body)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := client.Do(req)
+	var completion ChatCompletion
+
+	err = json.Unmarshal(responseBody, &completion)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to make request to OpenAI API : %w", err)
+		return nil, fmt.Errorf("failed to unmarshal chat completion responseBody=%s: %w", responseBody, err)
+	}
+
+	if len(completion.Choices) == 0 {
+		return nil, openaierrors.ErrNoChoicesFound
+	}
+
+	return &completion, nil
+}
+
+// withRetries runs attempt behind the circuit breaker, retrying retryable failures with backoff.
+// Once the retries are exhausted the error is wrapped with ErrProviderUnavailable.
+func (c *openAiClient) withRetries(ctx context.Context, attempt func() error) error {
+	if !c.breaker.Allow() {
+		return openaierrors.ErrProviderUnavailable
+	}
+
+	var err error
+
+	for i := 0; ; i++ {
+		err = attempt()
+		if err == nil {
+			c.breaker.Success()
+			return nil
+		}
+
+		if !openaierrors.IsRetryable(err) || ctx.Err() != nil {
+			break
+		}
+
+		if i >= c.settings.MaxRetries {
+			c.breaker.Failure()
+			return fmt.Errorf("%w: giving up after %d attempts: %w", openaierrors.ErrProviderUnavailable, i+1, err)
+		}
+
+		var retryAfter time.Duration
+
+		var apiErr *openaierrors.APIError
+		if errors.As(err, &apiErr) {
+			retryAfter = apiErr.RetryAfter
+		}
+
+		if retryAfter > c.settings.RetryMaxDelay {
+			// Retrying sooner than asked would only be rate limited again.
+			c.logger.Warn("not retrying openai request, provider asked to wait too long",
+				zap.Duration("retryAfter", retryAfter),
+				zap.Error(err),
+			)
+
+			break
+		}
+
+		delay := c.settings.backoff(i, retryAfter)
+
+		c.logger.Warn("retrying openai request",
+			zap.Int("attempt", i+1),
+			zap.Duration("delay", delay),
+			zap.Error(err),
+		)
+
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			break
+		}
+	}
+
+	var apiErr *openaierrors.APIError
+	if errors.As(err, &apiErr) {
+		// The provider answered, it just did not like the request.
+		c.breaker.Success()
+	} else {
+		c.breaker.Release()
+	}
+
+	return err
+}
+
+func (c *openAiClient) makeRequest(ctx context.Context, body []byte) ([]byte, error) {
+	resp, err := c.doRequest(ctx, body)
+	if err != nil {
+		return nil, err
 	}
 
 	defer func(Body io.ReadCloser) {
@@ -88,8 +265,44 @@ func (c openAiClient) MakeRequest(ctx context.Context, body io.Reader) (*http.Re
 
 	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read AI response body %v: %w", resp.Body, err)
+		return nil, fmt.Errorf("failed to read AI response body: %w", err)
+	}
+
+	return responseBody, nil
+}
+
+// doRequest sends a chat completion request and returns the response if the provider accepted it.
+// The caller is responsible for closing the response body.
+func (c *openAiClient) doRequest(ctx context.Context, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create post request : %w", err)
+	}
+
+	req.Header.Add("Content-Type", `application/json`)
+
+	if c.Key != "" {
+		req.Header.Add("Authorization", `Bearer `+c.Key)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to OpenAI API : %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer func(Body io.ReadCloser) {
+			_ = Body.Close()
+		}(resp.Body)
+
+		responseBody, _ := io.ReadAll(resp.Body)
+
+		return nil, &openaierrors.APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(responseBody),
+			RetryAfter: parseRetryAfter(resp.Header, time.Now()),
+		}
 	}
 
-	return resp, responseBody, nil
+	return resp, nil
 }
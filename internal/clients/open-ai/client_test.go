@@ -0,0 +1,292 @@
+package openai_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+)
+
+func newChatRequest() *openai.OpenAIRequest {
+	return &openai.OpenAIRequest{
+		Model:       "gpt-4o",
+		Temperature: 0.4,
+		MaxTokens:   400,
+		Messages: []openai.Message{
+			{Role: "system", Content: "You are a helpful assistant."},
+			{Role: "user", Content: "Explain the meaning of 'cat'."},
+		},
+	}
+}
+
+func TestCompatibleClient(t *testing.T) {
+	testCases := []struct {
+		name            string
+		apiKey          string
+		model           string
+		statusCode      int
+		responseBody    string
+		expectedModel   string
+		expectedAuth    string
+		expectedContent string
+		expectedErr     error
+		expectErr       bool
+	}{
+		{
+			name:            "overrides the model and omits the auth header",
+			model:           "llama3",
+			statusCode:      http.StatusOK,
+			responseBody:    `{"choices":[{"message":{"role":"assistant","content":"A small feline."}}],"usage":{"prompt_tokens":3,"completion_tokens":4,"total_tokens":7}}`,
+			expectedModel:   "llama3",
+			expectedContent: "A small feline.",
+		},
+		{
+			name:            "keeps the requested model and sends the api key",
+			apiKey:          "secret",
+			statusCode:      http.StatusOK,
+			responseBody:    `{"choices":[{"message":{"role":"assistant","content":"A small feline."}}]}`,
+			expectedModel:   "gpt-4o",
+			expectedAuth:    "Bearer secret",
+			expectedContent: "A small feline.",
+		},
+		{
+			name:          "no choices",
+			statusCode:    http.StatusOK,
+			responseBody:  `{"choices":[]}`,
+			expectedModel: "gpt-4o",
+			expectedErr:   openaierrors.ErrNoChoicesFound,
+		},
+		{
+			name:          "non-OK status",
+			statusCode:    http.StatusBadRequest,
+			responseBody:  `{"error":{"message":"bad request"}}`,
+			expectedModel: "gpt-4o",
+			expectErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+				assert.Equal(t, tc.expectedAuth, r.Header.Get("Authorization"))
+
+				var body openai.OpenAIRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tc.expectedModel, body.Model)
+
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.responseBody))
+			}))
+			defer server.Close()
+
+			client := openai.NewCompatibleClient(server.URL+"/v1/", tc.apiKey, tc.model, openai.Settings{}, zaptest.NewLogger(t))
+
+			completion, err := client.CreateChatCompletion(context.Background(), newChatRequest())
+
+			switch {
+			case tc.expectedErr != nil:
+				assert.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectErr:
+				assert.Error(t, err)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedContent, completion.Content())
+			}
+		})
+	}
+}
+
+func TestFakeClientIsDeterministic(t *testing.T) {
+	client := openai.NewFakeClient(zaptest.NewLogger(t))
+
+	first, err := client.CreateChatCompletion(context.Background(), newChatRequest())
+	require.NoError(t, err)
+
+	second, err := client.CreateChatCompletion(context.Background(), newChatRequest())
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Contains(t, first.Content(), "Explain the meaning of 'cat'.")
+	assert.Equal(t, first.Usage.PromptTokens+first.Usage.CompletionTokens, first.Usage.TotalTokens)
+}
+
+func TestClientRetriesRetryableErrors(t *testing.T) {
+	testCases := []struct {
+		name             string
+		statuses         []int
+		retryAfterMs     string
+		expectedAttempts int32
+		expectedErr      error
+		expectErr        bool
+	}{
+		{
+			name:             "recovers after a rate limit and a server error",
+			statuses:         []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusOK},
+			retryAfterMs:     "1",
+			expectedAttempts: 3,
+		},
+		{
+			name:             "gives up when asked to wait longer than the longest delay",
+			statuses:         []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfterMs:     "60000",
+			expectedAttempts: 1,
+			expectErr:        true,
+		},
+		{
+			name:             "gives up once the retries are exhausted",
+			statuses:         []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			expectedAttempts: 3,
+			expectedErr:      openaierrors.ErrProviderUnavailable,
+		},
+		{
+			name:             "does not retry a bad request",
+			statuses:         []int{http.StatusBadRequest, http.StatusOK},
+			expectedAttempts: 1,
+			expectErr:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tc.statuses[attempts.Add(1)-1]
+				if status == http.StatusTooManyRequests {
+					w.Header().Set("retry-after-ms", tc.retryAfterMs)
+				}
+
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"ok"}}]}`))
+			}))
+			defer server.Close()
+
+			settings := openai.Settings{
+				MaxRetries:     2,
+				RetryBaseDelay: time.Millisecond,
+				RetryMaxDelay:  time.Millisecond * 5,
+			}
+			client := openai.NewCompatibleClient(server.URL, "", "", settings, zaptest.NewLogger(t))
+
+			_, err := client.CreateChatCompletion(context.Background(), newChatRequest())
+
+			switch {
+			case tc.expectedErr != nil:
+				assert.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectErr:
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, openaierrors.ErrProviderUnavailable)
+			default:
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedAttempts, attempts.Load())
+		})
+	}
+}
+
+func TestClientCircuitBreakerFailsFast(t *testing.T) {
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	settings := openai.Settings{
+		MaxRetries:              0,
+		BreakerFailureThreshold: 2,
+		BreakerOpenTimeout:      time.Hour,
+	}
+	client := openai.NewCompatibleClient(server.URL, "", "", settings, zaptest.NewLogger(t))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.CreateChatCompletion(context.Background(), newChatRequest())
+		assert.ErrorIs(t, err, openaierrors.ErrProviderUnavailable)
+	}
+
+	_, err := client.CreateChatCompletion(context.Background(), newChatRequest())
+	assert.ErrorIs(t, err, openaierrors.ErrProviderUnavailable)
+	assert.Equal(t, int32(2), attempts.Load(), "an open breaker must not reach the provider")
+}
+
+func TestClientStreamsCompletion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body openai.OpenAIRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.True(t, body.Stream)
+		require.NotNil(t, body.StreamOptions)
+		assert.True(t, body.StreamOptions.IncludeUsage)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(`data: {"id":"c1","model":"gpt-4o","choices":[{"index":0,"delta":{"role":"assistant","content":"A small"}}]}
+
+data: {"id":"c1","model":"gpt-4o","choices":[{"index":0,"delta":{"content":" feline."},"finish_reason":"stop"}]}
+
+data: {"id":"c1","model":"gpt-4o","choices":[],"usage":{"prompt_tokens":3,"completion_tokens":4,"total_tokens":7}}
+
+data: [DONE]
+
+`))
+	}))
+	defer server.Close()
+
+	client := openai.NewCompatibleClient(server.URL, "", "", openai.Settings{}, zaptest.NewLogger(t))
+
+	var deltas []string
+
+	completion, err := client.CreateChatCompletionStream(context.Background(), newChatRequest(), func(delta string) error {
+		deltas = append(deltas, delta)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"A small", " feline."}, deltas)
+	assert.Equal(t, "A small feline.", completion.Content())
+	assert.Equal(t, "stop", completion.Choices[0].FinishReason)
+	assert.Equal(t, 7, completion.Usage.TotalTokens)
+}
+
+func TestClientRejectsIncompleteStreams(t *testing.T) {
+	testCases := map[string]string{
+		"connection closed": `data: {"id":"c1","model":"gpt-4o","choices":[{"index":0,"delta":{"content":"A small"}}]}
+
+`,
+		"token limit reached": `data: {"id":"c1","model":"gpt-4o","choices":[{"index":0,"delta":{"content":"A small"}}]}
+
+data: {"id":"c1","model":"gpt-4o","choices":[{"index":0,"delta":{"content":" fel"},"finish_reason":"length"}]}
+
+data: [DONE]
+
+`,
+	}
+
+	for name, stream := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				_, _ = w.Write([]byte(stream))
+			}))
+			defer server.Close()
+
+			client := openai.NewCompatibleClient(server.URL, "", "", openai.Settings{}, zaptest.NewLogger(t))
+
+			_, err := client.CreateChatCompletionStream(context.Background(), newChatRequest(), func(string) error {
+				return nil
+			})
+			assert.ErrorIs(t, err, openaierrors.ErrIncompleteStream)
+		})
+	}
+}
@@ -1,5 +1,75 @@
 package errors
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
 
-var ErrNoChoicesFound = errors.New("openai api response contains no choices")
+var (
+	ErrNoChoicesFound = errors.New("openai api response contains no choices")
+
+	// ErrInvalidStructuredOutput is returned when a structured completion is not the JSON it was asked for, or
+	// misses some of its required values.
+	ErrInvalidStructuredOutput = errors.New("ai response does not match the expected structure")
+
+	// ErrIncompleteStream is returned when a streamed completion ends before the answer is finished, because the
+	// connection closed early or the answer was cut off, e.g. at the token limit.
+	ErrIncompleteStream = errors.New("openai completion stream ended before the answer was finished")
+
+	// ErrProviderUnavailable is returned when the provider keeps failing or the circuit breaker is open.
+	// Handlers map it to a 503 so the frontend can tell the user to retry later.
+	ErrProviderUnavailable = errors.New("ai provider unavailable")
+)
+
+// APIError is returned when the provider answers with a non-OK status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+	// RetryAfter is how long the provider asked us to wait before retrying, zero if it did not say.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("openai api returned non-OK status statusCode=%v responseBody=%s", e.StatusCode, e.Body)
+}
+
+// IsRetryable reports whether a request that failed with err is worth sending again.
+// Rate limits, server errors and transport failures are retryable; anything that means
+// the request itself is wrong (bad request, auth, not found...) is fatal.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusRequestTimeout,
+			http.StatusConflict,
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		default:
+			return false
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, context.DeadlineExceeded)
+}
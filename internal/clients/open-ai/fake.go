@@ -0,0 +1,159 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type fakeClient struct {
+	logger *zap.Logger
+}
+
+// NewFakeClient returns a deterministic Client that never leaves the process.
+// The same request always produces the same completion, which makes it suitable for running the API offline and in tests.
+func NewFakeClient(logger *zap.Logger) Client {
+	return &fakeClient{
+		logger: logger,
+	}
+}
+
+func (c fakeClient) CreateChatCompletion(_ context.Context, request *OpenAIRequest) (*ChatCompletion, error) {
+	var (
+		prompt       string
+		promptTokens int
+	)
+
+	for _, message := range request.Messages {
+		promptTokens += countTokens(message.Content)
+
+		if message.Role == "user" {
+			prompt = message.Content
+		}
+	}
+
+	content := fmt.Sprintf("[fake %s] %s", request.Model, prompt)
+
+	if request.ResponseFormat != nil && request.ResponseFormat.JSONSchema != nil {
+		structured, err := fakeStructuredContent(request.Model, request.ResponseFormat.JSONSchema.Schema)
+		if err != nil {
+			return nil, err
+		}
+
+		content = structured
+	}
+
+	completionTokens := countTokens(content)
+
+	return &ChatCompletion{
+		ID:     fmt.Sprintf("fakecmpl-%x", fingerprint(request)),
+		Object: "chat.completion",
+		Model:  request.Model,
+		Choices: []Choice{
+			{
+				Message:      Message{Role: "assistant", Content: content},
+				FinishReason: "stop",
+			},
+		},
+		Usage: Usage{
+			PromptTokens:     promptTokens,
+			CompletionTokens: completionTokens,
+			TotalTokens:      promptTokens + completionTokens,
+		},
+	}, nil
+}
+
+// fakeStructuredContent builds a JSON document that satisfies schema, using a single item for every array.
+func fakeStructuredContent(model string, schema json.RawMessage) (string, error) {
+	var parsed map[string]any
+
+	err := json.Unmarshal(schema, &parsed)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal json schema: %w", err)
+	}
+
+	content, err := json.Marshal(fakeValue(model, "value", parsed))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal fake structured content: %w", err)
+	}
+
+	return string(content), nil
+}
+
+func fakeValue(model, name string, schema map[string]any) any {
+	if values, ok := schema["enum"].([]any); ok && len(values) > 0 {
+		return values[0]
+	}
+
+	switch schema["type"] {
+	case "object":
+		object := map[string]any{}
+
+		properties, _ := schema["properties"].(map[string]any)
+		for property, propertySchema := range properties {
+			child, _ := propertySchema.(map[string]any)
+			object[property] = fakeValue(model, property, child)
+		}
+
+		return object
+	case "array":
+		items, _ := schema["items"].(map[string]any)
+		return []any{fakeValue(model, name, items)}
+	case "integer", "number":
+		return 1
+	case "boolean":
+		return true
+	default:
+		return fmt.Sprintf("[fake %s] %s", model, name)
+	}
+}
+
+// countTokens approximates a token count by counting whitespace separated words.
+func countTokens(s string) int {
+	return len(strings.Fields(s))
+}
+
+func fingerprint(request *OpenAIRequest) uint64 {
+	h := fnv.New64a()
+
+	_, _ = h.Write([]byte(request.Model))
+
+	for _, message := range request.Messages {
+		_, _ = h.Write([]byte(message.Role))
+		_, _ = h.Write([]byte(message.Content))
+	}
+
+	return h.Sum64()
+}
+
+// CreateChatCompletionStream replays the fake completion word by word.
+func (c fakeClient) CreateChatCompletionStream(
+	ctx context.Context,
+	request *OpenAIRequest,
+	onDelta func(delta string) error,
+) (*ChatCompletion, error) {
+	completion, err := c.CreateChatCompletion(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, word := range strings.SplitAfter(completion.Content(), " ") {
+		if word == "" {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := onDelta(word); err != nil {
+			return nil, fmt.Errorf("failed to relay delta %d: %w", i, err)
+		}
+	}
+
+	return completion, nil
+}
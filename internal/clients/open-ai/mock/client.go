@@ -11,11 +11,11 @@ package mock_openai
 
 import (
 	context "context"
-	io "io"
-	http "net/http"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
 )
 
 // MockClient is a mock of Client interface.
@@ -41,18 +41,32 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
-// MakeRequest mocks base method.
-func (m *MockClient) MakeRequest(ctx context.Context, body io.Reader) (*http.Response, []byte, error) {
+// CreateChatCompletion mocks base method.
+func (m *MockClient) CreateChatCompletion(ctx context.Context, request *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChatCompletion", ctx, request)
+	ret0, _ := ret[0].(*openai.ChatCompletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChatCompletion indicates an expected call of CreateChatCompletion.
+func (mr *MockClientMockRecorder) CreateChatCompletion(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatCompletion", reflect.TypeOf((*MockClient)(nil).CreateChatCompletion), ctx, request)
+}
+
+// CreateChatCompletionStream mocks base method.
+func (m *MockClient) CreateChatCompletionStream(ctx context.Context, request *openai.OpenAIRequest, onDelta func(string) error) (*openai.ChatCompletion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MakeRequest", ctx, body)
-	ret0, _ := ret[0].(*http.Response)
-	ret1, _ := ret[1].([]byte)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "CreateChatCompletionStream", ctx, request, onDelta)
+	ret0, _ := ret[0].(*openai.ChatCompletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MakeRequest indicates an expected call of MakeRequest.
-func (mr *MockClientMockRecorder) MakeRequest(ctx, body any) *gomock.Call {
+// CreateChatCompletionStream indicates an expected call of CreateChatCompletionStream.
+func (mr *MockClientMockRecorder) CreateChatCompletionStream(ctx, request, onDelta any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeRequest", reflect.TypeOf((*MockClient)(nil).MakeRequest), ctx, body)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatCompletionStream", reflect.TypeOf((*MockClient)(nil).CreateChatCompletionStream), ctx, request, onDelta)
 }
@@ -0,0 +1,39 @@
+package openai
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/config"
+)
+
+// Supported values for the LLM_PROVIDER setting.
+const (
+	ProviderOpenAI           = "openai"
+	ProviderOpenAICompatible = "openai-compatible"
+	ProviderFake             = "fake"
+)
+
+// NewProvider builds the Client selected by cfg.LLMProvider.
+func NewProvider(cfg *config.Config, logger *zap.Logger) (Client, error) {
+	settings := Settings{
+		Timeout:                 cfg.LLMTimeout,
+		MaxRetries:              cfg.LLMMaxRetries,
+		RetryBaseDelay:          cfg.LLMRetryBaseDelay,
+		RetryMaxDelay:           cfg.LLMRetryMaxDelay,
+		BreakerFailureThreshold: cfg.LLMBreakerFailureThreshold,
+		BreakerOpenTimeout:      cfg.LLMBreakerOpenTimeout,
+	}
+
+	switch cfg.LLMProvider {
+	case ProviderOpenAI, "":
+		return NewClientWithSettings(cfg.OpenAIAPIKey, settings, logger), nil
+	case ProviderOpenAICompatible:
+		return NewCompatibleClient(cfg.LLMBaseURL, cfg.OpenAIAPIKey, cfg.LLMModel, settings, logger), nil
+	case ProviderFake:
+		return NewFakeClient(logger), nil
+	default:
+		return nil, fmt.Errorf("unknown llm provider %q", cfg.LLMProvider)
+	}
+}
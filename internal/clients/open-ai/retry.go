@@ -0,0 +1,121 @@
+package openai
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Settings tunes the HTTP behaviour of the OpenAI clients.
+type Settings struct {
+	// Timeout bounds a single attempt, not the whole retry loop.
+	Timeout time.Duration
+	// MaxRetries is the number of extra attempts made after a retryable failure.
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// BreakerFailureThreshold is the number of consecutive failed requests that opens the circuit breaker.
+	// Zero disables the breaker.
+	BreakerFailureThreshold int
+	BreakerOpenTimeout      time.Duration
+}
+
+// DefaultSettings are used by NewClient and whenever a setting is left empty.
+var DefaultSettings = Settings{
+	Timeout:                 time.Second * 45,
+	MaxRetries:              3,
+	RetryBaseDelay:          time.Millisecond * 500,
+	RetryMaxDelay:           time.Second * 8,
+	BreakerFailureThreshold: 5,
+	BreakerOpenTimeout:      time.Second * 30,
+}
+
+func (s Settings) withDefaults() Settings {
+	if s.Timeout <= 0 {
+		s.Timeout = DefaultSettings.Timeout
+	}
+
+	if s.MaxRetries < 0 {
+		s.MaxRetries = 0
+	}
+
+	if s.RetryBaseDelay <= 0 {
+		s.RetryBaseDelay = DefaultSettings.RetryBaseDelay
+	}
+
+	if s.RetryMaxDelay <= 0 {
+		s.RetryMaxDelay = DefaultSettings.RetryMaxDelay
+	}
+
+	if s.BreakerOpenTimeout <= 0 {
+		s.BreakerOpenTimeout = DefaultSettings.BreakerOpenTimeout
+	}
+
+	return s
+}
+
+// sharedTransport is reused by every client so connections to the provider are pooled across requests.
+var sharedTransport = newTransport()
+
+func newTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = 100
+	transport.MaxIdleConnsPerHost = 20
+	transport.IdleConnTimeout = time.Second * 90
+
+	return transport
+}
+
+// backoff returns how long to wait before the given retry attempt (starting at 0).
+// It uses exponential backoff with full jitter, but a Retry-After sent by the provider always wins. Callers give up
+// rather than wait when the Retry-After is longer than RetryMaxDelay.
+func (s Settings) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	delay := s.RetryMaxDelay
+	if attempt < 30 {
+		delay = min(s.RetryBaseDelay<<attempt, s.RetryMaxDelay)
+	}
+
+	return time.Duration(rand.Int64N(int64(delay) + 1))
+}
+
+// parseRetryAfter reads the Retry-After headers of a response.
+// OpenAI also sends retry-after-ms, which is preferred when present.
+func parseRetryAfter(header http.Header, now time.Time) time.Duration {
+	if ms, err := strconv.ParseFloat(header.Get("retry-after-ms"), 64); err == nil && ms > 0 {
+		return time.Duration(ms * float64(time.Millisecond))
+	}
+
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,160 @@
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+)
+
+type (
+	// ChatCompletionChunk is a single server-sent event of a streamed chat completion.
+	ChatCompletionChunk struct {
+		ID      string        `json:"id"`
+		Created int64         `json:"created"`
+		Model   string        `json:"model"`
+		Choices []ChunkChoice `json:"choices"`
+		// Usage is only sent in the final chunk, when stream_options.include_usage is set.
+		Usage *Usage `json:"usage"`
+	}
+
+	ChunkChoice struct {
+		Index        int     `json:"index"`
+		Delta        Message `json:"delta"`
+		FinishReason *string `json:"finish_reason"`
+	}
+)
+
+const streamDataPrefix = "data:"
+
+// CreateChatCompletionStream only retries while connecting. Once the first chunk has been received
+// a failure is returned as is, since the caller has already relayed part of the answer.
+func (c *openAiClient) CreateChatCompletionStream(
+	ctx context.Context,
+	request *OpenAIRequest,
+	onDelta func(delta string) error,
+) (*ChatCompletion, error) {
+	streamed := *request
+	streamed.Stream = true
+	streamed.StreamOptions = &StreamOptions{IncludeUsage: true}
+
+	if c.model != "" {
+		streamed.Model = c.model
+	}
+
+	body, err := json.Marshal(&streamed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal chat completion request: %w", err)
+	}
+
+	var resp *http.Response
+
+	err = c.withRetries(ctx, func() error {
+		resp, err = c.doRequest(ctx, body)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	return readStream(resp.Body, onDelta)
+}
+
+// finishReasonStop is the finish reason of an answer the model ended by itself.
+const finishReasonStop = "stop"
+
+// readStream consumes the server-sent events of a streamed completion and assembles the full completion.
+// It returns ErrIncompleteStream unless the stream ended with [DONE] or a stop finish reason, so that a truncated
+// answer is never taken for a whole one.
+func readStream(r io.Reader, onDelta func(delta string) error) (*ChatCompletion, error) {
+	completion := ChatCompletion{Object: "chat.completion"}
+
+	var content strings.Builder
+
+	finishReason := ""
+	done := false
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if !bytes.HasPrefix(line, []byte(streamDataPrefix)) {
+			continue
+		}
+
+		data := bytes.TrimSpace(bytes.TrimPrefix(line, []byte(streamDataPrefix)))
+		if string(data) == "[DONE]" {
+			done = true
+			break
+		}
+
+		var chunk ChatCompletionChunk
+		if err := json.Unmarshal(data, &chunk); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal chat completion chunk=%s: %w", data, err)
+		}
+
+		completion.ID = chunk.ID
+		completion.Created = chunk.Created
+		completion.Model = chunk.Model
+
+		if chunk.Usage != nil {
+			completion.Usage = *chunk.Usage
+		}
+
+		for _, choice := range chunk.Choices {
+			if choice.Index != 0 {
+				continue
+			}
+
+			if choice.FinishReason != nil {
+				finishReason = *choice.FinishReason
+			}
+
+			if choice.Delta.Content == "" {
+				continue
+			}
+
+			content.WriteString(choice.Delta.Content)
+
+			if err := onDelta(choice.Delta.Content); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read chat completion stream: %w", err)
+	}
+
+	if finishReason != "" && finishReason != finishReasonStop {
+		return nil, fmt.Errorf("%w: finish_reason=%s", openaierrors.ErrIncompleteStream, finishReason)
+	}
+
+	if !done && finishReason != finishReasonStop {
+		return nil, fmt.Errorf("%w: no [DONE] nor finish_reason", openaierrors.ErrIncompleteStream)
+	}
+
+	if content.Len() == 0 {
+		return nil, openaierrors.ErrNoChoicesFound
+	}
+
+	completion.Choices = []Choice{
+		{
+			Message:      Message{Role: "assistant", Content: content.String()},
+			FinishReason: finishReason,
+		},
+	}
+
+	return &completion, nil
+}
@@ -0,0 +1,46 @@
+package openai
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+)
+
+// validate is shared by every parse, as a Validate caches what it learns about the structs it validates.
+var validate = validator.New()
+
+// Normalizer is implemented by structured payloads that tidy up answers the model phrased in another accepted way,
+// e.g. a language name instead of its code. ParseStructured calls Normalize before validating the payload.
+type Normalizer interface {
+	Normalize()
+}
+
+// ParseStructured decodes the content of a completion asked for with NewJSONSchemaFormat into target, and validates
+// it against the validate tags of target. It returns errors.ErrInvalidStructuredOutput when either fails.
+func ParseStructured(content string, target any) error {
+	content = strings.TrimSpace(content)
+	// Some compatible providers ignore response_format and wrap the JSON in a markdown code block.
+	content = strings.TrimPrefix(content, "```json")
+	content = strings.TrimPrefix(content, "```")
+	content = strings.TrimSuffix(content, "```")
+
+	err := json.Unmarshal([]byte(content), target)
+	if err != nil {
+		return fmt.Errorf("%w: %w", openaierrors.ErrInvalidStructuredOutput, err)
+	}
+
+	if normalizer, ok := target.(Normalizer); ok {
+		normalizer.Normalize()
+	}
+
+	err = validate.Struct(target)
+	if err != nil {
+		return fmt.Errorf("%w: %w", openaierrors.ErrInvalidStructuredOutput, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package openai_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+)
+
+type answerPayload struct {
+	Answer string `json:"answer" validate:"required,lowercase"`
+}
+
+func (p *answerPayload) answer() string {
+	return p.Answer
+}
+
+type normalizedPayload struct {
+	answerPayload
+}
+
+func (p *normalizedPayload) Normalize() {
+	p.Answer = strings.ToLower(p.Answer)
+}
+
+func TestParseStructured(t *testing.T) {
+	testCases := map[string]struct {
+		content   string
+		target    interface{ answer() string }
+		want      string
+		wantError bool
+	}{
+		"json":                      {content: `{"answer":"yes"}`, target: &answerPayload{}, want: "yes"},
+		"json in a code block":      {content: "```json\n{\"answer\":\"yes\"}\n```", target: &answerPayload{}, want: "yes"},
+		"not json":                  {content: "Yes.", target: &answerPayload{}, wantError: true},
+		"missing required value":    {content: `{"answer":""}`, target: &answerPayload{}, wantError: true},
+		"invalid value":             {content: `{"answer":"YES"}`, target: &answerPayload{}, wantError: true},
+		"normalized before checked": {content: `{"answer":"YES"}`, target: &normalizedPayload{}, want: "yes"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := openai.ParseStructured(tc.content, tc.target)
+			if tc.wantError {
+				assert.ErrorIs(t, err, openaierrors.ErrInvalidStructuredOutput)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, tc.target.answer())
+		})
+	}
+}
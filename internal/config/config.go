@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/joho/godotenv"
@@ -12,7 +14,7 @@ import (
 
 // Config holds the application settings.
 type Config struct {
-	OpenAIAPIKey        string `mapstructure:"OPENAI_API_KEY" validate:"required"`
+	OpenAIAPIKey        string `mapstructure:"OPENAI_API_KEY" validate:"required_if=LLMProvider openai"`
 	Secret              string `mapstructure:"SECRET" validate:"required"`
 	Port                string `mapstructure:"PORT" validate:"required"`
 	Env                 string `mapstructure:"ENV" validate:"required"`
@@ -24,6 +26,41 @@ type Config struct {
 	StripePaidPriceId   string `mapstructure:"STRIPE_PAID_PRICE_ID" yaml:"stripe_paid_price_id" validate:"required"`
 	CheckoutSuccessURL  string `mapstructure:"CHECKOUT_SUCCESS_URL" yaml:"checkout_success_url" validate:"required"`
 	CheckoutCancelURL   string `mapstructure:"CHECKOUT_CANCEL_URL" yaml:"checkout_cancel_url" validate:"required"`
+	LLMProvider         string `mapstructure:"LLM_PROVIDER" yaml:"llm_provider" validate:"oneof=openai openai-compatible fake"`
+	LLMBaseURL          string `mapstructure:"LLM_BASE_URL" yaml:"llm_base_url" validate:"required_if=LLMProvider openai-compatible"`
+	LLMModel            string `mapstructure:"LLM_MODEL" yaml:"llm_model"`
+	LookupMode          string `mapstructure:"LOOKUP_MODE" yaml:"lookup_mode" validate:"oneof=single fanout"`
+	CacheBackend        string `mapstructure:"CACHE_BACKEND" yaml:"cache_backend" validate:"oneof=memory redis tiered"`
+	RedisURL            string `mapstructure:"REDIS_URL" yaml:"redis_url" validate:"required_if=CacheBackend redis,required_if=CacheBackend tiered,required_if=RateLimitBackend redis"`
+	RateLimitBackend    string `mapstructure:"RATE_LIMIT_BACKEND" yaml:"rate_limit_backend" validate:"oneof=memory redis"`
+	ClientIPHeader      string `mapstructure:"CLIENT_IP_HEADER" yaml:"client_ip_header"`
+	ReviewScheduler     string `mapstructure:"REVIEW_SCHEDULER" yaml:"review_scheduler" validate:"oneof=sm2 fsrs"`
+	// AdminUserIDs are the users allowed on the internal endpoints, e.g. experiment results.
+	AdminUserIDs []string `mapstructure:"ADMIN_USER_IDS" yaml:"admin_user_ids"`
+
+	LLMTimeout                 time.Duration `mapstructure:"LLM_TIMEOUT" yaml:"llm_timeout"`
+	LLMMaxRetries              int           `mapstructure:"LLM_MAX_RETRIES" yaml:"llm_max_retries" validate:"gte=0"`
+	LLMRetryBaseDelay          time.Duration `mapstructure:"LLM_RETRY_BASE_DELAY" yaml:"llm_retry_base_delay"`
+	LLMRetryMaxDelay           time.Duration `mapstructure:"LLM_RETRY_MAX_DELAY" yaml:"llm_retry_max_delay"`
+	LLMBreakerFailureThreshold int           `mapstructure:"LLM_BREAKER_FAILURE_THRESHOLD" yaml:"llm_breaker_failure_threshold" validate:"gte=0"`
+	LLMBreakerOpenTimeout      time.Duration `mapstructure:"LLM_BREAKER_OPEN_TIMEOUT" yaml:"llm_breaker_open_timeout"`
+	CacheLocalTTL              time.Duration `mapstructure:"CACHE_LOCAL_TTL" yaml:"cache_local_ttl"`
+	SubscriptionCacheTTL       time.Duration `mapstructure:"SUBSCRIPTION_CACHE_TTL" yaml:"subscription_cache_ttl"`
+
+	// Daily and monthly token quotas by subscription status.
+	QuotaTrialingDailyTokens   int `mapstructure:"QUOTA_TRIALING_DAILY_TOKENS" yaml:"quota_trialing_daily_tokens" validate:"gte=0"`
+	QuotaTrialingMonthlyTokens int `mapstructure:"QUOTA_TRIALING_MONTHLY_TOKENS" yaml:"quota_trialing_monthly_tokens" validate:"gte=0"`
+	QuotaActiveDailyTokens     int `mapstructure:"QUOTA_ACTIVE_DAILY_TOKENS" yaml:"quota_active_daily_tokens" validate:"gte=0"`
+	QuotaActiveMonthlyTokens   int `mapstructure:"QUOTA_ACTIVE_MONTHLY_TOKENS" yaml:"quota_active_monthly_tokens" validate:"gte=0"`
+	QuotaPastDueDailyTokens    int `mapstructure:"QUOTA_PAST_DUE_DAILY_TOKENS" yaml:"quota_past_due_daily_tokens" validate:"gte=0"`
+	QuotaPastDueMonthlyTokens  int `mapstructure:"QUOTA_PAST_DUE_MONTHLY_TOKENS" yaml:"quota_past_due_monthly_tokens" validate:"gte=0"`
+	QuotaCanceledDailyTokens   int `mapstructure:"QUOTA_CANCELED_DAILY_TOKENS" yaml:"quota_canceled_daily_tokens" validate:"gte=0"`
+	QuotaCanceledMonthlyTokens int `mapstructure:"QUOTA_CANCELED_MONTHLY_TOKENS" yaml:"quota_canceled_monthly_tokens" validate:"gte=0"`
+
+	// Daily free tier requests on the open AI endpoints.
+	FreeTierDailyRequests        int `mapstructure:"FREE_TIER_DAILY_REQUESTS" yaml:"free_tier_daily_requests" validate:"gte=0"`
+	FreeTierNetworkDailyRequests int `mapstructure:"FREE_TIER_NETWORK_DAILY_REQUESTS" yaml:"free_tier_network_daily_requests" validate:"gte=0"`
+	TrialDailyRequests           int `mapstructure:"TRIAL_DAILY_REQUESTS" yaml:"trial_daily_requests" validate:"gte=0"`
 }
 
 // LoadConfig loads configuration from the OS environment and, if not in production,
@@ -41,11 +78,61 @@ func LoadConfig() (*Config, error) {
 	// Use Viper to read environment variables.
 	viper.AutomaticEnv()
 
+	// Retry and circuit breaker defaults for the LLM client.
+	viper.SetDefault("LLM_TIMEOUT", "45s")
+	viper.SetDefault("LLM_MAX_RETRIES", 3)
+	viper.SetDefault("LLM_RETRY_BASE_DELAY", "500ms")
+	viper.SetDefault("LLM_RETRY_MAX_DELAY", "8s")
+	viper.SetDefault("LLM_BREAKER_FAILURE_THRESHOLD", 5)
+	viper.SetDefault("LLM_BREAKER_OPEN_TIMEOUT", "30s")
+
+	// How long the tiered cache keeps entries in memory before asking Redis again.
+	viper.SetDefault("CACHE_LOCAL_TTL", "10m")
+
+	// How long a user's subscription is trusted before it is read again.
+	viper.SetDefault("SUBSCRIPTION_CACHE_TTL", "30s")
+
+	// Token quotas. A canceled subscription, or no subscription at all, gets no tokens.
+	viper.SetDefault("QUOTA_TRIALING_DAILY_TOKENS", 20000)
+	viper.SetDefault("QUOTA_TRIALING_MONTHLY_TOKENS", 200000)
+	viper.SetDefault("QUOTA_ACTIVE_DAILY_TOKENS", 200000)
+	viper.SetDefault("QUOTA_ACTIVE_MONTHLY_TOKENS", 3000000)
+	viper.SetDefault("QUOTA_PAST_DUE_DAILY_TOKENS", 5000)
+	viper.SetDefault("QUOTA_PAST_DUE_MONTHLY_TOKENS", 20000)
+	viper.SetDefault("QUOTA_CANCELED_DAILY_TOKENS", 0)
+	viper.SetDefault("QUOTA_CANCELED_MONTHLY_TOKENS", 0)
+
+	// Free tier allowances: per anonymous device, per IP address and per trialing user.
+	viper.SetDefault("RATE_LIMIT_BACKEND", "memory")
+	viper.SetDefault("FREE_TIER_DAILY_REQUESTS", 20)
+	viper.SetDefault("FREE_TIER_NETWORK_DAILY_REQUESTS", 100)
+	viper.SetDefault("TRIAL_DAILY_REQUESTS", 200)
+
 	// Set a default value for ENV if it hasn't been set.
 	if viper.GetString("ENV") == "" {
 		viper.Set("ENV", "dev")
 	}
 
+	// Talk to OpenAI unless another provider has been selected.
+	if viper.GetString("LLM_PROVIDER") == "" {
+		viper.Set("LLM_PROVIDER", "openai")
+	}
+
+	// Get every lookup section in one completion unless told otherwise.
+	if viper.GetString("LOOKUP_MODE") == "" {
+		viper.Set("LOOKUP_MODE", "single")
+	}
+
+	// Keep the cache in process unless a shared one has been configured.
+	if viper.GetString("CACHE_BACKEND") == "" {
+		viper.Set("CACHE_BACKEND", "memory")
+	}
+
+	// Schedule reviews with SM-2 unless FSRS has been selected.
+	if viper.GetString("REVIEW_SCHEDULER") == "" {
+		viper.Set("REVIEW_SCHEDULER", "sm2")
+	}
+
 	// Create a Config instance with values from environment variables.
 	cfg := Config{
 		OpenAIAPIKey:        viper.GetString("OPENAI_API_KEY"),
@@ -60,6 +147,38 @@ func LoadConfig() (*Config, error) {
 		StripePaidPriceId:   viper.GetString("STRIPE_PAID_PRICE_ID"),
 		CheckoutSuccessURL:  viper.GetString("CHECKOUT_SUCCESS_URL"),
 		CheckoutCancelURL:   viper.GetString("CHECKOUT_CANCEL_URL"),
+		LLMProvider:         viper.GetString("LLM_PROVIDER"),
+		LLMBaseURL:          viper.GetString("LLM_BASE_URL"),
+		LLMModel:            viper.GetString("LLM_MODEL"),
+		LookupMode:          viper.GetString("LOOKUP_MODE"),
+		CacheBackend:        viper.GetString("CACHE_BACKEND"),
+		RedisURL:            viper.GetString("REDIS_URL"),
+		RateLimitBackend:    viper.GetString("RATE_LIMIT_BACKEND"),
+		ClientIPHeader:      viper.GetString("CLIENT_IP_HEADER"),
+		ReviewScheduler:     viper.GetString("REVIEW_SCHEDULER"),
+		AdminUserIDs:        adminUserIDs(viper.GetString("ADMIN_USER_IDS")),
+
+		LLMTimeout:                 viper.GetDuration("LLM_TIMEOUT"),
+		LLMMaxRetries:              viper.GetInt("LLM_MAX_RETRIES"),
+		LLMRetryBaseDelay:          viper.GetDuration("LLM_RETRY_BASE_DELAY"),
+		LLMRetryMaxDelay:           viper.GetDuration("LLM_RETRY_MAX_DELAY"),
+		LLMBreakerFailureThreshold: viper.GetInt("LLM_BREAKER_FAILURE_THRESHOLD"),
+		LLMBreakerOpenTimeout:      viper.GetDuration("LLM_BREAKER_OPEN_TIMEOUT"),
+		CacheLocalTTL:              viper.GetDuration("CACHE_LOCAL_TTL"),
+		SubscriptionCacheTTL:       viper.GetDuration("SUBSCRIPTION_CACHE_TTL"),
+
+		QuotaTrialingDailyTokens:   viper.GetInt("QUOTA_TRIALING_DAILY_TOKENS"),
+		QuotaTrialingMonthlyTokens: viper.GetInt("QUOTA_TRIALING_MONTHLY_TOKENS"),
+		QuotaActiveDailyTokens:     viper.GetInt("QUOTA_ACTIVE_DAILY_TOKENS"),
+		QuotaActiveMonthlyTokens:   viper.GetInt("QUOTA_ACTIVE_MONTHLY_TOKENS"),
+		QuotaPastDueDailyTokens:    viper.GetInt("QUOTA_PAST_DUE_DAILY_TOKENS"),
+		QuotaPastDueMonthlyTokens:  viper.GetInt("QUOTA_PAST_DUE_MONTHLY_TOKENS"),
+		QuotaCanceledDailyTokens:   viper.GetInt("QUOTA_CANCELED_DAILY_TOKENS"),
+		QuotaCanceledMonthlyTokens: viper.GetInt("QUOTA_CANCELED_MONTHLY_TOKENS"),
+
+		FreeTierDailyRequests:        viper.GetInt("FREE_TIER_DAILY_REQUESTS"),
+		FreeTierNetworkDailyRequests: viper.GetInt("FREE_TIER_NETWORK_DAILY_REQUESTS"),
+		TrialDailyRequests:           viper.GetInt("TRIAL_DAILY_REQUESTS"),
 	}
 
 	// Validate the config.
@@ -69,3 +188,16 @@ func LoadConfig() (*Config, error) {
 
 	return &cfg, nil
 }
+
+// adminUserIDs splits the comma separated ADMIN_USER_IDS.
+func adminUserIDs(value string) []string {
+	var ids []string
+
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
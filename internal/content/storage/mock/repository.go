@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=mock/repository.go
+//
+
+// Package mock_storage is a generated GoMock package.
+package mock_storage
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+// MockContentRepository is a mock of ContentRepository interface.
+type MockContentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentRepositoryMockRecorder
+}
+
+// MockContentRepositoryMockRecorder is the mock recorder for MockContentRepository.
+type MockContentRepositoryMockRecorder struct {
+	mock *MockContentRepository
+}
+
+// NewMockContentRepository creates a new mock instance.
+func NewMockContentRepository(ctrl *gomock.Controller) *MockContentRepository {
+	mock := &MockContentRepository{ctrl: ctrl}
+	mock.recorder = &MockContentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentRepository) EXPECT() *MockContentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetContent mocks base method.
+func (m *MockContentRepository) GetContent(ctx context.Context, kind, input, language, promptVersion, model string) (*entity.GeneratedContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContent", ctx, kind, input, language, promptVersion, model)
+	ret0, _ := ret[0].(*entity.GeneratedContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContent indicates an expected call of GetContent.
+func (mr *MockContentRepositoryMockRecorder) GetContent(ctx, kind, input, language, promptVersion, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContent", reflect.TypeOf((*MockContentRepository)(nil).GetContent), ctx, kind, input, language, promptVersion, model)
+}
+
+// UpsertContent mocks base method.
+func (m *MockContentRepository) UpsertContent(ctx context.Context, content *entity.GeneratedContent) (*entity.GeneratedContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertContent", ctx, content)
+	ret0, _ := ret[0].(*entity.GeneratedContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertContent indicates an expected call of UpsertContent.
+func (mr *MockContentRepositoryMockRecorder) UpsertContent(ctx, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertContent", reflect.TypeOf((*MockContentRepository)(nil).UpsertContent), ctx, content)
+}
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+//go:generate mockgen -source=repository.go -destination=mock/repository.go
+type ContentRepository interface {
+	GetContent(ctx context.Context, kind, input, language, promptVersion, model string) (*entity.GeneratedContent, error)
+	UpsertContent(ctx context.Context, content *entity.GeneratedContent) (*entity.GeneratedContent, error)
+}
+
+type contentRepository struct {
+	db *sqlx.DB
+}
+
+func NewContentRepository(db *sqlx.DB) ContentRepository {
+	return &contentRepository{
+		db: db,
+	}
+}
+
+// GetContent returns sql.ErrNoRows when nothing has been generated for the key yet.
+func (r *contentRepository) GetContent(
+	ctx context.Context,
+	kind, input, language, promptVersion, model string,
+) (*entity.GeneratedContent, error) {
+	return entity.GeneratedContents(
+		entity.GeneratedContentWhere.Kind.EQ(kind),
+		entity.GeneratedContentWhere.Input.EQ(input),
+		entity.GeneratedContentWhere.Language.EQ(language),
+		entity.GeneratedContentWhere.PromptVersion.EQ(promptVersion),
+		entity.GeneratedContentWhere.Model.EQ(model),
+	).One(ctx, r.db)
+}
+
+// UpsertContent inserts the content, replacing the content previously generated for the same key.
+func (r *contentRepository) UpsertContent(ctx context.Context, content *entity.GeneratedContent) (*entity.GeneratedContent, error) {
+	err := content.Upsert(
+		ctx,
+		r.db,
+		true,
+		[]string{
+			entity.GeneratedContentColumns.Kind,
+			entity.GeneratedContentColumns.Input,
+			entity.GeneratedContentColumns.Language,
+			entity.GeneratedContentColumns.PromptVersion,
+			entity.GeneratedContentColumns.Model,
+		},
+		boil.Whitelist(entity.GeneratedContentColumns.Content, entity.GeneratedContentColumns.UpdatedAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert generated content: %w", err)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,198 @@
+package content
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+var ErrNotFound = errors.New("content not found")
+
+// Key identifies a piece of generated content. The same input asked with another prompt version
+// or model is stored separately, so changing a prompt never serves answers generated by the old one.
+type Key struct {
+	// Kind is what was generated, e.g. "word_definition" or "sentence_correction".
+	Kind          string
+	Input         string
+	Language      string
+	PromptVersion string
+	Model         string
+}
+
+// Store keeps AI generated content. Implementations are read-through: a Get may be served from
+// memory, but every Set is persisted.
+//
+//go:generate mockgen -source=store.go -destination=mock/store.go
+type Store interface {
+	// Get returns ErrNotFound when nothing has been generated for the key yet.
+	Get(ctx context.Context, key Key) (*string, error)
+	Set(ctx context.Context, key Key, content string) error
+	// GetOrGenerate returns the content stored for key, or generates and stores it. Concurrent calls
+	// for the same key share a single call to generate.
+	GetOrGenerate(ctx context.Context, key Key, generate Generate) (*string, error)
+}
+
+// Generate produces the content for a key that has not been stored yet.
+type Generate func(ctx context.Context) (string, error)
+
+type store struct {
+	logger     *zap.Logger
+	repository storage.ContentRepository
+	cache      cache.Cache
+	inFlight   singleflight.Group
+}
+
+// NewStore returns a Store backed by Postgres, with cache read through in front of it.
+// A nil repository keeps the content in the cache only, which is how tests and local runs without a database use it.
+func NewStore(
+	logger *zap.Logger,
+	repository storage.ContentRepository,
+	cache cache.Cache,
+) Store {
+	return &store{
+		logger:     logger,
+		repository: repository,
+		cache:      cache,
+	}
+}
+
+const cacheExpiration = time.Hour * 24
+
+func (s *store) Get(ctx context.Context, key Key) (*string, error) {
+	key = key.normalized()
+	cacheKey := key.cacheKey()
+
+	cached, err := s.cache.Get(ctx, cacheKey)
+	if err == nil {
+		content := string(cached)
+		return &content, nil
+	}
+
+	if !errors.Is(err, cache.ErrCacheMiss) {
+		s.logger.Warn("generated content cache get failed", zap.String("kind", key.Kind), zap.Error(err))
+	}
+
+	if s.repository == nil {
+		return nil, ErrNotFound
+	}
+
+	generated, err := s.repository.GetContent(ctx, key.Kind, key.Input, key.Language, key.PromptVersion, key.Model)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		s.logger.Warn("failed to read generated content, it will be generated again", zap.String("kind", key.Kind), zap.Error(err))
+		return nil, fmt.Errorf("failed to get generated content kind=%s: %w", key.Kind, err)
+	}
+
+	err = s.cache.Set(ctx, cacheKey, []byte(generated.Content), cacheExpiration)
+	if err != nil {
+		s.logger.Warn("generated content cache set failed", zap.String("kind", key.Kind), zap.Error(err))
+	}
+
+	return &generated.Content, nil
+}
+
+func (s *store) Set(ctx context.Context, key Key, content string) error {
+	key = key.normalized()
+
+	// The cache is written first, so the content is served from it even when the database write fails.
+	err := s.cache.Set(ctx, key.cacheKey(), []byte(content), cacheExpiration)
+	if err != nil {
+		s.logger.Warn("generated content cache set failed", zap.String("kind", key.Kind), zap.Error(err))
+	}
+
+	if s.repository == nil {
+		return nil
+	}
+
+	_, err = s.repository.UpsertContent(ctx, &entity.GeneratedContent{
+		Kind:          key.Kind,
+		Input:         key.Input,
+		Language:      key.Language,
+		PromptVersion: key.PromptVersion,
+		Model:         key.Model,
+		Content:       content,
+		UpdatedAt:     time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store generated content kind=%s: %w", key.Kind, err)
+	}
+
+	return nil
+}
+
+func (s *store) GetOrGenerate(ctx context.Context, key Key, generate Generate) (*string, error) {
+	stored, err := s.Get(ctx, key)
+	if err == nil {
+		return stored, nil
+	}
+
+	key = key.normalized()
+
+	// The generation is detached from the context of the caller that started it, so it is not
+	// cancelled for the callers waiting on it when that first caller goes away.
+	results := s.inFlight.DoChan(key.cacheKey(), func() (any, error) {
+		// A generation for the same key may have finished since the first read.
+		stored, err := s.Get(ctx, key)
+		if err == nil {
+			return *stored, nil
+		}
+
+		generated, err := generate(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.Set(context.WithoutCancel(ctx), key, generated)
+		if err != nil {
+			s.logger.Warn("failed to store generated content", zap.String("kind", key.Kind), zap.Error(err))
+		}
+
+		return generated, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		if result.Shared {
+			s.logger.Debug("shared in-flight generation", zap.String("kind", key.Kind))
+		}
+
+		generated := result.Val.(string)
+
+		return &generated, nil
+	}
+}
+
+func (k Key) normalized() Key {
+	k.Input = strings.Join(strings.Fields(k.Input), " ")
+	k.Language = strings.ToLower(strings.TrimSpace(k.Language))
+
+	return k
+}
+
+// String returns the normalized key as a single string.
+func (k Key) String() string {
+	return k.normalized().cacheKey()
+}
+
+func (k Key) cacheKey() string {
+	return strings.Join([]string{k.Kind, k.Language, k.PromptVersion, k.Model, k.Input}, "|")
+}
@@ -0,0 +1,233 @@
+package content_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coocood/freecache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache/cachetest"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content"
+	mockstorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content/storage/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+func newKey(input string) content.Key {
+	return content.Key{
+		Kind:          "word_definition",
+		Input:         input,
+		Language:      "English",
+		PromptVersion: "v1",
+		Model:         "gpt-4o",
+	}
+}
+
+func TestStoreGet(t *testing.T) {
+	testCases := []struct {
+		name            string
+		mock            func(repository *mockstorage.MockContentRepository)
+		expectedContent string
+		expectedErr     error
+	}{
+		{
+			name: "reads through to postgres",
+			mock: func(repository *mockstorage.MockContentRepository) {
+				repository.EXPECT().GetContent(gomock.Any(), "word_definition", "cat", "english", "v1", "gpt-4o").
+					Return(&entity.GeneratedContent{Content: "A small feline."}, nil).
+					Times(1)
+			},
+			expectedContent: "A small feline.",
+		},
+		{
+			name: "not found",
+			mock: func(repository *mockstorage.MockContentRepository) {
+				repository.EXPECT().GetContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, sql.ErrNoRows).
+					Times(2)
+			},
+			expectedErr: content.ErrNotFound,
+		},
+		{
+			name: "database error",
+			mock: func(repository *mockstorage.MockContentRepository) {
+				repository.EXPECT().GetContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("connection refused")).
+					Times(2)
+			},
+			expectedErr: errors.New("failed to get generated content kind=word_definition: connection refused"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repository := mockstorage.NewMockContentRepository(ctrl)
+			tc.mock(repository)
+
+			store := content.NewStore(zaptest.NewLogger(t), repository, cache.NewFreecache(freecache.NewCache(1024*1024)))
+
+			// The second call is served by the L1 cache when the first one found the content.
+			for _, input := range []string{"cat", "  cat "} {
+				stored, err := store.Get(context.Background(), newKey(input))
+
+				switch {
+				case errors.Is(tc.expectedErr, content.ErrNotFound):
+					assert.ErrorIs(t, err, content.ErrNotFound)
+				case tc.expectedErr != nil:
+					assert.EqualError(t, err, tc.expectedErr.Error())
+				default:
+					require.NoError(t, err)
+					assert.Equal(t, tc.expectedContent, *stored)
+				}
+			}
+		})
+	}
+}
+
+func TestStoreSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repository := mockstorage.NewMockContentRepository(ctrl)
+
+	repository.EXPECT().UpsertContent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, generated *entity.GeneratedContent) (*entity.GeneratedContent, error) {
+			assert.Equal(t, "a black cat", generated.Input)
+			assert.Equal(t, "english", generated.Language)
+			assert.Equal(t, "A small black feline.", generated.Content)
+
+			return generated, nil
+		})
+
+	store := content.NewStore(zaptest.NewLogger(t), repository, cache.NewFreecache(freecache.NewCache(1024*1024)))
+
+	err := store.Set(context.Background(), newKey("a  black\tcat"), "A small black feline.")
+	require.NoError(t, err)
+
+	// Served from the L1 cache without reaching the repository.
+	stored, err := store.Get(context.Background(), newKey("a black cat"))
+	require.NoError(t, err)
+	assert.Equal(t, "A small black feline.", *stored)
+}
+
+func TestStoreSetCachesWhenTheDatabaseFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repository := mockstorage.NewMockContentRepository(ctrl)
+
+	repository.EXPECT().UpsertContent(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+
+	store := content.NewStore(zaptest.NewLogger(t), repository, cache.NewFreecache(freecache.NewCache(1024*1024)))
+
+	err := store.Set(context.Background(), newKey("cat"), "A small feline.")
+	assert.EqualError(t, err, "failed to store generated content kind=word_definition: connection refused")
+
+	stored, err := store.Get(context.Background(), newKey("cat"))
+	require.NoError(t, err)
+	assert.Equal(t, "A small feline.", *stored)
+}
+
+func TestStoreWithoutRepository(t *testing.T) {
+	store := content.NewStore(zaptest.NewLogger(t), nil, cache.NewFreecache(freecache.NewCache(1024*1024)))
+
+	_, err := store.Get(context.Background(), newKey("cat"))
+	assert.ErrorIs(t, err, content.ErrNotFound)
+
+	require.NoError(t, store.Set(context.Background(), newKey("cat"), "A small feline."))
+
+	stored, err := store.Get(context.Background(), newKey("cat"))
+	require.NoError(t, err)
+	assert.Equal(t, "A small feline.", *stored)
+}
+
+func TestStoreSharesContentThroughRedis(t *testing.T) {
+	redisCache, _ := cachetest.NewRedis(t)
+	logger := zaptest.NewLogger(t)
+
+	first := content.NewStore(logger, nil, redisCache)
+	second := content.NewStore(logger, nil, redisCache)
+
+	require.NoError(t, first.Set(context.Background(), newKey("cat"), "A small feline."))
+
+	stored, err := second.Get(context.Background(), newKey("cat"))
+	require.NoError(t, err)
+	assert.Equal(t, "A small feline.", *stored)
+}
+
+func TestStoreGetOrGenerateCoalescesConcurrentCalls(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repository := mockstorage.NewMockContentRepository(ctrl)
+
+	repository.EXPECT().GetContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, sql.ErrNoRows).
+		AnyTimes()
+	repository.EXPECT().UpsertContent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, generated *entity.GeneratedContent) (*entity.GeneratedContent, error) {
+			return generated, nil
+		}).
+		Times(1)
+
+	store := content.NewStore(zaptest.NewLogger(t), repository, cache.NewFreecache(freecache.NewCache(1024*1024)))
+
+	var generations atomic.Int32
+
+	release := make(chan struct{})
+	generate := func(context.Context) (string, error) {
+		generations.Add(1)
+		<-release
+
+		return "A small feline.", nil
+	}
+
+	const callers = 10
+
+	var wg sync.WaitGroup
+
+	results := make([]*string, callers)
+	errs := make([]error, callers)
+
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i], errs[i] = store.GetOrGenerate(context.Background(), newKey("cat"), generate)
+		}()
+	}
+
+	// Give every caller the time to join the in-flight generation before it completes.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), generations.Load())
+
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "A small feline.", *results[i])
+	}
+}
+
+func TestStoreGetOrGenerateReturnsWhenTheCallerGivesUp(t *testing.T) {
+	store := content.NewStore(zaptest.NewLogger(t), nil, cache.NewFreecache(freecache.NewCache(1024*1024)))
+
+	release := make(chan struct{})
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := store.GetOrGenerate(ctx, newKey("cat"), func(context.Context) (string, error) {
+		<-release
+		return "A small feline.", nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+
+	worddomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
+)
+
+// Deck is a named collection of words a user saved.
+type Deck struct {
+	ID         string
+	Name       string
+	EntryCount int
+	// Entries are in deck order. They are only loaded with a single deck.
+	Entries   []Entry
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Entry is a word saved in a deck.
+type Entry struct {
+	ID             string
+	DeckID         string
+	Word           string
+	NativeLanguage string
+	// Position is the order of the entry in its deck, from 1.
+	Position int
+	// Details is the lookup of the word when it was saved. It is not updated when the word is looked up again.
+	Details   worddomain.LookupDetails
+	CreatedAt time.Time
+}
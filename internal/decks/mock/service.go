@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go
+//
+
+// Package mock_decks is a generated GoMock package.
+package mock_decks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	domain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/domain"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// AddEntry mocks base method.
+func (m *MockService) AddEntry(ctx context.Context, userID, deckID, word, nativeLanguage string) (*domain.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEntry", ctx, userID, deckID, word, nativeLanguage)
+	ret0, _ := ret[0].(*domain.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEntry indicates an expected call of AddEntry.
+func (mr *MockServiceMockRecorder) AddEntry(ctx, userID, deckID, word, nativeLanguage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEntry", reflect.TypeOf((*MockService)(nil).AddEntry), ctx, userID, deckID, word, nativeLanguage)
+}
+
+// CreateDeck mocks base method.
+func (m *MockService) CreateDeck(ctx context.Context, userID, name string) (*domain.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeck", ctx, userID, name)
+	ret0, _ := ret[0].(*domain.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeck indicates an expected call of CreateDeck.
+func (mr *MockServiceMockRecorder) CreateDeck(ctx, userID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeck", reflect.TypeOf((*MockService)(nil).CreateDeck), ctx, userID, name)
+}
+
+// DeleteDeck mocks base method.
+func (m *MockService) DeleteDeck(ctx context.Context, userID, deckID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeck", ctx, userID, deckID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeck indicates an expected call of DeleteDeck.
+func (mr *MockServiceMockRecorder) DeleteDeck(ctx, userID, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeck", reflect.TypeOf((*MockService)(nil).DeleteDeck), ctx, userID, deckID)
+}
+
+// GetDeck mocks base method.
+func (m *MockService) GetDeck(ctx context.Context, userID, deckID string) (*domain.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeck", ctx, userID, deckID)
+	ret0, _ := ret[0].(*domain.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeck indicates an expected call of GetDeck.
+func (mr *MockServiceMockRecorder) GetDeck(ctx, userID, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeck", reflect.TypeOf((*MockService)(nil).GetDeck), ctx, userID, deckID)
+}
+
+// ListDecks mocks base method.
+func (m *MockService) ListDecks(ctx context.Context, userID string) ([]domain.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDecks", ctx, userID)
+	ret0, _ := ret[0].([]domain.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDecks indicates an expected call of ListDecks.
+func (mr *MockServiceMockRecorder) ListDecks(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDecks", reflect.TypeOf((*MockService)(nil).ListDecks), ctx, userID)
+}
+
+// RemoveEntry mocks base method.
+func (m *MockService) RemoveEntry(ctx context.Context, userID, deckID, entryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEntry", ctx, userID, deckID, entryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveEntry indicates an expected call of RemoveEntry.
+func (mr *MockServiceMockRecorder) RemoveEntry(ctx, userID, deckID, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEntry", reflect.TypeOf((*MockService)(nil).RemoveEntry), ctx, userID, deckID, entryID)
+}
+
+// RenameDeck mocks base method.
+func (m *MockService) RenameDeck(ctx context.Context, userID, deckID, name string) (*domain.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDeck", ctx, userID, deckID, name)
+	ret0, _ := ret[0].(*domain.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameDeck indicates an expected call of RenameDeck.
+func (mr *MockServiceMockRecorder) RenameDeck(ctx, userID, deckID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDeck", reflect.TypeOf((*MockService)(nil).RenameDeck), ctx, userID, deckID, name)
+}
+
+// ReorderEntries mocks base method.
+func (m *MockService) ReorderEntries(ctx context.Context, userID, deckID string, entryIDs []string) ([]domain.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderEntries", ctx, userID, deckID, entryIDs)
+	ret0, _ := ret[0].([]domain.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderEntries indicates an expected call of ReorderEntries.
+func (mr *MockServiceMockRecorder) ReorderEntries(ctx, userID, deckID, entryIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderEntries", reflect.TypeOf((*MockService)(nil).ReorderEntries), ctx, userID, deckID, entryIDs)
+}
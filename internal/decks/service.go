@@ -0,0 +1,359 @@
+// Package decks keeps the named decks learners save words into, with the lookup of each word as it was when it
+// was saved.
+package decks
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/canonical"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
+	worddomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
+)
+
+// MaxNameLength is the longest deck name, in characters.
+const MaxNameLength = 100
+
+var (
+	ErrDeckNotFound  = errors.New("deck not found")
+	ErrEntryNotFound = errors.New("deck entry not found")
+	ErrInvalidName   = fmt.Errorf("deck names must have 1 to %d characters", MaxNameLength)
+	ErrNameTaken     = errors.New("deck name already used")
+	ErrEntryExists   = errors.New("word already in deck")
+	// ErrInvalidOrder is returned when a new order does not list every entry of the deck exactly once.
+	ErrInvalidOrder = errors.New("invalid deck order")
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+type Service interface {
+	// ListDecks returns the decks of the user, oldest first, without their entries.
+	ListDecks(ctx context.Context, userID string) ([]domain.Deck, error)
+	// GetDeck returns ErrDeckNotFound when the user has no deck with that ID.
+	GetDeck(ctx context.Context, userID, deckID string) (*domain.Deck, error)
+	CreateDeck(ctx context.Context, userID, name string) (*domain.Deck, error)
+	RenameDeck(ctx context.Context, userID, deckID, name string) (*domain.Deck, error)
+	DeleteDeck(ctx context.Context, userID, deckID string) error
+	// AddEntry looks word up and saves it at the end of the deck. Invalid words are refused with the errors of
+	// word.Service.ValidateWord.
+	AddEntry(ctx context.Context, userID, deckID, word, nativeLanguage string) (*domain.Entry, error)
+	RemoveEntry(ctx context.Context, userID, deckID, entryID string) error
+	// ReorderEntries puts the entries of the deck in the order of entryIDs, which must list each of them once.
+	ReorderEntries(ctx context.Context, userID, deckID string, entryIDs []string) ([]domain.Entry, error)
+}
+
+type service struct {
+	logger      *zap.Logger
+	repository  storage.DeckRepository
+	wordService word.Service
+}
+
+func NewDeckService(logger *zap.Logger, repository storage.DeckRepository, wordService word.Service) Service {
+	return &service{
+		logger:      logger,
+		repository:  repository,
+		wordService: wordService,
+	}
+}
+
+func (s *service) ListDecks(ctx context.Context, userID string) ([]domain.Deck, error) {
+	summaries, err := s.repository.ListDecks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	decks := make([]domain.Deck, 0, len(summaries))
+	for _, summary := range summaries {
+		deck := toDomainDeck(&summary.Deck)
+		deck.EntryCount = summary.EntryCount
+		decks = append(decks, deck)
+	}
+
+	return decks, nil
+}
+
+func (s *service) GetDeck(ctx context.Context, userID, deckID string) (*domain.Deck, error) {
+	deck, err := s.getDeck(ctx, userID, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.listEntries(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := toDomainDeck(deck)
+	result.Entries = entries
+	result.EntryCount = len(entries)
+
+	return &result, nil
+}
+
+func (s *service) CreateDeck(ctx context.Context, userID, name string) (*domain.Deck, error) {
+	name, err := validName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	deck, err := s.repository.InsertDeck(ctx, &entity.Deck{UserID: userID, Name: name})
+	if errors.Is(err, storage.ErrDuplicate) {
+		return nil, ErrNameTaken
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := toDomainDeck(deck)
+
+	return &result, nil
+}
+
+func (s *service) RenameDeck(ctx context.Context, userID, deckID, name string) (*domain.Deck, error) {
+	name, err := validName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	deck, err := s.getDeck(ctx, userID, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	deck.Name = name
+
+	deck, err = s.repository.RenameDeck(ctx, deck)
+	if errors.Is(err, storage.ErrDuplicate) {
+		return nil, ErrNameTaken
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := toDomainDeck(deck)
+
+	return &result, nil
+}
+
+func (s *service) DeleteDeck(ctx context.Context, userID, deckID string) error {
+	if _, err := uuid.Parse(deckID); err != nil {
+		return ErrDeckNotFound
+	}
+
+	deleted, err := s.repository.DeleteDeck(ctx, userID, deckID)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrDeckNotFound
+	}
+
+	return nil
+}
+
+func (s *service) AddEntry(ctx context.Context, userID, deckID, word, nativeLanguage string) (*domain.Entry, error) {
+	word = strings.TrimSpace(word)
+	nativeLanguage = languages.Normalize(nativeLanguage)
+
+	if err := s.wordService.ValidateWord(word); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getDeck(ctx, userID, deckID); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repository.ListEntries(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Checked before the lookup so that saving a word twice, in any spelling, costs no tokens. The unique constraint
+	// still catches entries added concurrently.
+	canonicalWord := canonical.Word(word)
+
+	for _, entry := range entries {
+		if canonical.Word(entry.Word) == canonicalWord && entry.NativeLanguage == nativeLanguage {
+			return nil, ErrEntryExists
+		}
+	}
+
+	position := 1
+	if len(entries) > 0 {
+		position = entries[len(entries)-1].Position + 1
+	}
+
+	details, err := s.wordService.Lookup(ctx, word, nativeLanguage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up %q for deck %s: %w", word, deckID, err)
+	}
+
+	snapshot, err := json.Marshal(worddomain.LookupDetails{
+		Definition: details.Definition,
+		Synonyms:   details.Synonyms,
+		History:    details.History,
+		Difficulty: details.Difficulty,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot %q for deck %s: %w", word, deckID, err)
+	}
+
+	entry, err := s.repository.InsertEntry(ctx, &entity.DeckEntry{
+		DeckID:         deckID,
+		Word:           word,
+		CanonicalWord:  canonicalWord,
+		NativeLanguage: nativeLanguage,
+		Details:        string(snapshot),
+		Position:       position,
+	})
+	if errors.Is(err, storage.ErrDuplicate) {
+		return nil, ErrEntryExists
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ToDomainEntry(entry)
+}
+
+func (s *service) RemoveEntry(ctx context.Context, userID, deckID, entryID string) error {
+	if _, err := s.getDeck(ctx, userID, deckID); err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(entryID); err != nil {
+		return ErrEntryNotFound
+	}
+
+	deleted, err := s.repository.DeleteEntry(ctx, deckID, entryID)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrEntryNotFound
+	}
+
+	return nil
+}
+
+func (s *service) ReorderEntries(ctx context.Context, userID, deckID string, entryIDs []string) ([]domain.Entry, error) {
+	if _, err := s.getDeck(ctx, userID, deckID); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repository.ListEntries(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		current = append(current, entry.ID)
+	}
+
+	requested := slices.Clone(entryIDs)
+	slices.Sort(current)
+	slices.Sort(requested)
+
+	if !slices.Equal(current, requested) {
+		return nil, ErrInvalidOrder
+	}
+
+	if err := s.repository.SetPositions(ctx, deckID, entryIDs); err != nil {
+		return nil, err
+	}
+
+	return s.listEntries(ctx, deckID)
+}
+
+// getDeck returns ErrDeckNotFound when the user has no deck with that ID.
+func (s *service) getDeck(ctx context.Context, userID, deckID string) (*entity.Deck, error) {
+	if _, err := uuid.Parse(deckID); err != nil {
+		return nil, ErrDeckNotFound
+	}
+
+	deck, err := s.repository.GetDeck(ctx, userID, deckID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDeckNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deck %s: %w", deckID, err)
+	}
+
+	return deck, nil
+}
+
+func (s *service) listEntries(ctx context.Context, deckID string) ([]domain.Entry, error) {
+	entries, err := s.repository.ListEntries(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Entry, 0, len(entries))
+
+	for _, entry := range entries {
+		domainEntry, err := ToDomainEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, *domainEntry)
+	}
+
+	return result, nil
+}
+
+// validName returns name without surrounding spaces, or ErrInvalidName.
+func validName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return "", ErrInvalidName
+	}
+
+	return name, nil
+}
+
+func toDomainDeck(deck *entity.Deck) domain.Deck {
+	return domain.Deck{
+		ID:        deck.ID,
+		Name:      deck.Name,
+		CreatedAt: deck.CreatedAt,
+		UpdatedAt: deck.UpdatedAt,
+	}
+}
+
+// ToDomainEntry returns entry with its lookup snapshot decoded.
+func ToDomainEntry(entry *entity.DeckEntry) (*domain.Entry, error) {
+	result := &domain.Entry{
+		ID:             entry.ID,
+		DeckID:         entry.DeckID,
+		Word:           entry.Word,
+		NativeLanguage: entry.NativeLanguage,
+		Position:       entry.Position,
+		CreatedAt:      entry.CreatedAt,
+	}
+
+	if err := json.Unmarshal([]byte(entry.Details), &result.Details); err != nil {
+		return nil, fmt.Errorf("invalid details of deck entry %s: %w", entry.ID, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,194 @@
+package decks_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/storage"
+	mockstorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/storage/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
+	worddomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
+	wordmock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/mock"
+)
+
+const (
+	userID = "user-1"
+	deckID = "6f1c2d4e-0d1a-4a57-9b1e-3f6a2b7c8d90"
+	entryA = "0b6f0a7e-8c1d-4f3e-9a2b-1c2d3e4f5a6b"
+	entryB = "9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b"
+)
+
+func newService(t *testing.T) (decks.Service, *mockstorage.MockDeckRepository, *wordmock.MockService) {
+	ctrl := gomock.NewController(t)
+	repository := mockstorage.NewMockDeckRepository(ctrl)
+	wordService := wordmock.NewMockService(ctrl)
+
+	return decks.NewDeckService(zaptest.NewLogger(t), repository, wordService), repository, wordService
+}
+
+func TestAddEntrySnapshotsTheLookup(t *testing.T) {
+	service, repository, wordService := newService(t)
+
+	lookup := &worddomain.LookupDetails{
+		Definition:    &worddomain.Definition{PartOfSpeech: "noun", Senses: []worddomain.Sense{{Gloss: "cat"}}},
+		Synonyms:      []worddomain.Synonym{{Word: "minino", Nuance: "affectionate"}},
+		Difficulty:    &difficulty.Assessment{CEFR: "A1", Rationale: "Everyday word."},
+		SectionErrors: map[string]error{worddomain.SectionHistory: sql.ErrConnDone},
+		CacheHits:     map[string]bool{worddomain.SectionDefinition: true},
+	}
+
+	wordService.EXPECT().ValidateWord("gato").Return(nil)
+	repository.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(&entity.Deck{ID: deckID, UserID: userID}, nil)
+	repository.EXPECT().ListEntries(gomock.Any(), deckID).Return(entity.DeckEntrySlice{{ID: entryA, Position: 4}}, nil)
+	wordService.EXPECT().Lookup(gomock.Any(), "gato", "English").Return(lookup, nil)
+	repository.EXPECT().InsertEntry(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *entity.DeckEntry) (*entity.DeckEntry, error) {
+			assert.Equal(t, 5, entry.Position, "new entries go last")
+			assert.Equal(t, "English", entry.NativeLanguage)
+			assert.Equal(t, "gato", entry.CanonicalWord)
+
+			var details map[string]any
+			require.NoError(t, json.Unmarshal([]byte(entry.Details), &details))
+			assert.Nil(t, details["SectionErrors"])
+			assert.Nil(t, details["CacheHits"])
+
+			entry.ID = entryB
+
+			return entry, nil
+		})
+
+	entry, err := service.AddEntry(context.Background(), userID, deckID, " gato ", "en")
+	require.NoError(t, err)
+
+	assert.Equal(t, entryB, entry.ID)
+	assert.Equal(t, "gato", entry.Word)
+	assert.Equal(t, lookup.Definition, entry.Details.Definition)
+	assert.Equal(t, lookup.Synonyms, entry.Details.Synonyms)
+	assert.Equal(t, lookup.Difficulty, entry.Details.Difficulty)
+}
+
+func TestAddEntryRefusals(t *testing.T) {
+	t.Run("invalid word", func(t *testing.T) {
+		service, _, wordService := newService(t)
+		wordService.EXPECT().ValidateWord("").Return(word.ErrEmptyWord)
+
+		_, err := service.AddEntry(context.Background(), userID, deckID, "", "English")
+		assert.ErrorIs(t, err, word.ErrEmptyWord)
+	})
+
+	t.Run("deck of another user", func(t *testing.T) {
+		service, repository, wordService := newService(t)
+		wordService.EXPECT().ValidateWord("gato").Return(nil)
+		repository.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(nil, sql.ErrNoRows)
+
+		_, err := service.AddEntry(context.Background(), userID, deckID, "gato", "English")
+		assert.ErrorIs(t, err, decks.ErrDeckNotFound)
+	})
+
+	t.Run("word already saved", func(t *testing.T) {
+		service, repository, wordService := newService(t)
+		wordService.EXPECT().ValidateWord("gato").Return(nil)
+		repository.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(&entity.Deck{ID: deckID}, nil)
+		repository.EXPECT().ListEntries(gomock.Any(), deckID).Return(nil, nil)
+		wordService.EXPECT().Lookup(gomock.Any(), "gato", "English").Return(&worddomain.LookupDetails{}, nil)
+		repository.EXPECT().InsertEntry(gomock.Any(), gomock.Any()).Return(nil, storage.ErrDuplicate)
+
+		_, err := service.AddEntry(context.Background(), userID, deckID, "gato", "English")
+		assert.ErrorIs(t, err, decks.ErrEntryExists)
+	})
+
+	t.Run("word already in the deck is not looked up", func(t *testing.T) {
+		service, repository, wordService := newService(t)
+		wordService.EXPECT().ValidateWord("gato").Return(nil)
+		repository.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(&entity.Deck{ID: deckID}, nil)
+		repository.EXPECT().ListEntries(gomock.Any(), deckID).Return(entity.DeckEntrySlice{
+			{ID: "entry-1", DeckID: deckID, Word: "gato", NativeLanguage: "English", Position: 1},
+		}, nil)
+
+		_, err := service.AddEntry(context.Background(), userID, deckID, " gato ", "english")
+		assert.ErrorIs(t, err, decks.ErrEntryExists)
+	})
+
+	t.Run("word already in the deck in another spelling", func(t *testing.T) {
+		service, repository, wordService := newService(t)
+		wordService.EXPECT().ValidateWord("ＧＡＴＯ").Return(nil)
+		repository.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(&entity.Deck{ID: deckID}, nil)
+		repository.EXPECT().ListEntries(gomock.Any(), deckID).Return(entity.DeckEntrySlice{
+			{ID: "entry-1", DeckID: deckID, Word: "Gato", CanonicalWord: "gato", NativeLanguage: "English", Position: 1},
+		}, nil)
+
+		_, err := service.AddEntry(context.Background(), userID, deckID, "ＧＡＴＯ", "English")
+		assert.ErrorIs(t, err, decks.ErrEntryExists)
+	})
+}
+
+func TestDeckNames(t *testing.T) {
+	service, repository, _ := newService(t)
+
+	for _, name := range []string{"", "   ", strings.Repeat("あ", decks.MaxNameLength+1)} {
+		_, err := service.CreateDeck(context.Background(), userID, name)
+		assert.ErrorIs(t, err, decks.ErrInvalidName)
+	}
+
+	repository.EXPECT().InsertDeck(gomock.Any(), &entity.Deck{UserID: userID, Name: "JLPT N3"}).Return(nil, storage.ErrDuplicate)
+
+	_, err := service.CreateDeck(context.Background(), userID, " JLPT N3 ")
+	assert.ErrorIs(t, err, decks.ErrNameTaken)
+}
+
+func TestReorderEntries(t *testing.T) {
+	testCases := []struct {
+		name     string
+		entryIDs []string
+		valid    bool
+	}{
+		{name: "new order", entryIDs: []string{entryB, entryA}, valid: true},
+		{name: "missing entry", entryIDs: []string{entryB}},
+		{name: "repeated entry", entryIDs: []string{entryB, entryB}},
+		{name: "unknown entry", entryIDs: []string{entryB, deckID}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service, repository, _ := newService(t)
+
+			entries := entity.DeckEntrySlice{
+				{ID: entryA, Position: 1, Details: "{}"},
+				{ID: entryB, Position: 2, Details: "{}"},
+			}
+
+			repository.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(&entity.Deck{ID: deckID}, nil)
+			repository.EXPECT().ListEntries(gomock.Any(), deckID).Return(entries, nil)
+
+			if tc.valid {
+				repository.EXPECT().SetPositions(gomock.Any(), deckID, tc.entryIDs).Return(nil)
+				repository.EXPECT().ListEntries(gomock.Any(), deckID).Return(entity.DeckEntrySlice{
+					{ID: entryB, Position: 1, Details: "{}"},
+					{ID: entryA, Position: 2, Details: "{}"},
+				}, nil)
+			}
+
+			reordered, err := service.ReorderEntries(context.Background(), userID, deckID, tc.entryIDs)
+			if !tc.valid {
+				assert.ErrorIs(t, err, decks.ErrInvalidOrder)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, reordered, 2)
+			assert.Equal(t, entryB, reordered[0].ID)
+			assert.Equal(t, entryA, reordered[1].ID)
+		})
+	}
+}
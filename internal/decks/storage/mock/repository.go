@@ -0,0 +1,177 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=mock/repository.go
+//
+
+// Package mock_storage is a generated GoMock package.
+package mock_storage
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	storage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/storage"
+	entity "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+// MockDeckRepository is a mock of DeckRepository interface.
+type MockDeckRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeckRepositoryMockRecorder
+}
+
+// MockDeckRepositoryMockRecorder is the mock recorder for MockDeckRepository.
+type MockDeckRepositoryMockRecorder struct {
+	mock *MockDeckRepository
+}
+
+// NewMockDeckRepository creates a new mock instance.
+func NewMockDeckRepository(ctrl *gomock.Controller) *MockDeckRepository {
+	mock := &MockDeckRepository{ctrl: ctrl}
+	mock.recorder = &MockDeckRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeckRepository) EXPECT() *MockDeckRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteDeck mocks base method.
+func (m *MockDeckRepository) DeleteDeck(ctx context.Context, userID, deckID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeck", ctx, userID, deckID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDeck indicates an expected call of DeleteDeck.
+func (mr *MockDeckRepositoryMockRecorder) DeleteDeck(ctx, userID, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeck", reflect.TypeOf((*MockDeckRepository)(nil).DeleteDeck), ctx, userID, deckID)
+}
+
+// DeleteEntry mocks base method.
+func (m *MockDeckRepository) DeleteEntry(ctx context.Context, deckID, entryID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEntry", ctx, deckID, entryID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEntry indicates an expected call of DeleteEntry.
+func (mr *MockDeckRepositoryMockRecorder) DeleteEntry(ctx, deckID, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntry", reflect.TypeOf((*MockDeckRepository)(nil).DeleteEntry), ctx, deckID, entryID)
+}
+
+// GetDeck mocks base method.
+func (m *MockDeckRepository) GetDeck(ctx context.Context, userID, deckID string) (*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeck", ctx, userID, deckID)
+	ret0, _ := ret[0].(*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeck indicates an expected call of GetDeck.
+func (mr *MockDeckRepositoryMockRecorder) GetDeck(ctx, userID, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeck", reflect.TypeOf((*MockDeckRepository)(nil).GetDeck), ctx, userID, deckID)
+}
+
+// InsertDeck mocks base method.
+func (m *MockDeckRepository) InsertDeck(ctx context.Context, deck *entity.Deck) (*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDeck", ctx, deck)
+	ret0, _ := ret[0].(*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertDeck indicates an expected call of InsertDeck.
+func (mr *MockDeckRepositoryMockRecorder) InsertDeck(ctx, deck any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDeck", reflect.TypeOf((*MockDeckRepository)(nil).InsertDeck), ctx, deck)
+}
+
+// InsertEntry mocks base method.
+func (m *MockDeckRepository) InsertEntry(ctx context.Context, entry *entity.DeckEntry) (*entity.DeckEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertEntry", ctx, entry)
+	ret0, _ := ret[0].(*entity.DeckEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertEntry indicates an expected call of InsertEntry.
+func (mr *MockDeckRepositoryMockRecorder) InsertEntry(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEntry", reflect.TypeOf((*MockDeckRepository)(nil).InsertEntry), ctx, entry)
+}
+
+// ListDecks mocks base method.
+func (m *MockDeckRepository) ListDecks(ctx context.Context, userID string) ([]*storage.DeckSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDecks", ctx, userID)
+	ret0, _ := ret[0].([]*storage.DeckSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDecks indicates an expected call of ListDecks.
+func (mr *MockDeckRepositoryMockRecorder) ListDecks(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDecks", reflect.TypeOf((*MockDeckRepository)(nil).ListDecks), ctx, userID)
+}
+
+// ListEntries mocks base method.
+func (m *MockDeckRepository) ListEntries(ctx context.Context, deckID string) (entity.DeckEntrySlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, deckID)
+	ret0, _ := ret[0].(entity.DeckEntrySlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockDeckRepositoryMockRecorder) ListEntries(ctx, deckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockDeckRepository)(nil).ListEntries), ctx, deckID)
+}
+
+// RenameDeck mocks base method.
+func (m *MockDeckRepository) RenameDeck(ctx context.Context, deck *entity.Deck) (*entity.Deck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDeck", ctx, deck)
+	ret0, _ := ret[0].(*entity.Deck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameDeck indicates an expected call of RenameDeck.
+func (mr *MockDeckRepositoryMockRecorder) RenameDeck(ctx, deck any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDeck", reflect.TypeOf((*MockDeckRepository)(nil).RenameDeck), ctx, deck)
+}
+
+// SetPositions mocks base method.
+func (m *MockDeckRepository) SetPositions(ctx context.Context, deckID string, entryIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPositions", ctx, deckID, entryIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPositions indicates an expected call of SetPositions.
+func (mr *MockDeckRepositoryMockRecorder) SetPositions(ctx, deckID, entryIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPositions", reflect.TypeOf((*MockDeckRepository)(nil).SetPositions), ctx, deckID, entryIDs)
+}
@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+// ErrDuplicate is returned when a deck name or deck entry is already taken.
+var ErrDuplicate = errors.New("already exists")
+
+// uniqueViolation is the Postgres error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+// DeckSummary is a deck with the number of entries it holds.
+type DeckSummary struct {
+	entity.Deck `boil:",bind"`
+	EntryCount  int `boil:"entry_count"`
+}
+
+//go:generate mockgen -source=repository.go -destination=mock/repository.go
+type DeckRepository interface {
+	// ListDecks returns the decks of the user, oldest first.
+	ListDecks(ctx context.Context, userID string) ([]*DeckSummary, error)
+	// GetDeck returns sql.ErrNoRows when the user has no deck with that ID.
+	GetDeck(ctx context.Context, userID, deckID string) (*entity.Deck, error)
+	// InsertDeck returns ErrDuplicate when the user already has a deck with that name.
+	InsertDeck(ctx context.Context, deck *entity.Deck) (*entity.Deck, error)
+	// RenameDeck returns ErrDuplicate when the user already has a deck with that name.
+	RenameDeck(ctx context.Context, deck *entity.Deck) (*entity.Deck, error)
+	// DeleteDeck returns the number of decks deleted, 0 when the user has no deck with that ID.
+	DeleteDeck(ctx context.Context, userID, deckID string) (int, error)
+	// ListEntries returns the entries of the deck in order.
+	ListEntries(ctx context.Context, deckID string) (entity.DeckEntrySlice, error)
+	// InsertEntry returns ErrDuplicate when the word is already in the deck in that native language.
+	InsertEntry(ctx context.Context, entry *entity.DeckEntry) (*entity.DeckEntry, error)
+	// DeleteEntry returns the number of entries deleted, 0 when the deck has no entry with that ID.
+	DeleteEntry(ctx context.Context, deckID, entryID string) (int, error)
+	// SetPositions numbers the entries of the deck in the order of entryIDs, from 1, in a single transaction.
+	SetPositions(ctx context.Context, deckID string, entryIDs []string) error
+}
+
+type deckRepository struct {
+	db *sqlx.DB
+}
+
+func NewDeckRepository(db *sqlx.DB) DeckRepository {
+	return &deckRepository{
+		db: db,
+	}
+}
+
+const listDecksQuery = `
+SELECT decks.*, COUNT(deck_entries.id) AS entry_count
+FROM decks
+LEFT JOIN deck_entries ON deck_entries.deck_id = decks.id
+WHERE decks.user_id = $1
+GROUP BY decks.id
+ORDER BY decks.created_at, decks.id`
+
+func (r *deckRepository) ListDecks(ctx context.Context, userID string) ([]*DeckSummary, error) {
+	var decks []*DeckSummary
+
+	err := queries.Raw(listDecksQuery, userID).Bind(ctx, r.db, &decks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list decks of user %s: %w", userID, err)
+	}
+
+	return decks, nil
+}
+
+func (r *deckRepository) GetDeck(ctx context.Context, userID, deckID string) (*entity.Deck, error) {
+	return entity.Decks(
+		entity.DeckWhere.ID.EQ(deckID),
+		entity.DeckWhere.UserID.EQ(userID),
+	).One(ctx, r.db)
+}
+
+func (r *deckRepository) InsertDeck(ctx context.Context, deck *entity.Deck) (*entity.Deck, error) {
+	err := deck.Insert(ctx, r.db, boil.Infer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert deck: %w", duplicate(err))
+	}
+
+	return deck, nil
+}
+
+func (r *deckRepository) RenameDeck(ctx context.Context, deck *entity.Deck) (*entity.Deck, error) {
+	deck.UpdatedAt = time.Now().UTC()
+
+	_, err := deck.Update(ctx, r.db, boil.Whitelist(entity.DeckColumns.Name, entity.DeckColumns.UpdatedAt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename deck %s: %w", deck.ID, duplicate(err))
+	}
+
+	return deck, nil
+}
+
+func (r *deckRepository) DeleteDeck(ctx context.Context, userID, deckID string) (int, error) {
+	deleted, err := entity.Decks(
+		entity.DeckWhere.ID.EQ(deckID),
+		entity.DeckWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete deck %s: %w", deckID, err)
+	}
+
+	return int(deleted), nil
+}
+
+func (r *deckRepository) ListEntries(ctx context.Context, deckID string) (entity.DeckEntrySlice, error) {
+	entries, err := entity.DeckEntries(
+		entity.DeckEntryWhere.DeckID.EQ(deckID),
+		qm.OrderBy(entity.DeckEntryColumns.Position+", "+entity.DeckEntryColumns.CreatedAt),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entries of deck %s: %w", deckID, err)
+	}
+
+	return entries, nil
+}
+
+func (r *deckRepository) InsertEntry(ctx context.Context, entry *entity.DeckEntry) (*entity.DeckEntry, error) {
+	err := entry.Insert(ctx, r.db, boil.Infer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert entry into deck %s: %w", entry.DeckID, duplicate(err))
+	}
+
+	return entry, nil
+}
+
+func (r *deckRepository) DeleteEntry(ctx context.Context, deckID, entryID string) (int, error) {
+	deleted, err := entity.DeckEntries(
+		entity.DeckEntryWhere.ID.EQ(entryID),
+		entity.DeckEntryWhere.DeckID.EQ(deckID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete entry %s of deck %s: %w", entryID, deckID, err)
+	}
+
+	return int(deleted), nil
+}
+
+func (r *deckRepository) SetPositions(ctx context.Context, deckID string, entryIDs []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin reordering deck %s: %w", deckID, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now().UTC()
+
+	for i, entryID := range entryIDs {
+		_, err := entity.DeckEntries(
+			entity.DeckEntryWhere.ID.EQ(entryID),
+			entity.DeckEntryWhere.DeckID.EQ(deckID),
+		).UpdateAll(ctx, tx, entity.M{
+			entity.DeckEntryColumns.Position:  i + 1,
+			entity.DeckEntryColumns.UpdatedAt: now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to move entry %s of deck %s: %w", entryID, deckID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to reorder deck %s: %w", deckID, err)
+	}
+
+	return nil
+}
+
+// duplicate returns ErrDuplicate for unique constraint violations, and err otherwise.
+func duplicate(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return ErrDuplicate
+	}
+
+	return err
+}
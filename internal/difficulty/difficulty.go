@@ -0,0 +1,75 @@
+// Package difficulty describes how hard a word or sentence is for learners: its CEFR level, and its JLPT or HSK level
+// for Japanese and Chinese.
+package difficulty
+
+import "encoding/json"
+
+// CEFRLevels are the CEFR levels, from easiest to hardest.
+var CEFRLevels = []string{"A1", "A2", "B1", "B2", "C1", "C2"}
+
+// Assessment is the difficulty of a word or sentence.
+type Assessment struct {
+	CEFR string
+	// JLPT is N5 to N1 for Japanese, and empty for other languages.
+	JLPT string
+	// HSK is the HSK 3.0 level, 1 to 9, for Chinese, and empty for other languages.
+	HSK string
+	// Rationale explains the levels, in the user's native language.
+	Rationale string
+}
+
+// Payload mirrors JSONSchema.
+type Payload struct {
+	CEFR      string `json:"cefr" validate:"oneof=A1 A2 B1 B2 C1 C2"`
+	JLPT      string `json:"jlpt" validate:"omitempty,oneof=N5 N4 N3 N2 N1"`
+	HSK       string `json:"hsk" validate:"omitempty,oneof=1 2 3 4 5 6 7 8 9"`
+	Rationale string `json:"rationale" validate:"required"`
+}
+
+// Schema is the structured output schema of an assessment, to embed in larger schemas.
+var Schema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"cefr": map[string]any{
+			"type":        "string",
+			"enum":        CEFRLevels,
+			"description": "The CEFR level a learner needs to understand it.",
+		},
+		"jlpt": map[string]any{
+			"type":        "string",
+			"enum":        []string{"", "N5", "N4", "N3", "N2", "N1"},
+			"description": "The JLPT level for Japanese. Empty for other languages.",
+		},
+		"hsk": map[string]any{
+			"type":        "string",
+			"enum":        []string{"", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
+			"description": "The HSK 3.0 level for Chinese. Empty for other languages.",
+		},
+		"rationale": map[string]any{
+			"type":        "string",
+			"description": "One or two sentences explaining the levels, written in the user's language.",
+		},
+	},
+	"required":             []string{"cefr", "hsk", "jlpt", "rationale"},
+	"additionalProperties": false,
+}
+
+// JSONSchema is Schema, marshalled for a response format.
+var JSONSchema = func() json.RawMessage {
+	raw, err := json.Marshal(Schema)
+	if err != nil {
+		panic(err)
+	}
+
+	return raw
+}()
+
+// ToDomain returns the Assessment held by p.
+func (p Payload) ToDomain() *Assessment {
+	return &Assessment{
+		CEFR:      p.CEFR,
+		JLPT:      p.JLPT,
+		HSK:       p.HSK,
+		Rationale: p.Rationale,
+	}
+}
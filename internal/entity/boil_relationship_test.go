@@ -8,35 +8,73 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("DeckEntryToDeckUsingDeck", testDeckEntryToOneDeckUsingDeck)
+	t.Run("DeckToUserUsingUser", testDeckToOneUserUsingUser)
+	t.Run("ExperimentResponseToUserUsingUser", testExperimentResponseToOneUserUsingUser)
+	t.Run("ImportJobToUserUsingUser", testImportJobToOneUserUsingUser)
+	t.Run("ImportJobToDeckUsingDeck", testImportJobToOneDeckUsingDeck)
+	t.Run("LookupHistoryToUserUsingUser", testLookupHistoryToOneUserUsingUser)
 	t.Run("PaymentTransactionToUserUsingUser", testPaymentTransactionToOneUserUsingUser)
+	t.Run("QuizToUserUsingUser", testQuizToOneUserUsingUser)
+	t.Run("ReviewCardToDeckEntryUsingDeckEntry", testReviewCardToOneDeckEntryUsingDeckEntry)
+	t.Run("ReviewLogToDeckEntryUsingDeckEntry", testReviewLogToOneDeckEntryUsingDeckEntry)
+	t.Run("ReviewLogToUserUsingUser", testReviewLogToOneUserUsingUser)
 	t.Run("SubscriptionToUserUsingUser", testSubscriptionToOneUserUsingUser)
+	t.Run("TokenUsageToUserUsingUser", testTokenUsageToOneUserUsingUser)
 }
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOne(t *testing.T) {}
+func TestOneToOne(t *testing.T) {
+	t.Run("DeckEntryToReviewCardUsingReviewCard", testDeckEntryOneToOneReviewCardUsingReviewCard)
+}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("DeckEntryToReviewLogs", testDeckEntryToManyReviewLogs)
+	t.Run("DeckToDeckEntries", testDeckToManyDeckEntries)
+	t.Run("DeckToImportJobs", testDeckToManyImportJobs)
+	t.Run("UserToDecks", testUserToManyDecks)
+	t.Run("UserToExperimentResponses", testUserToManyExperimentResponses)
+	t.Run("UserToImportJobs", testUserToManyImportJobs)
+	t.Run("UserToLookupHistories", testUserToManyLookupHistories)
 	t.Run("UserToPaymentTransactions", testUserToManyPaymentTransactions)
+	t.Run("UserToQuizzes", testUserToManyQuizzes)
+	t.Run("UserToReviewLogs", testUserToManyReviewLogs)
 	t.Run("UserToSubscriptions", testUserToManySubscriptions)
+	t.Run("UserToTokenUsages", testUserToManyTokenUsages)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("DeckEntryToDeckUsingDeckEntries", testDeckEntryToOneSetOpDeckUsingDeck)
+	t.Run("DeckToUserUsingDecks", testDeckToOneSetOpUserUsingUser)
+	t.Run("ExperimentResponseToUserUsingExperimentResponses", testExperimentResponseToOneSetOpUserUsingUser)
+	t.Run("ImportJobToUserUsingImportJobs", testImportJobToOneSetOpUserUsingUser)
+	t.Run("ImportJobToDeckUsingImportJobs", testImportJobToOneSetOpDeckUsingDeck)
+	t.Run("LookupHistoryToUserUsingLookupHistories", testLookupHistoryToOneSetOpUserUsingUser)
 	t.Run("PaymentTransactionToUserUsingPaymentTransactions", testPaymentTransactionToOneSetOpUserUsingUser)
+	t.Run("QuizToUserUsingQuizzes", testQuizToOneSetOpUserUsingUser)
+	t.Run("ReviewCardToDeckEntryUsingReviewCard", testReviewCardToOneSetOpDeckEntryUsingDeckEntry)
+	t.Run("ReviewLogToDeckEntryUsingReviewLogs", testReviewLogToOneSetOpDeckEntryUsingDeckEntry)
+	t.Run("ReviewLogToUserUsingReviewLogs", testReviewLogToOneSetOpUserUsingUser)
 	t.Run("SubscriptionToUserUsingSubscriptions", testSubscriptionToOneSetOpUserUsingUser)
+	t.Run("TokenUsageToUserUsingTokenUsages", testTokenUsageToOneSetOpUserUsingUser)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("ExperimentResponseToUserUsingExperimentResponses", testExperimentResponseToOneRemoveOpUserUsingUser)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOneSet(t *testing.T) {}
+func TestOneToOneSet(t *testing.T) {
+	t.Run("DeckEntryToReviewCardUsingReviewCard", testDeckEntryOneToOneSetOpReviewCardUsingReviewCard)
+}
 
 // TestOneToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
@@ -45,14 +83,28 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("DeckEntryToReviewLogs", testDeckEntryToManyAddOpReviewLogs)
+	t.Run("DeckToDeckEntries", testDeckToManyAddOpDeckEntries)
+	t.Run("DeckToImportJobs", testDeckToManyAddOpImportJobs)
+	t.Run("UserToDecks", testUserToManyAddOpDecks)
+	t.Run("UserToExperimentResponses", testUserToManyAddOpExperimentResponses)
+	t.Run("UserToImportJobs", testUserToManyAddOpImportJobs)
+	t.Run("UserToLookupHistories", testUserToManyAddOpLookupHistories)
 	t.Run("UserToPaymentTransactions", testUserToManyAddOpPaymentTransactions)
+	t.Run("UserToQuizzes", testUserToManyAddOpQuizzes)
+	t.Run("UserToReviewLogs", testUserToManyAddOpReviewLogs)
 	t.Run("UserToSubscriptions", testUserToManyAddOpSubscriptions)
+	t.Run("UserToTokenUsages", testUserToManyAddOpTokenUsages)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("UserToExperimentResponses", testUserToManySetOpExperimentResponses)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("UserToExperimentResponses", testUserToManyRemoveOpExperimentResponses)
+}
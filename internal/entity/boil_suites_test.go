@@ -12,124 +12,304 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntries)
+	t.Run("Decks", testDecks)
+	t.Run("ExperimentResponses", testExperimentResponses)
+	t.Run("GeneratedContents", testGeneratedContents)
 	t.Run("GooseDBVersions", testGooseDBVersions)
+	t.Run("ImportJobs", testImportJobs)
+	t.Run("LookupHistories", testLookupHistories)
 	t.Run("PaymentTransactions", testPaymentTransactions)
+	t.Run("Quizzes", testQuizzes)
+	t.Run("ReviewCards", testReviewCards)
+	t.Run("ReviewLogs", testReviewLogs)
 	t.Run("Subscriptions", testSubscriptions)
+	t.Run("TokenUsages", testTokenUsages)
 	t.Run("Users", testUsers)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesDelete)
+	t.Run("Decks", testDecksDelete)
+	t.Run("ExperimentResponses", testExperimentResponsesDelete)
+	t.Run("GeneratedContents", testGeneratedContentsDelete)
 	t.Run("GooseDBVersions", testGooseDBVersionsDelete)
+	t.Run("ImportJobs", testImportJobsDelete)
+	t.Run("LookupHistories", testLookupHistoriesDelete)
 	t.Run("PaymentTransactions", testPaymentTransactionsDelete)
+	t.Run("Quizzes", testQuizzesDelete)
+	t.Run("ReviewCards", testReviewCardsDelete)
+	t.Run("ReviewLogs", testReviewLogsDelete)
 	t.Run("Subscriptions", testSubscriptionsDelete)
+	t.Run("TokenUsages", testTokenUsagesDelete)
 	t.Run("Users", testUsersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesQueryDeleteAll)
+	t.Run("Decks", testDecksQueryDeleteAll)
+	t.Run("ExperimentResponses", testExperimentResponsesQueryDeleteAll)
+	t.Run("GeneratedContents", testGeneratedContentsQueryDeleteAll)
 	t.Run("GooseDBVersions", testGooseDBVersionsQueryDeleteAll)
+	t.Run("ImportJobs", testImportJobsQueryDeleteAll)
+	t.Run("LookupHistories", testLookupHistoriesQueryDeleteAll)
 	t.Run("PaymentTransactions", testPaymentTransactionsQueryDeleteAll)
+	t.Run("Quizzes", testQuizzesQueryDeleteAll)
+	t.Run("ReviewCards", testReviewCardsQueryDeleteAll)
+	t.Run("ReviewLogs", testReviewLogsQueryDeleteAll)
 	t.Run("Subscriptions", testSubscriptionsQueryDeleteAll)
+	t.Run("TokenUsages", testTokenUsagesQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesSliceDeleteAll)
+	t.Run("Decks", testDecksSliceDeleteAll)
+	t.Run("ExperimentResponses", testExperimentResponsesSliceDeleteAll)
+	t.Run("GeneratedContents", testGeneratedContentsSliceDeleteAll)
 	t.Run("GooseDBVersions", testGooseDBVersionsSliceDeleteAll)
+	t.Run("ImportJobs", testImportJobsSliceDeleteAll)
+	t.Run("LookupHistories", testLookupHistoriesSliceDeleteAll)
 	t.Run("PaymentTransactions", testPaymentTransactionsSliceDeleteAll)
+	t.Run("Quizzes", testQuizzesSliceDeleteAll)
+	t.Run("ReviewCards", testReviewCardsSliceDeleteAll)
+	t.Run("ReviewLogs", testReviewLogsSliceDeleteAll)
 	t.Run("Subscriptions", testSubscriptionsSliceDeleteAll)
+	t.Run("TokenUsages", testTokenUsagesSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesExists)
+	t.Run("Decks", testDecksExists)
+	t.Run("ExperimentResponses", testExperimentResponsesExists)
+	t.Run("GeneratedContents", testGeneratedContentsExists)
 	t.Run("GooseDBVersions", testGooseDBVersionsExists)
+	t.Run("ImportJobs", testImportJobsExists)
+	t.Run("LookupHistories", testLookupHistoriesExists)
 	t.Run("PaymentTransactions", testPaymentTransactionsExists)
+	t.Run("Quizzes", testQuizzesExists)
+	t.Run("ReviewCards", testReviewCardsExists)
+	t.Run("ReviewLogs", testReviewLogsExists)
 	t.Run("Subscriptions", testSubscriptionsExists)
+	t.Run("TokenUsages", testTokenUsagesExists)
 	t.Run("Users", testUsersExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesFind)
+	t.Run("Decks", testDecksFind)
+	t.Run("ExperimentResponses", testExperimentResponsesFind)
+	t.Run("GeneratedContents", testGeneratedContentsFind)
 	t.Run("GooseDBVersions", testGooseDBVersionsFind)
+	t.Run("ImportJobs", testImportJobsFind)
+	t.Run("LookupHistories", testLookupHistoriesFind)
 	t.Run("PaymentTransactions", testPaymentTransactionsFind)
+	t.Run("Quizzes", testQuizzesFind)
+	t.Run("ReviewCards", testReviewCardsFind)
+	t.Run("ReviewLogs", testReviewLogsFind)
 	t.Run("Subscriptions", testSubscriptionsFind)
+	t.Run("TokenUsages", testTokenUsagesFind)
 	t.Run("Users", testUsersFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesBind)
+	t.Run("Decks", testDecksBind)
+	t.Run("ExperimentResponses", testExperimentResponsesBind)
+	t.Run("GeneratedContents", testGeneratedContentsBind)
 	t.Run("GooseDBVersions", testGooseDBVersionsBind)
+	t.Run("ImportJobs", testImportJobsBind)
+	t.Run("LookupHistories", testLookupHistoriesBind)
 	t.Run("PaymentTransactions", testPaymentTransactionsBind)
+	t.Run("Quizzes", testQuizzesBind)
+	t.Run("ReviewCards", testReviewCardsBind)
+	t.Run("ReviewLogs", testReviewLogsBind)
 	t.Run("Subscriptions", testSubscriptionsBind)
+	t.Run("TokenUsages", testTokenUsagesBind)
 	t.Run("Users", testUsersBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesOne)
+	t.Run("Decks", testDecksOne)
+	t.Run("ExperimentResponses", testExperimentResponsesOne)
+	t.Run("GeneratedContents", testGeneratedContentsOne)
 	t.Run("GooseDBVersions", testGooseDBVersionsOne)
+	t.Run("ImportJobs", testImportJobsOne)
+	t.Run("LookupHistories", testLookupHistoriesOne)
 	t.Run("PaymentTransactions", testPaymentTransactionsOne)
+	t.Run("Quizzes", testQuizzesOne)
+	t.Run("ReviewCards", testReviewCardsOne)
+	t.Run("ReviewLogs", testReviewLogsOne)
 	t.Run("Subscriptions", testSubscriptionsOne)
+	t.Run("TokenUsages", testTokenUsagesOne)
 	t.Run("Users", testUsersOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesAll)
+	t.Run("Decks", testDecksAll)
+	t.Run("ExperimentResponses", testExperimentResponsesAll)
+	t.Run("GeneratedContents", testGeneratedContentsAll)
 	t.Run("GooseDBVersions", testGooseDBVersionsAll)
+	t.Run("ImportJobs", testImportJobsAll)
+	t.Run("LookupHistories", testLookupHistoriesAll)
 	t.Run("PaymentTransactions", testPaymentTransactionsAll)
+	t.Run("Quizzes", testQuizzesAll)
+	t.Run("ReviewCards", testReviewCardsAll)
+	t.Run("ReviewLogs", testReviewLogsAll)
 	t.Run("Subscriptions", testSubscriptionsAll)
+	t.Run("TokenUsages", testTokenUsagesAll)
 	t.Run("Users", testUsersAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesCount)
+	t.Run("Decks", testDecksCount)
+	t.Run("ExperimentResponses", testExperimentResponsesCount)
+	t.Run("GeneratedContents", testGeneratedContentsCount)
 	t.Run("GooseDBVersions", testGooseDBVersionsCount)
+	t.Run("ImportJobs", testImportJobsCount)
+	t.Run("LookupHistories", testLookupHistoriesCount)
 	t.Run("PaymentTransactions", testPaymentTransactionsCount)
+	t.Run("Quizzes", testQuizzesCount)
+	t.Run("ReviewCards", testReviewCardsCount)
+	t.Run("ReviewLogs", testReviewLogsCount)
 	t.Run("Subscriptions", testSubscriptionsCount)
+	t.Run("TokenUsages", testTokenUsagesCount)
 	t.Run("Users", testUsersCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesHooks)
+	t.Run("Decks", testDecksHooks)
+	t.Run("ExperimentResponses", testExperimentResponsesHooks)
+	t.Run("GeneratedContents", testGeneratedContentsHooks)
 	t.Run("GooseDBVersions", testGooseDBVersionsHooks)
+	t.Run("ImportJobs", testImportJobsHooks)
+	t.Run("LookupHistories", testLookupHistoriesHooks)
 	t.Run("PaymentTransactions", testPaymentTransactionsHooks)
+	t.Run("Quizzes", testQuizzesHooks)
+	t.Run("ReviewCards", testReviewCardsHooks)
+	t.Run("ReviewLogs", testReviewLogsHooks)
 	t.Run("Subscriptions", testSubscriptionsHooks)
+	t.Run("TokenUsages", testTokenUsagesHooks)
 	t.Run("Users", testUsersHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesInsert)
+	t.Run("DeckEntries", testDeckEntriesInsertWhitelist)
+	t.Run("Decks", testDecksInsert)
+	t.Run("Decks", testDecksInsertWhitelist)
+	t.Run("ExperimentResponses", testExperimentResponsesInsert)
+	t.Run("ExperimentResponses", testExperimentResponsesInsertWhitelist)
+	t.Run("GeneratedContents", testGeneratedContentsInsert)
+	t.Run("GeneratedContents", testGeneratedContentsInsertWhitelist)
 	t.Run("GooseDBVersions", testGooseDBVersionsInsert)
 	t.Run("GooseDBVersions", testGooseDBVersionsInsertWhitelist)
+	t.Run("ImportJobs", testImportJobsInsert)
+	t.Run("ImportJobs", testImportJobsInsertWhitelist)
+	t.Run("LookupHistories", testLookupHistoriesInsert)
+	t.Run("LookupHistories", testLookupHistoriesInsertWhitelist)
 	t.Run("PaymentTransactions", testPaymentTransactionsInsert)
 	t.Run("PaymentTransactions", testPaymentTransactionsInsertWhitelist)
+	t.Run("Quizzes", testQuizzesInsert)
+	t.Run("Quizzes", testQuizzesInsertWhitelist)
+	t.Run("ReviewCards", testReviewCardsInsert)
+	t.Run("ReviewCards", testReviewCardsInsertWhitelist)
+	t.Run("ReviewLogs", testReviewLogsInsert)
+	t.Run("ReviewLogs", testReviewLogsInsertWhitelist)
 	t.Run("Subscriptions", testSubscriptionsInsert)
 	t.Run("Subscriptions", testSubscriptionsInsertWhitelist)
+	t.Run("TokenUsages", testTokenUsagesInsert)
+	t.Run("TokenUsages", testTokenUsagesInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesReload)
+	t.Run("Decks", testDecksReload)
+	t.Run("ExperimentResponses", testExperimentResponsesReload)
+	t.Run("GeneratedContents", testGeneratedContentsReload)
 	t.Run("GooseDBVersions", testGooseDBVersionsReload)
+	t.Run("ImportJobs", testImportJobsReload)
+	t.Run("LookupHistories", testLookupHistoriesReload)
 	t.Run("PaymentTransactions", testPaymentTransactionsReload)
+	t.Run("Quizzes", testQuizzesReload)
+	t.Run("ReviewCards", testReviewCardsReload)
+	t.Run("ReviewLogs", testReviewLogsReload)
 	t.Run("Subscriptions", testSubscriptionsReload)
+	t.Run("TokenUsages", testTokenUsagesReload)
 	t.Run("Users", testUsersReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesReloadAll)
+	t.Run("Decks", testDecksReloadAll)
+	t.Run("ExperimentResponses", testExperimentResponsesReloadAll)
+	t.Run("GeneratedContents", testGeneratedContentsReloadAll)
 	t.Run("GooseDBVersions", testGooseDBVersionsReloadAll)
+	t.Run("ImportJobs", testImportJobsReloadAll)
+	t.Run("LookupHistories", testLookupHistoriesReloadAll)
 	t.Run("PaymentTransactions", testPaymentTransactionsReloadAll)
+	t.Run("Quizzes", testQuizzesReloadAll)
+	t.Run("ReviewCards", testReviewCardsReloadAll)
+	t.Run("ReviewLogs", testReviewLogsReloadAll)
 	t.Run("Subscriptions", testSubscriptionsReloadAll)
+	t.Run("TokenUsages", testTokenUsagesReloadAll)
 	t.Run("Users", testUsersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesSelect)
+	t.Run("Decks", testDecksSelect)
+	t.Run("ExperimentResponses", testExperimentResponsesSelect)
+	t.Run("GeneratedContents", testGeneratedContentsSelect)
 	t.Run("GooseDBVersions", testGooseDBVersionsSelect)
+	t.Run("ImportJobs", testImportJobsSelect)
+	t.Run("LookupHistories", testLookupHistoriesSelect)
 	t.Run("PaymentTransactions", testPaymentTransactionsSelect)
+	t.Run("Quizzes", testQuizzesSelect)
+	t.Run("ReviewCards", testReviewCardsSelect)
+	t.Run("ReviewLogs", testReviewLogsSelect)
 	t.Run("Subscriptions", testSubscriptionsSelect)
+	t.Run("TokenUsages", testTokenUsagesSelect)
 	t.Run("Users", testUsersSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesUpdate)
+	t.Run("Decks", testDecksUpdate)
+	t.Run("ExperimentResponses", testExperimentResponsesUpdate)
+	t.Run("GeneratedContents", testGeneratedContentsUpdate)
 	t.Run("GooseDBVersions", testGooseDBVersionsUpdate)
+	t.Run("ImportJobs", testImportJobsUpdate)
+	t.Run("LookupHistories", testLookupHistoriesUpdate)
 	t.Run("PaymentTransactions", testPaymentTransactionsUpdate)
+	t.Run("Quizzes", testQuizzesUpdate)
+	t.Run("ReviewCards", testReviewCardsUpdate)
+	t.Run("ReviewLogs", testReviewLogsUpdate)
 	t.Run("Subscriptions", testSubscriptionsUpdate)
+	t.Run("TokenUsages", testTokenUsagesUpdate)
 	t.Run("Users", testUsersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesSliceUpdateAll)
+	t.Run("Decks", testDecksSliceUpdateAll)
+	t.Run("ExperimentResponses", testExperimentResponsesSliceUpdateAll)
+	t.Run("GeneratedContents", testGeneratedContentsSliceUpdateAll)
 	t.Run("GooseDBVersions", testGooseDBVersionsSliceUpdateAll)
+	t.Run("ImportJobs", testImportJobsSliceUpdateAll)
+	t.Run("LookupHistories", testLookupHistoriesSliceUpdateAll)
 	t.Run("PaymentTransactions", testPaymentTransactionsSliceUpdateAll)
+	t.Run("Quizzes", testQuizzesSliceUpdateAll)
+	t.Run("ReviewCards", testReviewCardsSliceUpdateAll)
+	t.Run("ReviewLogs", testReviewLogsSliceUpdateAll)
 	t.Run("Subscriptions", testSubscriptionsSliceUpdateAll)
+	t.Run("TokenUsages", testTokenUsagesSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
 }
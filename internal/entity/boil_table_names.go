@@ -4,13 +4,33 @@
 package entity
 
 var TableNames = struct {
+	DeckEntries         string
+	Decks               string
+	ExperimentResponses string
+	GeneratedContents   string
 	GooseDBVersion      string
+	ImportJobs          string
+	LookupHistories     string
 	PaymentTransactions string
+	Quizzes             string
+	ReviewCards         string
+	ReviewLogs          string
 	Subscriptions       string
+	TokenUsages         string
 	Users               string
 }{
+	DeckEntries:         "deck_entries",
+	Decks:               "decks",
+	ExperimentResponses: "experiment_responses",
+	GeneratedContents:   "generated_contents",
 	GooseDBVersion:      "goose_db_version",
+	ImportJobs:          "import_jobs",
+	LookupHistories:     "lookup_histories",
 	PaymentTransactions: "payment_transactions",
+	Quizzes:             "quizzes",
+	ReviewCards:         "review_cards",
+	ReviewLogs:          "review_logs",
 	Subscriptions:       "subscriptions",
+	TokenUsages:         "token_usages",
 	Users:               "users",
 }
@@ -0,0 +1,1639 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DeckEntry is an object representing the database table.
+type DeckEntry struct {
+	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	DeckID         string    `boil:"deck_id" json:"deck_id" toml:"deck_id" yaml:"deck_id"`
+	Word           string    `boil:"word" json:"word" toml:"word" yaml:"word"`
+	CanonicalWord  string    `boil:"canonical_word" json:"canonical_word" toml:"canonical_word" yaml:"canonical_word"`
+	NativeLanguage string    `boil:"native_language" json:"native_language" toml:"native_language" yaml:"native_language"`
+	Details        string    `boil:"details" json:"details" toml:"details" yaml:"details"`
+	Position       int       `boil:"position" json:"position" toml:"position" yaml:"position"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *deckEntryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L deckEntryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DeckEntryColumns = struct {
+	ID             string
+	DeckID         string
+	Word           string
+	CanonicalWord  string
+	NativeLanguage string
+	Details        string
+	Position       string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	DeckID:         "deck_id",
+	Word:           "word",
+	CanonicalWord:  "canonical_word",
+	NativeLanguage: "native_language",
+	Details:        "details",
+	Position:       "position",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var DeckEntryTableColumns = struct {
+	ID             string
+	DeckID         string
+	Word           string
+	CanonicalWord  string
+	NativeLanguage string
+	Details        string
+	Position       string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "deck_entries.id",
+	DeckID:         "deck_entries.deck_id",
+	Word:           "deck_entries.word",
+	CanonicalWord:  "deck_entries.canonical_word",
+	NativeLanguage: "deck_entries.native_language",
+	Details:        "deck_entries.details",
+	Position:       "deck_entries.position",
+	CreatedAt:      "deck_entries.created_at",
+	UpdatedAt:      "deck_entries.updated_at",
+}
+
+// Generated where
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var DeckEntryWhere = struct {
+	ID             whereHelperstring
+	DeckID         whereHelperstring
+	Word           whereHelperstring
+	CanonicalWord  whereHelperstring
+	NativeLanguage whereHelperstring
+	Details        whereHelperstring
+	Position       whereHelperint
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"deck_entries\".\"id\""},
+	DeckID:         whereHelperstring{field: "\"deck_entries\".\"deck_id\""},
+	Word:           whereHelperstring{field: "\"deck_entries\".\"word\""},
+	CanonicalWord:  whereHelperstring{field: "\"deck_entries\".\"canonical_word\""},
+	NativeLanguage: whereHelperstring{field: "\"deck_entries\".\"native_language\""},
+	Details:        whereHelperstring{field: "\"deck_entries\".\"details\""},
+	Position:       whereHelperint{field: "\"deck_entries\".\"position\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"deck_entries\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"deck_entries\".\"updated_at\""},
+}
+
+// DeckEntryRels is where relationship names are stored.
+var DeckEntryRels = struct {
+	Deck       string
+	ReviewCard string
+	ReviewLogs string
+}{
+	Deck:       "Deck",
+	ReviewCard: "ReviewCard",
+	ReviewLogs: "ReviewLogs",
+}
+
+// deckEntryR is where relationships are stored.
+type deckEntryR struct {
+	Deck       *Deck          `boil:"Deck" json:"Deck" toml:"Deck" yaml:"Deck"`
+	ReviewCard *ReviewCard    `boil:"ReviewCard" json:"ReviewCard" toml:"ReviewCard" yaml:"ReviewCard"`
+	ReviewLogs ReviewLogSlice `boil:"ReviewLogs" json:"ReviewLogs" toml:"ReviewLogs" yaml:"ReviewLogs"`
+}
+
+// NewStruct creates a new relationship struct
+func (*deckEntryR) NewStruct() *deckEntryR {
+	return &deckEntryR{}
+}
+
+func (r *deckEntryR) GetDeck() *Deck {
+	if r == nil {
+		return nil
+	}
+	return r.Deck
+}
+
+func (r *deckEntryR) GetReviewCard() *ReviewCard {
+	if r == nil {
+		return nil
+	}
+	return r.ReviewCard
+}
+
+func (r *deckEntryR) GetReviewLogs() ReviewLogSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReviewLogs
+}
+
+// deckEntryL is where Load methods for each relationship are stored.
+type deckEntryL struct{}
+
+var (
+	deckEntryAllColumns            = []string{"id", "deck_id", "word", "canonical_word", "native_language", "details", "position", "created_at", "updated_at"}
+	deckEntryColumnsWithoutDefault = []string{"deck_id", "word", "canonical_word", "native_language", "details", "position"}
+	deckEntryColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	deckEntryPrimaryKeyColumns     = []string{"id"}
+	deckEntryGeneratedColumns      = []string{}
+)
+
+type (
+	// DeckEntrySlice is an alias for a slice of pointers to DeckEntry.
+	// This should almost always be used instead of []DeckEntry.
+	DeckEntrySlice []*DeckEntry
+	// DeckEntryHook is the signature for custom DeckEntry hook methods
+	DeckEntryHook func(context.Context, boil.ContextExecutor, *DeckEntry) error
+
+	deckEntryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	deckEntryType                 = reflect.TypeOf(&DeckEntry{})
+	deckEntryMapping              = queries.MakeStructMapping(deckEntryType)
+	deckEntryPrimaryKeyMapping, _ = queries.BindMapping(deckEntryType, deckEntryMapping, deckEntryPrimaryKeyColumns)
+	deckEntryInsertCacheMut       sync.RWMutex
+	deckEntryInsertCache          = make(map[string]insertCache)
+	deckEntryUpdateCacheMut       sync.RWMutex
+	deckEntryUpdateCache          = make(map[string]updateCache)
+	deckEntryUpsertCacheMut       sync.RWMutex
+	deckEntryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var deckEntryAfterSelectMu sync.Mutex
+var deckEntryAfterSelectHooks []DeckEntryHook
+
+var deckEntryBeforeInsertMu sync.Mutex
+var deckEntryBeforeInsertHooks []DeckEntryHook
+var deckEntryAfterInsertMu sync.Mutex
+var deckEntryAfterInsertHooks []DeckEntryHook
+
+var deckEntryBeforeUpdateMu sync.Mutex
+var deckEntryBeforeUpdateHooks []DeckEntryHook
+var deckEntryAfterUpdateMu sync.Mutex
+var deckEntryAfterUpdateHooks []DeckEntryHook
+
+var deckEntryBeforeDeleteMu sync.Mutex
+var deckEntryBeforeDeleteHooks []DeckEntryHook
+var deckEntryAfterDeleteMu sync.Mutex
+var deckEntryAfterDeleteHooks []DeckEntryHook
+
+var deckEntryBeforeUpsertMu sync.Mutex
+var deckEntryBeforeUpsertHooks []DeckEntryHook
+var deckEntryAfterUpsertMu sync.Mutex
+var deckEntryAfterUpsertHooks []DeckEntryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DeckEntry) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckEntryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DeckEntry) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckEntryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DeckEntry) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckEntryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DeckEntry) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckEntryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DeckEntry) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckEntryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DeckEntry) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckEntryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DeckEntry) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckEntryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DeckEntry) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckEntryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DeckEntry) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckEntryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDeckEntryHook registers your hook function for all future operations.
+func AddDeckEntryHook(hookPoint boil.HookPoint, deckEntryHook DeckEntryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		deckEntryAfterSelectMu.Lock()
+		deckEntryAfterSelectHooks = append(deckEntryAfterSelectHooks, deckEntryHook)
+		deckEntryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		deckEntryBeforeInsertMu.Lock()
+		deckEntryBeforeInsertHooks = append(deckEntryBeforeInsertHooks, deckEntryHook)
+		deckEntryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		deckEntryAfterInsertMu.Lock()
+		deckEntryAfterInsertHooks = append(deckEntryAfterInsertHooks, deckEntryHook)
+		deckEntryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		deckEntryBeforeUpdateMu.Lock()
+		deckEntryBeforeUpdateHooks = append(deckEntryBeforeUpdateHooks, deckEntryHook)
+		deckEntryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		deckEntryAfterUpdateMu.Lock()
+		deckEntryAfterUpdateHooks = append(deckEntryAfterUpdateHooks, deckEntryHook)
+		deckEntryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		deckEntryBeforeDeleteMu.Lock()
+		deckEntryBeforeDeleteHooks = append(deckEntryBeforeDeleteHooks, deckEntryHook)
+		deckEntryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		deckEntryAfterDeleteMu.Lock()
+		deckEntryAfterDeleteHooks = append(deckEntryAfterDeleteHooks, deckEntryHook)
+		deckEntryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		deckEntryBeforeUpsertMu.Lock()
+		deckEntryBeforeUpsertHooks = append(deckEntryBeforeUpsertHooks, deckEntryHook)
+		deckEntryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		deckEntryAfterUpsertMu.Lock()
+		deckEntryAfterUpsertHooks = append(deckEntryAfterUpsertHooks, deckEntryHook)
+		deckEntryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single deckEntry record from the query.
+func (q deckEntryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DeckEntry, error) {
+	o := &DeckEntry{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for deck_entries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DeckEntry records from the query.
+func (q deckEntryQuery) All(ctx context.Context, exec boil.ContextExecutor) (DeckEntrySlice, error) {
+	var o []*DeckEntry
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to DeckEntry slice")
+	}
+
+	if len(deckEntryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DeckEntry records in the query.
+func (q deckEntryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count deck_entries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q deckEntryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if deck_entries exists")
+	}
+
+	return count > 0, nil
+}
+
+// Deck pointed to by the foreign key.
+func (o *DeckEntry) Deck(mods ...qm.QueryMod) deckQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DeckID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Decks(queryMods...)
+}
+
+// ReviewCard pointed to by the foreign key.
+func (o *DeckEntry) ReviewCard(mods ...qm.QueryMod) reviewCardQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"deck_entry_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ReviewCards(queryMods...)
+}
+
+// ReviewLogs retrieves all the review_log's ReviewLogs with an executor.
+func (o *DeckEntry) ReviewLogs(mods ...qm.QueryMod) reviewLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"review_logs\".\"deck_entry_id\"=?", o.ID),
+	)
+
+	return ReviewLogs(queryMods...)
+}
+
+// LoadDeck allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (deckEntryL) LoadDeck(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeckEntry interface{}, mods queries.Applicator) error {
+	var slice []*DeckEntry
+	var object *DeckEntry
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeckEntry.(*DeckEntry)
+		if !ok {
+			object = new(DeckEntry)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeckEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeckEntry))
+			}
+		}
+	} else {
+		s, ok := maybeDeckEntry.(*[]*DeckEntry)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeckEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeckEntry))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &deckEntryR{}
+		}
+		args[object.DeckID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &deckEntryR{}
+			}
+
+			args[obj.DeckID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`decks`),
+		qm.WhereIn(`decks.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Deck")
+	}
+
+	var resultSlice []*Deck
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Deck")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for decks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for decks")
+	}
+
+	if len(deckAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Deck = foreign
+		if foreign.R == nil {
+			foreign.R = &deckR{}
+		}
+		foreign.R.DeckEntries = append(foreign.R.DeckEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DeckID == foreign.ID {
+				local.R.Deck = foreign
+				if foreign.R == nil {
+					foreign.R = &deckR{}
+				}
+				foreign.R.DeckEntries = append(foreign.R.DeckEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReviewCard allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (deckEntryL) LoadReviewCard(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeckEntry interface{}, mods queries.Applicator) error {
+	var slice []*DeckEntry
+	var object *DeckEntry
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeckEntry.(*DeckEntry)
+		if !ok {
+			object = new(DeckEntry)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeckEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeckEntry))
+			}
+		}
+	} else {
+		s, ok := maybeDeckEntry.(*[]*DeckEntry)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeckEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeckEntry))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &deckEntryR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &deckEntryR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`review_cards`),
+		qm.WhereIn(`review_cards.deck_entry_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ReviewCard")
+	}
+
+	var resultSlice []*ReviewCard
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ReviewCard")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for review_cards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for review_cards")
+	}
+
+	if len(reviewCardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ReviewCard = foreign
+		if foreign.R == nil {
+			foreign.R = &reviewCardR{}
+		}
+		foreign.R.DeckEntry = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.DeckEntryID {
+				local.R.ReviewCard = foreign
+				if foreign.R == nil {
+					foreign.R = &reviewCardR{}
+				}
+				foreign.R.DeckEntry = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReviewLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (deckEntryL) LoadReviewLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeckEntry interface{}, mods queries.Applicator) error {
+	var slice []*DeckEntry
+	var object *DeckEntry
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeckEntry.(*DeckEntry)
+		if !ok {
+			object = new(DeckEntry)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeckEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeckEntry))
+			}
+		}
+	} else {
+		s, ok := maybeDeckEntry.(*[]*DeckEntry)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeckEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeckEntry))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &deckEntryR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &deckEntryR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`review_logs`),
+		qm.WhereIn(`review_logs.deck_entry_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load review_logs")
+	}
+
+	var resultSlice []*ReviewLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice review_logs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on review_logs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for review_logs")
+	}
+
+	if len(reviewLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReviewLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reviewLogR{}
+			}
+			foreign.R.DeckEntry = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.DeckEntryID {
+				local.R.ReviewLogs = append(local.R.ReviewLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &reviewLogR{}
+				}
+				foreign.R.DeckEntry = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDeck of the deckEntry to the related item.
+// Sets o.R.Deck to related.
+// Adds o to related.R.DeckEntries.
+func (o *DeckEntry) SetDeck(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Deck) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"deck_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"deck_id"}),
+		strmangle.WhereClause("\"", "\"", 2, deckEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DeckID = related.ID
+	if o.R == nil {
+		o.R = &deckEntryR{
+			Deck: related,
+		}
+	} else {
+		o.R.Deck = related
+	}
+
+	if related.R == nil {
+		related.R = &deckR{
+			DeckEntries: DeckEntrySlice{o},
+		}
+	} else {
+		related.R.DeckEntries = append(related.R.DeckEntries, o)
+	}
+
+	return nil
+}
+
+// SetReviewCard of the deckEntry to the related item.
+// Sets o.R.ReviewCard to related.
+// Adds o to related.R.DeckEntry.
+func (o *DeckEntry) SetReviewCard(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ReviewCard) error {
+	var err error
+
+	if insert {
+		related.DeckEntryID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"review_cards\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"deck_entry_id"}),
+			strmangle.WhereClause("\"", "\"", 2, reviewCardPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.DeckEntryID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.DeckEntryID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &deckEntryR{
+			ReviewCard: related,
+		}
+	} else {
+		o.R.ReviewCard = related
+	}
+
+	if related.R == nil {
+		related.R = &reviewCardR{
+			DeckEntry: o,
+		}
+	} else {
+		related.R.DeckEntry = o
+	}
+	return nil
+}
+
+// AddReviewLogs adds the given related objects to the existing relationships
+// of the deck_entry, optionally inserting them as new records.
+// Appends related to o.R.ReviewLogs.
+// Sets related.R.DeckEntry appropriately.
+func (o *DeckEntry) AddReviewLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReviewLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.DeckEntryID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"review_logs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"deck_entry_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reviewLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.DeckEntryID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &deckEntryR{
+			ReviewLogs: related,
+		}
+	} else {
+		o.R.ReviewLogs = append(o.R.ReviewLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reviewLogR{
+				DeckEntry: o,
+			}
+		} else {
+			rel.R.DeckEntry = o
+		}
+	}
+	return nil
+}
+
+// DeckEntries retrieves all the records using an executor.
+func DeckEntries(mods ...qm.QueryMod) deckEntryQuery {
+	mods = append(mods, qm.From("\"deck_entries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"deck_entries\".*"})
+	}
+
+	return deckEntryQuery{q}
+}
+
+// FindDeckEntry retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDeckEntry(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*DeckEntry, error) {
+	deckEntryObj := &DeckEntry{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"deck_entries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, deckEntryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from deck_entries")
+	}
+
+	if err = deckEntryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return deckEntryObj, err
+	}
+
+	return deckEntryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DeckEntry) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no deck_entries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deckEntryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	deckEntryInsertCacheMut.RLock()
+	cache, cached := deckEntryInsertCache[key]
+	deckEntryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			deckEntryAllColumns,
+			deckEntryColumnsWithDefault,
+			deckEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(deckEntryType, deckEntryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(deckEntryType, deckEntryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"deck_entries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"deck_entries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into deck_entries")
+	}
+
+	if !cached {
+		deckEntryInsertCacheMut.Lock()
+		deckEntryInsertCache[key] = cache
+		deckEntryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DeckEntry.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DeckEntry) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	deckEntryUpdateCacheMut.RLock()
+	cache, cached := deckEntryUpdateCache[key]
+	deckEntryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			deckEntryAllColumns,
+			deckEntryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update deck_entries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"deck_entries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, deckEntryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(deckEntryType, deckEntryMapping, append(wl, deckEntryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update deck_entries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for deck_entries")
+	}
+
+	if !cached {
+		deckEntryUpdateCacheMut.Lock()
+		deckEntryUpdateCache[key] = cache
+		deckEntryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q deckEntryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for deck_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for deck_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DeckEntrySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deckEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"deck_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, deckEntryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in deckEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all deckEntry")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DeckEntry) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no deck_entries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deckEntryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	deckEntryUpsertCacheMut.RLock()
+	cache, cached := deckEntryUpsertCache[key]
+	deckEntryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			deckEntryAllColumns,
+			deckEntryColumnsWithDefault,
+			deckEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			deckEntryAllColumns,
+			deckEntryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert deck_entries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(deckEntryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(deckEntryPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert deck_entries, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(deckEntryPrimaryKeyColumns))
+			copy(conflict, deckEntryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"deck_entries\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(deckEntryType, deckEntryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(deckEntryType, deckEntryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert deck_entries")
+	}
+
+	if !cached {
+		deckEntryUpsertCacheMut.Lock()
+		deckEntryUpsertCache[key] = cache
+		deckEntryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DeckEntry record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DeckEntry) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no DeckEntry provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), deckEntryPrimaryKeyMapping)
+	sql := "DELETE FROM \"deck_entries\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from deck_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for deck_entries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q deckEntryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no deckEntryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from deck_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for deck_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DeckEntrySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(deckEntryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deckEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"deck_entries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deckEntryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from deckEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for deck_entries")
+	}
+
+	if len(deckEntryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DeckEntry) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDeckEntry(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DeckEntrySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DeckEntrySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deckEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"deck_entries\".* FROM \"deck_entries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deckEntryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in DeckEntrySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DeckEntryExists checks if the DeckEntry row exists.
+func DeckEntryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"deck_entries\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if deck_entries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DeckEntry row exists.
+func (o *DeckEntry) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DeckEntryExists(ctx, exec, o.ID)
+}
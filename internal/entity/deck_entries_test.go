@@ -0,0 +1,1126 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDeckEntries(t *testing.T) {
+	t.Parallel()
+
+	query := DeckEntries()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDeckEntriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DeckEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDeckEntriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := DeckEntries().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DeckEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDeckEntriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DeckEntrySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DeckEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDeckEntriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DeckEntryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if DeckEntry exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DeckEntryExists to return true, but got false.")
+	}
+}
+
+func testDeckEntriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	deckEntryFound, err := FindDeckEntry(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if deckEntryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDeckEntriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = DeckEntries().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDeckEntriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := DeckEntries().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDeckEntriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	deckEntryOne := &DeckEntry{}
+	deckEntryTwo := &DeckEntry{}
+	if err = randomize.Struct(seed, deckEntryOne, deckEntryDBTypes, false, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+	if err = randomize.Struct(seed, deckEntryTwo, deckEntryDBTypes, false, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = deckEntryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = deckEntryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DeckEntries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDeckEntriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	deckEntryOne := &DeckEntry{}
+	deckEntryTwo := &DeckEntry{}
+	if err = randomize.Struct(seed, deckEntryOne, deckEntryDBTypes, false, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+	if err = randomize.Struct(seed, deckEntryTwo, deckEntryDBTypes, false, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = deckEntryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = deckEntryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DeckEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func deckEntryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+	*o = DeckEntry{}
+	return nil
+}
+
+func deckEntryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+	*o = DeckEntry{}
+	return nil
+}
+
+func deckEntryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+	*o = DeckEntry{}
+	return nil
+}
+
+func deckEntryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+	*o = DeckEntry{}
+	return nil
+}
+
+func deckEntryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+	*o = DeckEntry{}
+	return nil
+}
+
+func deckEntryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+	*o = DeckEntry{}
+	return nil
+}
+
+func deckEntryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+	*o = DeckEntry{}
+	return nil
+}
+
+func deckEntryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+	*o = DeckEntry{}
+	return nil
+}
+
+func deckEntryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+	*o = DeckEntry{}
+	return nil
+}
+
+func testDeckEntriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &DeckEntry{}
+	o := &DeckEntry{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize DeckEntry object: %s", err)
+	}
+
+	AddDeckEntryHook(boil.BeforeInsertHook, deckEntryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	deckEntryBeforeInsertHooks = []DeckEntryHook{}
+
+	AddDeckEntryHook(boil.AfterInsertHook, deckEntryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	deckEntryAfterInsertHooks = []DeckEntryHook{}
+
+	AddDeckEntryHook(boil.AfterSelectHook, deckEntryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	deckEntryAfterSelectHooks = []DeckEntryHook{}
+
+	AddDeckEntryHook(boil.BeforeUpdateHook, deckEntryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	deckEntryBeforeUpdateHooks = []DeckEntryHook{}
+
+	AddDeckEntryHook(boil.AfterUpdateHook, deckEntryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	deckEntryAfterUpdateHooks = []DeckEntryHook{}
+
+	AddDeckEntryHook(boil.BeforeDeleteHook, deckEntryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	deckEntryBeforeDeleteHooks = []DeckEntryHook{}
+
+	AddDeckEntryHook(boil.AfterDeleteHook, deckEntryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	deckEntryAfterDeleteHooks = []DeckEntryHook{}
+
+	AddDeckEntryHook(boil.BeforeUpsertHook, deckEntryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	deckEntryBeforeUpsertHooks = []DeckEntryHook{}
+
+	AddDeckEntryHook(boil.AfterUpsertHook, deckEntryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	deckEntryAfterUpsertHooks = []DeckEntryHook{}
+}
+
+func testDeckEntriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DeckEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDeckEntriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(deckEntryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DeckEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDeckEntryOneToOneReviewCardUsingReviewCard(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign ReviewCard
+	var local DeckEntry
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreign.DeckEntryID = local.ID
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ReviewCard().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.DeckEntryID != foreign.DeckEntryID {
+		t.Errorf("want: %v, got %v", foreign.DeckEntryID, check.DeckEntryID)
+	}
+
+	ranAfterSelectHook := false
+	AddReviewCardHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *ReviewCard) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := DeckEntrySlice{&local}
+	if err = local.L.LoadReviewCard(ctx, tx, false, (*[]*DeckEntry)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ReviewCard == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ReviewCard = nil
+	if err = local.L.LoadReviewCard(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ReviewCard == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testDeckEntryOneToOneSetOpReviewCardUsingReviewCard(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a DeckEntry
+	var b, c ReviewCard
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, deckEntryDBTypes, false, strmangle.SetComplement(deckEntryPrimaryKeyColumns, deckEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, reviewCardDBTypes, false, strmangle.SetComplement(reviewCardPrimaryKeyColumns, reviewCardColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, reviewCardDBTypes, false, strmangle.SetComplement(reviewCardPrimaryKeyColumns, reviewCardColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ReviewCard{&b, &c} {
+		err = a.SetReviewCard(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ReviewCard != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.DeckEntry != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if a.ID != x.DeckEntryID {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		if exists, err := ReviewCardExists(ctx, tx, x.DeckEntryID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'x' to exist")
+		}
+
+		if a.ID != x.DeckEntryID {
+			t.Error("foreign key was wrong value", a.ID, x.DeckEntryID)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+
+func testDeckEntryToManyReviewLogs(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a DeckEntry
+	var b, c ReviewLog
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, reviewLogDBTypes, false, reviewLogColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, reviewLogDBTypes, false, reviewLogColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.DeckEntryID = a.ID
+	c.DeckEntryID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ReviewLogs().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.DeckEntryID == b.DeckEntryID {
+			bFound = true
+		}
+		if v.DeckEntryID == c.DeckEntryID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := DeckEntrySlice{&a}
+	if err = a.L.LoadReviewLogs(ctx, tx, false, (*[]*DeckEntry)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ReviewLogs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ReviewLogs = nil
+	if err = a.L.LoadReviewLogs(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ReviewLogs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testDeckEntryToManyAddOpReviewLogs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a DeckEntry
+	var b, c, d, e ReviewLog
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, deckEntryDBTypes, false, strmangle.SetComplement(deckEntryPrimaryKeyColumns, deckEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ReviewLog{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reviewLogDBTypes, false, strmangle.SetComplement(reviewLogPrimaryKeyColumns, reviewLogColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ReviewLog{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddReviewLogs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.DeckEntryID {
+			t.Error("foreign key was wrong value", a.ID, first.DeckEntryID)
+		}
+		if a.ID != second.DeckEntryID {
+			t.Error("foreign key was wrong value", a.ID, second.DeckEntryID)
+		}
+
+		if first.R.DeckEntry != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.DeckEntry != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ReviewLogs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ReviewLogs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ReviewLogs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testDeckEntryToOneDeckUsingDeck(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local DeckEntry
+	var foreign Deck
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, deckEntryDBTypes, false, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, deckDBTypes, false, deckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deck struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.DeckID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Deck().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddDeckHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Deck) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := DeckEntrySlice{&local}
+	if err = local.L.LoadDeck(ctx, tx, false, (*[]*DeckEntry)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Deck == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Deck = nil
+	if err = local.L.LoadDeck(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Deck == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testDeckEntryToOneSetOpDeckUsingDeck(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a DeckEntry
+	var b, c Deck
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, deckEntryDBTypes, false, strmangle.SetComplement(deckEntryPrimaryKeyColumns, deckEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, deckDBTypes, false, strmangle.SetComplement(deckPrimaryKeyColumns, deckColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, deckDBTypes, false, strmangle.SetComplement(deckPrimaryKeyColumns, deckColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Deck{&b, &c} {
+		err = a.SetDeck(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Deck != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.DeckEntries[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.DeckID != x.ID {
+			t.Error("foreign key was wrong value", a.DeckID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.DeckID))
+		reflect.Indirect(reflect.ValueOf(&a.DeckID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.DeckID != x.ID {
+			t.Error("foreign key was wrong value", a.DeckID, x.ID)
+		}
+	}
+}
+
+func testDeckEntriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDeckEntriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DeckEntrySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDeckEntriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DeckEntries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	deckEntryDBTypes = map[string]string{`ID`: `uuid`, `DeckID`: `uuid`, `Word`: `text`, `NativeLanguage`: `character varying`, `Details`: `text`, `Position`: `integer`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                = bytes.MinRead
+)
+
+func testDeckEntriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(deckEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(deckEntryAllColumns) == len(deckEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DeckEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDeckEntriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(deckEntryAllColumns) == len(deckEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DeckEntry{}
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DeckEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, deckEntryDBTypes, true, deckEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(deckEntryAllColumns, deckEntryPrimaryKeyColumns) {
+		fields = deckEntryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			deckEntryAllColumns,
+			deckEntryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DeckEntrySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDeckEntriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(deckEntryAllColumns) == len(deckEntryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := DeckEntry{}
+	if err = randomize.Struct(seed, &o, deckEntryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DeckEntry: %s", err)
+	}
+
+	count, err := DeckEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, deckEntryDBTypes, false, deckEntryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DeckEntry: %s", err)
+	}
+
+	count, err = DeckEntries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1542 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Deck is an object representing the database table.
+type Deck struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *deckR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L deckL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DeckColumns = struct {
+	ID        string
+	UserID    string
+	Name      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	Name:      "name",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var DeckTableColumns = struct {
+	ID        string
+	UserID    string
+	Name      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "decks.id",
+	UserID:    "decks.user_id",
+	Name:      "decks.name",
+	CreatedAt: "decks.created_at",
+	UpdatedAt: "decks.updated_at",
+}
+
+// Generated where
+
+var DeckWhere = struct {
+	ID        whereHelperstring
+	UserID    whereHelperstring
+	Name      whereHelperstring
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"decks\".\"id\""},
+	UserID:    whereHelperstring{field: "\"decks\".\"user_id\""},
+	Name:      whereHelperstring{field: "\"decks\".\"name\""},
+	CreatedAt: whereHelpertime_Time{field: "\"decks\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"decks\".\"updated_at\""},
+}
+
+// DeckRels is where relationship names are stored.
+var DeckRels = struct {
+	User        string
+	DeckEntries string
+	ImportJobs  string
+}{
+	User:        "User",
+	DeckEntries: "DeckEntries",
+	ImportJobs:  "ImportJobs",
+}
+
+// deckR is where relationships are stored.
+type deckR struct {
+	User        *User          `boil:"User" json:"User" toml:"User" yaml:"User"`
+	DeckEntries DeckEntrySlice `boil:"DeckEntries" json:"DeckEntries" toml:"DeckEntries" yaml:"DeckEntries"`
+	ImportJobs  ImportJobSlice `boil:"ImportJobs" json:"ImportJobs" toml:"ImportJobs" yaml:"ImportJobs"`
+}
+
+// NewStruct creates a new relationship struct
+func (*deckR) NewStruct() *deckR {
+	return &deckR{}
+}
+
+func (r *deckR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *deckR) GetDeckEntries() DeckEntrySlice {
+	if r == nil {
+		return nil
+	}
+	return r.DeckEntries
+}
+
+func (r *deckR) GetImportJobs() ImportJobSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ImportJobs
+}
+
+// deckL is where Load methods for each relationship are stored.
+type deckL struct{}
+
+var (
+	deckAllColumns            = []string{"id", "user_id", "name", "created_at", "updated_at"}
+	deckColumnsWithoutDefault = []string{"user_id", "name"}
+	deckColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	deckPrimaryKeyColumns     = []string{"id"}
+	deckGeneratedColumns      = []string{}
+)
+
+type (
+	// DeckSlice is an alias for a slice of pointers to Deck.
+	// This should almost always be used instead of []Deck.
+	DeckSlice []*Deck
+	// DeckHook is the signature for custom Deck hook methods
+	DeckHook func(context.Context, boil.ContextExecutor, *Deck) error
+
+	deckQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	deckType                 = reflect.TypeOf(&Deck{})
+	deckMapping              = queries.MakeStructMapping(deckType)
+	deckPrimaryKeyMapping, _ = queries.BindMapping(deckType, deckMapping, deckPrimaryKeyColumns)
+	deckInsertCacheMut       sync.RWMutex
+	deckInsertCache          = make(map[string]insertCache)
+	deckUpdateCacheMut       sync.RWMutex
+	deckUpdateCache          = make(map[string]updateCache)
+	deckUpsertCacheMut       sync.RWMutex
+	deckUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var deckAfterSelectMu sync.Mutex
+var deckAfterSelectHooks []DeckHook
+
+var deckBeforeInsertMu sync.Mutex
+var deckBeforeInsertHooks []DeckHook
+var deckAfterInsertMu sync.Mutex
+var deckAfterInsertHooks []DeckHook
+
+var deckBeforeUpdateMu sync.Mutex
+var deckBeforeUpdateHooks []DeckHook
+var deckAfterUpdateMu sync.Mutex
+var deckAfterUpdateHooks []DeckHook
+
+var deckBeforeDeleteMu sync.Mutex
+var deckBeforeDeleteHooks []DeckHook
+var deckAfterDeleteMu sync.Mutex
+var deckAfterDeleteHooks []DeckHook
+
+var deckBeforeUpsertMu sync.Mutex
+var deckBeforeUpsertHooks []DeckHook
+var deckAfterUpsertMu sync.Mutex
+var deckAfterUpsertHooks []DeckHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Deck) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Deck) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Deck) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Deck) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Deck) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Deck) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Deck) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Deck) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Deck) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deckAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDeckHook registers your hook function for all future operations.
+func AddDeckHook(hookPoint boil.HookPoint, deckHook DeckHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		deckAfterSelectMu.Lock()
+		deckAfterSelectHooks = append(deckAfterSelectHooks, deckHook)
+		deckAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		deckBeforeInsertMu.Lock()
+		deckBeforeInsertHooks = append(deckBeforeInsertHooks, deckHook)
+		deckBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		deckAfterInsertMu.Lock()
+		deckAfterInsertHooks = append(deckAfterInsertHooks, deckHook)
+		deckAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		deckBeforeUpdateMu.Lock()
+		deckBeforeUpdateHooks = append(deckBeforeUpdateHooks, deckHook)
+		deckBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		deckAfterUpdateMu.Lock()
+		deckAfterUpdateHooks = append(deckAfterUpdateHooks, deckHook)
+		deckAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		deckBeforeDeleteMu.Lock()
+		deckBeforeDeleteHooks = append(deckBeforeDeleteHooks, deckHook)
+		deckBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		deckAfterDeleteMu.Lock()
+		deckAfterDeleteHooks = append(deckAfterDeleteHooks, deckHook)
+		deckAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		deckBeforeUpsertMu.Lock()
+		deckBeforeUpsertHooks = append(deckBeforeUpsertHooks, deckHook)
+		deckBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		deckAfterUpsertMu.Lock()
+		deckAfterUpsertHooks = append(deckAfterUpsertHooks, deckHook)
+		deckAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single deck record from the query.
+func (q deckQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Deck, error) {
+	o := &Deck{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for decks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Deck records from the query.
+func (q deckQuery) All(ctx context.Context, exec boil.ContextExecutor) (DeckSlice, error) {
+	var o []*Deck
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to Deck slice")
+	}
+
+	if len(deckAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Deck records in the query.
+func (q deckQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count decks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q deckQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if decks exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *Deck) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// DeckEntries retrieves all the deck_entry's DeckEntries with an executor.
+func (o *Deck) DeckEntries(mods ...qm.QueryMod) deckEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"deck_entries\".\"deck_id\"=?", o.ID),
+	)
+
+	return DeckEntries(queryMods...)
+}
+
+// ImportJobs retrieves all the import_job's ImportJobs with an executor.
+func (o *Deck) ImportJobs(mods ...qm.QueryMod) importJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"import_jobs\".\"deck_id\"=?", o.ID),
+	)
+
+	return ImportJobs(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (deckL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeck interface{}, mods queries.Applicator) error {
+	var slice []*Deck
+	var object *Deck
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeck.(*Deck)
+		if !ok {
+			object = new(Deck)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeck)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeck))
+			}
+		}
+	} else {
+		s, ok := maybeDeck.(*[]*Deck)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeck)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeck))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &deckR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &deckR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Decks = append(foreign.R.Decks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Decks = append(foreign.R.Decks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDeckEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (deckL) LoadDeckEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeck interface{}, mods queries.Applicator) error {
+	var slice []*Deck
+	var object *Deck
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeck.(*Deck)
+		if !ok {
+			object = new(Deck)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeck)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeck))
+			}
+		}
+	} else {
+		s, ok := maybeDeck.(*[]*Deck)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeck)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeck))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &deckR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &deckR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`deck_entries`),
+		qm.WhereIn(`deck_entries.deck_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load deck_entries")
+	}
+
+	var resultSlice []*DeckEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice deck_entries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on deck_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deck_entries")
+	}
+
+	if len(deckEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DeckEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &deckEntryR{}
+			}
+			foreign.R.Deck = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.DeckID {
+				local.R.DeckEntries = append(local.R.DeckEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &deckEntryR{}
+				}
+				foreign.R.Deck = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImportJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (deckL) LoadImportJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeck interface{}, mods queries.Applicator) error {
+	var slice []*Deck
+	var object *Deck
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeck.(*Deck)
+		if !ok {
+			object = new(Deck)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeck)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeck))
+			}
+		}
+	} else {
+		s, ok := maybeDeck.(*[]*Deck)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeck)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeck))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &deckR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &deckR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`import_jobs`),
+		qm.WhereIn(`import_jobs.deck_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load import_jobs")
+	}
+
+	var resultSlice []*ImportJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice import_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on import_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for import_jobs")
+	}
+
+	if len(importJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImportJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &importJobR{}
+			}
+			foreign.R.Deck = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.DeckID {
+				local.R.ImportJobs = append(local.R.ImportJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &importJobR{}
+				}
+				foreign.R.Deck = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the deck to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Decks.
+func (o *Deck) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"decks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, deckPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &deckR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Decks: DeckSlice{o},
+		}
+	} else {
+		related.R.Decks = append(related.R.Decks, o)
+	}
+
+	return nil
+}
+
+// AddDeckEntries adds the given related objects to the existing relationships
+// of the deck, optionally inserting them as new records.
+// Appends related to o.R.DeckEntries.
+// Sets related.R.Deck appropriately.
+func (o *Deck) AddDeckEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DeckEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.DeckID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"deck_entries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"deck_id"}),
+				strmangle.WhereClause("\"", "\"", 2, deckEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.DeckID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &deckR{
+			DeckEntries: related,
+		}
+	} else {
+		o.R.DeckEntries = append(o.R.DeckEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &deckEntryR{
+				Deck: o,
+			}
+		} else {
+			rel.R.Deck = o
+		}
+	}
+	return nil
+}
+
+// AddImportJobs adds the given related objects to the existing relationships
+// of the deck, optionally inserting them as new records.
+// Appends related to o.R.ImportJobs.
+// Sets related.R.Deck appropriately.
+func (o *Deck) AddImportJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.DeckID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"import_jobs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"deck_id"}),
+				strmangle.WhereClause("\"", "\"", 2, importJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.DeckID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &deckR{
+			ImportJobs: related,
+		}
+	} else {
+		o.R.ImportJobs = append(o.R.ImportJobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &importJobR{
+				Deck: o,
+			}
+		} else {
+			rel.R.Deck = o
+		}
+	}
+	return nil
+}
+
+// Decks retrieves all the records using an executor.
+func Decks(mods ...qm.QueryMod) deckQuery {
+	mods = append(mods, qm.From("\"decks\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"decks\".*"})
+	}
+
+	return deckQuery{q}
+}
+
+// FindDeck retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDeck(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Deck, error) {
+	deckObj := &Deck{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"decks\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, deckObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from decks")
+	}
+
+	if err = deckObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return deckObj, err
+	}
+
+	return deckObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Deck) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no decks provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deckColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	deckInsertCacheMut.RLock()
+	cache, cached := deckInsertCache[key]
+	deckInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			deckAllColumns,
+			deckColumnsWithDefault,
+			deckColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(deckType, deckMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(deckType, deckMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"decks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"decks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into decks")
+	}
+
+	if !cached {
+		deckInsertCacheMut.Lock()
+		deckInsertCache[key] = cache
+		deckInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Deck.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Deck) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	deckUpdateCacheMut.RLock()
+	cache, cached := deckUpdateCache[key]
+	deckUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			deckAllColumns,
+			deckPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update decks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"decks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, deckPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(deckType, deckMapping, append(wl, deckPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update decks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for decks")
+	}
+
+	if !cached {
+		deckUpdateCacheMut.Lock()
+		deckUpdateCache[key] = cache
+		deckUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q deckQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for decks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for decks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DeckSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deckPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"decks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, deckPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in deck slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all deck")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Deck) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no decks provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deckColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	deckUpsertCacheMut.RLock()
+	cache, cached := deckUpsertCache[key]
+	deckUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			deckAllColumns,
+			deckColumnsWithDefault,
+			deckColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			deckAllColumns,
+			deckPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert decks, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(deckAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(deckPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert decks, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(deckPrimaryKeyColumns))
+			copy(conflict, deckPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"decks\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(deckType, deckMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(deckType, deckMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert decks")
+	}
+
+	if !cached {
+		deckUpsertCacheMut.Lock()
+		deckUpsertCache[key] = cache
+		deckUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Deck record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Deck) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no Deck provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), deckPrimaryKeyMapping)
+	sql := "DELETE FROM \"decks\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from decks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for decks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q deckQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no deckQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from decks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for decks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DeckSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(deckBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deckPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"decks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deckPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from deck slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for decks")
+	}
+
+	if len(deckAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Deck) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDeck(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DeckSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DeckSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deckPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"decks\".* FROM \"decks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deckPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in DeckSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DeckExists checks if the Deck row exists.
+func DeckExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"decks\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if decks exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Deck row exists.
+func (o *Deck) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DeckExists(ctx, exec, o.ID)
+}
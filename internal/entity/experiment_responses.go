@@ -0,0 +1,1344 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ExperimentResponse is an object representing the database table.
+type ExperimentResponse struct {
+	ID               string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Experiment       string      `boil:"experiment" json:"experiment" toml:"experiment" yaml:"experiment"`
+	Variant          string      `boil:"variant" json:"variant" toml:"variant" yaml:"variant"`
+	PromptID         string      `boil:"prompt_id" json:"prompt_id" toml:"prompt_id" yaml:"prompt_id"`
+	PromptVersion    string      `boil:"prompt_version" json:"prompt_version" toml:"prompt_version" yaml:"prompt_version"`
+	UserID           null.String `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	SessionID        null.String `boil:"session_id" json:"session_id,omitempty" toml:"session_id" yaml:"session_id,omitempty"`
+	PromptTokens     int         `boil:"prompt_tokens" json:"prompt_tokens" toml:"prompt_tokens" yaml:"prompt_tokens"`
+	CompletionTokens int         `boil:"completion_tokens" json:"completion_tokens" toml:"completion_tokens" yaml:"completion_tokens"`
+	TotalTokens      int         `boil:"total_tokens" json:"total_tokens" toml:"total_tokens" yaml:"total_tokens"`
+	Rating           null.Int16  `boil:"rating" json:"rating,omitempty" toml:"rating" yaml:"rating,omitempty"`
+	CreatedAt        time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *experimentResponseR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L experimentResponseL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ExperimentResponseColumns = struct {
+	ID               string
+	Experiment       string
+	Variant          string
+	PromptID         string
+	PromptVersion    string
+	UserID           string
+	SessionID        string
+	PromptTokens     string
+	CompletionTokens string
+	TotalTokens      string
+	Rating           string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	ID:               "id",
+	Experiment:       "experiment",
+	Variant:          "variant",
+	PromptID:         "prompt_id",
+	PromptVersion:    "prompt_version",
+	UserID:           "user_id",
+	SessionID:        "session_id",
+	PromptTokens:     "prompt_tokens",
+	CompletionTokens: "completion_tokens",
+	TotalTokens:      "total_tokens",
+	Rating:           "rating",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+}
+
+var ExperimentResponseTableColumns = struct {
+	ID               string
+	Experiment       string
+	Variant          string
+	PromptID         string
+	PromptVersion    string
+	UserID           string
+	SessionID        string
+	PromptTokens     string
+	CompletionTokens string
+	TotalTokens      string
+	Rating           string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	ID:               "experiment_responses.id",
+	Experiment:       "experiment_responses.experiment",
+	Variant:          "experiment_responses.variant",
+	PromptID:         "experiment_responses.prompt_id",
+	PromptVersion:    "experiment_responses.prompt_version",
+	UserID:           "experiment_responses.user_id",
+	SessionID:        "experiment_responses.session_id",
+	PromptTokens:     "experiment_responses.prompt_tokens",
+	CompletionTokens: "experiment_responses.completion_tokens",
+	TotalTokens:      "experiment_responses.total_tokens",
+	Rating:           "experiment_responses.rating",
+	CreatedAt:        "experiment_responses.created_at",
+	UpdatedAt:        "experiment_responses.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_Int16 struct{ field string }
+
+func (w whereHelpernull_Int16) EQ(x null.Int16) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int16) NEQ(x null.Int16) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int16) LT(x null.Int16) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int16) LTE(x null.Int16) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int16) GT(x null.Int16) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int16) GTE(x null.Int16) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int16) IN(slice []int16) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int16) NIN(slice []int16) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int16) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int16) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var ExperimentResponseWhere = struct {
+	ID               whereHelperstring
+	Experiment       whereHelperstring
+	Variant          whereHelperstring
+	PromptID         whereHelperstring
+	PromptVersion    whereHelperstring
+	UserID           whereHelpernull_String
+	SessionID        whereHelpernull_String
+	PromptTokens     whereHelperint
+	CompletionTokens whereHelperint
+	TotalTokens      whereHelperint
+	Rating           whereHelpernull_Int16
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
+}{
+	ID:               whereHelperstring{field: "\"experiment_responses\".\"id\""},
+	Experiment:       whereHelperstring{field: "\"experiment_responses\".\"experiment\""},
+	Variant:          whereHelperstring{field: "\"experiment_responses\".\"variant\""},
+	PromptID:         whereHelperstring{field: "\"experiment_responses\".\"prompt_id\""},
+	PromptVersion:    whereHelperstring{field: "\"experiment_responses\".\"prompt_version\""},
+	UserID:           whereHelpernull_String{field: "\"experiment_responses\".\"user_id\""},
+	SessionID:        whereHelpernull_String{field: "\"experiment_responses\".\"session_id\""},
+	PromptTokens:     whereHelperint{field: "\"experiment_responses\".\"prompt_tokens\""},
+	CompletionTokens: whereHelperint{field: "\"experiment_responses\".\"completion_tokens\""},
+	TotalTokens:      whereHelperint{field: "\"experiment_responses\".\"total_tokens\""},
+	Rating:           whereHelpernull_Int16{field: "\"experiment_responses\".\"rating\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"experiment_responses\".\"created_at\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"experiment_responses\".\"updated_at\""},
+}
+
+// ExperimentResponseRels is where relationship names are stored.
+var ExperimentResponseRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// experimentResponseR is where relationships are stored.
+type experimentResponseR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*experimentResponseR) NewStruct() *experimentResponseR {
+	return &experimentResponseR{}
+}
+
+func (r *experimentResponseR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// experimentResponseL is where Load methods for each relationship are stored.
+type experimentResponseL struct{}
+
+var (
+	experimentResponseAllColumns            = []string{"id", "experiment", "variant", "prompt_id", "prompt_version", "user_id", "session_id", "prompt_tokens", "completion_tokens", "total_tokens", "rating", "created_at", "updated_at"}
+	experimentResponseColumnsWithoutDefault = []string{"experiment", "variant", "prompt_id", "prompt_version"}
+	experimentResponseColumnsWithDefault    = []string{"id", "user_id", "session_id", "prompt_tokens", "completion_tokens", "total_tokens", "rating", "created_at", "updated_at"}
+	experimentResponsePrimaryKeyColumns     = []string{"id"}
+	experimentResponseGeneratedColumns      = []string{}
+)
+
+type (
+	// ExperimentResponseSlice is an alias for a slice of pointers to ExperimentResponse.
+	// This should almost always be used instead of []ExperimentResponse.
+	ExperimentResponseSlice []*ExperimentResponse
+	// ExperimentResponseHook is the signature for custom ExperimentResponse hook methods
+	ExperimentResponseHook func(context.Context, boil.ContextExecutor, *ExperimentResponse) error
+
+	experimentResponseQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	experimentResponseType                 = reflect.TypeOf(&ExperimentResponse{})
+	experimentResponseMapping              = queries.MakeStructMapping(experimentResponseType)
+	experimentResponsePrimaryKeyMapping, _ = queries.BindMapping(experimentResponseType, experimentResponseMapping, experimentResponsePrimaryKeyColumns)
+	experimentResponseInsertCacheMut       sync.RWMutex
+	experimentResponseInsertCache          = make(map[string]insertCache)
+	experimentResponseUpdateCacheMut       sync.RWMutex
+	experimentResponseUpdateCache          = make(map[string]updateCache)
+	experimentResponseUpsertCacheMut       sync.RWMutex
+	experimentResponseUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var experimentResponseAfterSelectMu sync.Mutex
+var experimentResponseAfterSelectHooks []ExperimentResponseHook
+
+var experimentResponseBeforeInsertMu sync.Mutex
+var experimentResponseBeforeInsertHooks []ExperimentResponseHook
+var experimentResponseAfterInsertMu sync.Mutex
+var experimentResponseAfterInsertHooks []ExperimentResponseHook
+
+var experimentResponseBeforeUpdateMu sync.Mutex
+var experimentResponseBeforeUpdateHooks []ExperimentResponseHook
+var experimentResponseAfterUpdateMu sync.Mutex
+var experimentResponseAfterUpdateHooks []ExperimentResponseHook
+
+var experimentResponseBeforeDeleteMu sync.Mutex
+var experimentResponseBeforeDeleteHooks []ExperimentResponseHook
+var experimentResponseAfterDeleteMu sync.Mutex
+var experimentResponseAfterDeleteHooks []ExperimentResponseHook
+
+var experimentResponseBeforeUpsertMu sync.Mutex
+var experimentResponseBeforeUpsertHooks []ExperimentResponseHook
+var experimentResponseAfterUpsertMu sync.Mutex
+var experimentResponseAfterUpsertHooks []ExperimentResponseHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ExperimentResponse) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range experimentResponseAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ExperimentResponse) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range experimentResponseBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ExperimentResponse) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range experimentResponseAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ExperimentResponse) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range experimentResponseBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ExperimentResponse) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range experimentResponseAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ExperimentResponse) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range experimentResponseBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ExperimentResponse) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range experimentResponseAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ExperimentResponse) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range experimentResponseBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ExperimentResponse) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range experimentResponseAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddExperimentResponseHook registers your hook function for all future operations.
+func AddExperimentResponseHook(hookPoint boil.HookPoint, experimentResponseHook ExperimentResponseHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		experimentResponseAfterSelectMu.Lock()
+		experimentResponseAfterSelectHooks = append(experimentResponseAfterSelectHooks, experimentResponseHook)
+		experimentResponseAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		experimentResponseBeforeInsertMu.Lock()
+		experimentResponseBeforeInsertHooks = append(experimentResponseBeforeInsertHooks, experimentResponseHook)
+		experimentResponseBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		experimentResponseAfterInsertMu.Lock()
+		experimentResponseAfterInsertHooks = append(experimentResponseAfterInsertHooks, experimentResponseHook)
+		experimentResponseAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		experimentResponseBeforeUpdateMu.Lock()
+		experimentResponseBeforeUpdateHooks = append(experimentResponseBeforeUpdateHooks, experimentResponseHook)
+		experimentResponseBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		experimentResponseAfterUpdateMu.Lock()
+		experimentResponseAfterUpdateHooks = append(experimentResponseAfterUpdateHooks, experimentResponseHook)
+		experimentResponseAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		experimentResponseBeforeDeleteMu.Lock()
+		experimentResponseBeforeDeleteHooks = append(experimentResponseBeforeDeleteHooks, experimentResponseHook)
+		experimentResponseBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		experimentResponseAfterDeleteMu.Lock()
+		experimentResponseAfterDeleteHooks = append(experimentResponseAfterDeleteHooks, experimentResponseHook)
+		experimentResponseAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		experimentResponseBeforeUpsertMu.Lock()
+		experimentResponseBeforeUpsertHooks = append(experimentResponseBeforeUpsertHooks, experimentResponseHook)
+		experimentResponseBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		experimentResponseAfterUpsertMu.Lock()
+		experimentResponseAfterUpsertHooks = append(experimentResponseAfterUpsertHooks, experimentResponseHook)
+		experimentResponseAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single experimentResponse record from the query.
+func (q experimentResponseQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ExperimentResponse, error) {
+	o := &ExperimentResponse{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for experiment_responses")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ExperimentResponse records from the query.
+func (q experimentResponseQuery) All(ctx context.Context, exec boil.ContextExecutor) (ExperimentResponseSlice, error) {
+	var o []*ExperimentResponse
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to ExperimentResponse slice")
+	}
+
+	if len(experimentResponseAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ExperimentResponse records in the query.
+func (q experimentResponseQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count experiment_responses rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q experimentResponseQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if experiment_responses exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *ExperimentResponse) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (experimentResponseL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExperimentResponse interface{}, mods queries.Applicator) error {
+	var slice []*ExperimentResponse
+	var object *ExperimentResponse
+
+	if singular {
+		var ok bool
+		object, ok = maybeExperimentResponse.(*ExperimentResponse)
+		if !ok {
+			object = new(ExperimentResponse)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeExperimentResponse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeExperimentResponse))
+			}
+		}
+	} else {
+		s, ok := maybeExperimentResponse.(*[]*ExperimentResponse)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeExperimentResponse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeExperimentResponse))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &experimentResponseR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &experimentResponseR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ExperimentResponses = append(foreign.R.ExperimentResponses, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ExperimentResponses = append(foreign.R.ExperimentResponses, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the experimentResponse to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ExperimentResponses.
+func (o *ExperimentResponse) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"experiment_responses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, experimentResponsePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &experimentResponseR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ExperimentResponses: ExperimentResponseSlice{o},
+		}
+	} else {
+		related.R.ExperimentResponses = append(related.R.ExperimentResponses, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ExperimentResponse) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ExperimentResponses {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.ExperimentResponses)
+		if ln > 1 && i < ln-1 {
+			related.R.ExperimentResponses[i] = related.R.ExperimentResponses[ln-1]
+		}
+		related.R.ExperimentResponses = related.R.ExperimentResponses[:ln-1]
+		break
+	}
+	return nil
+}
+
+// ExperimentResponses retrieves all the records using an executor.
+func ExperimentResponses(mods ...qm.QueryMod) experimentResponseQuery {
+	mods = append(mods, qm.From("\"experiment_responses\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"experiment_responses\".*"})
+	}
+
+	return experimentResponseQuery{q}
+}
+
+// FindExperimentResponse retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindExperimentResponse(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ExperimentResponse, error) {
+	experimentResponseObj := &ExperimentResponse{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"experiment_responses\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, experimentResponseObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from experiment_responses")
+	}
+
+	if err = experimentResponseObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return experimentResponseObj, err
+	}
+
+	return experimentResponseObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ExperimentResponse) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no experiment_responses provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(experimentResponseColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	experimentResponseInsertCacheMut.RLock()
+	cache, cached := experimentResponseInsertCache[key]
+	experimentResponseInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			experimentResponseAllColumns,
+			experimentResponseColumnsWithDefault,
+			experimentResponseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(experimentResponseType, experimentResponseMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(experimentResponseType, experimentResponseMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"experiment_responses\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"experiment_responses\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into experiment_responses")
+	}
+
+	if !cached {
+		experimentResponseInsertCacheMut.Lock()
+		experimentResponseInsertCache[key] = cache
+		experimentResponseInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ExperimentResponse.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ExperimentResponse) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	experimentResponseUpdateCacheMut.RLock()
+	cache, cached := experimentResponseUpdateCache[key]
+	experimentResponseUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			experimentResponseAllColumns,
+			experimentResponsePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update experiment_responses, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"experiment_responses\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, experimentResponsePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(experimentResponseType, experimentResponseMapping, append(wl, experimentResponsePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update experiment_responses row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for experiment_responses")
+	}
+
+	if !cached {
+		experimentResponseUpdateCacheMut.Lock()
+		experimentResponseUpdateCache[key] = cache
+		experimentResponseUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q experimentResponseQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for experiment_responses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for experiment_responses")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ExperimentResponseSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), experimentResponsePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"experiment_responses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, experimentResponsePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in experimentResponse slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all experimentResponse")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ExperimentResponse) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no experiment_responses provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(experimentResponseColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	experimentResponseUpsertCacheMut.RLock()
+	cache, cached := experimentResponseUpsertCache[key]
+	experimentResponseUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			experimentResponseAllColumns,
+			experimentResponseColumnsWithDefault,
+			experimentResponseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			experimentResponseAllColumns,
+			experimentResponsePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert experiment_responses, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(experimentResponseAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(experimentResponsePrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert experiment_responses, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(experimentResponsePrimaryKeyColumns))
+			copy(conflict, experimentResponsePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"experiment_responses\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(experimentResponseType, experimentResponseMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(experimentResponseType, experimentResponseMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert experiment_responses")
+	}
+
+	if !cached {
+		experimentResponseUpsertCacheMut.Lock()
+		experimentResponseUpsertCache[key] = cache
+		experimentResponseUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ExperimentResponse record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ExperimentResponse) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no ExperimentResponse provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), experimentResponsePrimaryKeyMapping)
+	sql := "DELETE FROM \"experiment_responses\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from experiment_responses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for experiment_responses")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q experimentResponseQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no experimentResponseQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from experiment_responses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for experiment_responses")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ExperimentResponseSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(experimentResponseBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), experimentResponsePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"experiment_responses\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, experimentResponsePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from experimentResponse slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for experiment_responses")
+	}
+
+	if len(experimentResponseAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ExperimentResponse) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindExperimentResponse(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ExperimentResponseSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ExperimentResponseSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), experimentResponsePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"experiment_responses\".* FROM \"experiment_responses\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, experimentResponsePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in ExperimentResponseSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ExperimentResponseExists checks if the ExperimentResponse row exists.
+func ExperimentResponseExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"experiment_responses\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if experiment_responses exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ExperimentResponse row exists.
+func (o *ExperimentResponse) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ExperimentResponseExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,902 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testExperimentResponses(t *testing.T) {
+	t.Parallel()
+
+	query := ExperimentResponses()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testExperimentResponsesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExperimentResponses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExperimentResponsesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ExperimentResponses().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExperimentResponses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExperimentResponsesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ExperimentResponseSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExperimentResponses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExperimentResponsesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ExperimentResponseExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ExperimentResponse exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ExperimentResponseExists to return true, but got false.")
+	}
+}
+
+func testExperimentResponsesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	experimentResponseFound, err := FindExperimentResponse(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if experimentResponseFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testExperimentResponsesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ExperimentResponses().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExperimentResponsesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ExperimentResponses().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testExperimentResponsesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	experimentResponseOne := &ExperimentResponse{}
+	experimentResponseTwo := &ExperimentResponse{}
+	if err = randomize.Struct(seed, experimentResponseOne, experimentResponseDBTypes, false, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+	if err = randomize.Struct(seed, experimentResponseTwo, experimentResponseDBTypes, false, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = experimentResponseOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = experimentResponseTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ExperimentResponses().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testExperimentResponsesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	experimentResponseOne := &ExperimentResponse{}
+	experimentResponseTwo := &ExperimentResponse{}
+	if err = randomize.Struct(seed, experimentResponseOne, experimentResponseDBTypes, false, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+	if err = randomize.Struct(seed, experimentResponseTwo, experimentResponseDBTypes, false, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = experimentResponseOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = experimentResponseTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExperimentResponses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func experimentResponseBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ExperimentResponse) error {
+	*o = ExperimentResponse{}
+	return nil
+}
+
+func experimentResponseAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ExperimentResponse) error {
+	*o = ExperimentResponse{}
+	return nil
+}
+
+func experimentResponseAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ExperimentResponse) error {
+	*o = ExperimentResponse{}
+	return nil
+}
+
+func experimentResponseBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ExperimentResponse) error {
+	*o = ExperimentResponse{}
+	return nil
+}
+
+func experimentResponseAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ExperimentResponse) error {
+	*o = ExperimentResponse{}
+	return nil
+}
+
+func experimentResponseBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ExperimentResponse) error {
+	*o = ExperimentResponse{}
+	return nil
+}
+
+func experimentResponseAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ExperimentResponse) error {
+	*o = ExperimentResponse{}
+	return nil
+}
+
+func experimentResponseBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ExperimentResponse) error {
+	*o = ExperimentResponse{}
+	return nil
+}
+
+func experimentResponseAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ExperimentResponse) error {
+	*o = ExperimentResponse{}
+	return nil
+}
+
+func testExperimentResponsesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ExperimentResponse{}
+	o := &ExperimentResponse{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse object: %s", err)
+	}
+
+	AddExperimentResponseHook(boil.BeforeInsertHook, experimentResponseBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	experimentResponseBeforeInsertHooks = []ExperimentResponseHook{}
+
+	AddExperimentResponseHook(boil.AfterInsertHook, experimentResponseAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	experimentResponseAfterInsertHooks = []ExperimentResponseHook{}
+
+	AddExperimentResponseHook(boil.AfterSelectHook, experimentResponseAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	experimentResponseAfterSelectHooks = []ExperimentResponseHook{}
+
+	AddExperimentResponseHook(boil.BeforeUpdateHook, experimentResponseBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	experimentResponseBeforeUpdateHooks = []ExperimentResponseHook{}
+
+	AddExperimentResponseHook(boil.AfterUpdateHook, experimentResponseAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	experimentResponseAfterUpdateHooks = []ExperimentResponseHook{}
+
+	AddExperimentResponseHook(boil.BeforeDeleteHook, experimentResponseBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	experimentResponseBeforeDeleteHooks = []ExperimentResponseHook{}
+
+	AddExperimentResponseHook(boil.AfterDeleteHook, experimentResponseAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	experimentResponseAfterDeleteHooks = []ExperimentResponseHook{}
+
+	AddExperimentResponseHook(boil.BeforeUpsertHook, experimentResponseBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	experimentResponseBeforeUpsertHooks = []ExperimentResponseHook{}
+
+	AddExperimentResponseHook(boil.AfterUpsertHook, experimentResponseAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	experimentResponseAfterUpsertHooks = []ExperimentResponseHook{}
+}
+
+func testExperimentResponsesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExperimentResponses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testExperimentResponsesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(experimentResponseColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExperimentResponses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testExperimentResponseToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ExperimentResponse
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ExperimentResponseSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ExperimentResponse)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testExperimentResponseToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ExperimentResponse
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, experimentResponseDBTypes, false, strmangle.SetComplement(experimentResponsePrimaryKeyColumns, experimentResponseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExperimentResponses[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testExperimentResponseToOneRemoveOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ExperimentResponse
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, experimentResponseDBTypes, false, strmangle.SetComplement(experimentResponsePrimaryKeyColumns, experimentResponseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.User().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.User != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.UserID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ExperimentResponses) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testExperimentResponsesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExperimentResponsesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ExperimentResponseSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExperimentResponsesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ExperimentResponses().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	experimentResponseDBTypes = map[string]string{`ID`: `uuid`, `Experiment`: `character varying`, `Variant`: `character varying`, `PromptID`: `character varying`, `PromptVersion`: `character varying`, `UserID`: `uuid`, `PromptTokens`: `integer`, `CompletionTokens`: `integer`, `TotalTokens`: `integer`, `Rating`: `smallint`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                         = bytes.MinRead
+)
+
+func testExperimentResponsesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(experimentResponsePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(experimentResponseAllColumns) == len(experimentResponsePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExperimentResponses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponsePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testExperimentResponsesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(experimentResponseAllColumns) == len(experimentResponsePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExperimentResponse{}
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExperimentResponses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, experimentResponseDBTypes, true, experimentResponsePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(experimentResponseAllColumns, experimentResponsePrimaryKeyColumns) {
+		fields = experimentResponseAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			experimentResponseAllColumns,
+			experimentResponsePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ExperimentResponseSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testExperimentResponsesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(experimentResponseAllColumns) == len(experimentResponsePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ExperimentResponse{}
+	if err = randomize.Struct(seed, &o, experimentResponseDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ExperimentResponse: %s", err)
+	}
+
+	count, err := ExperimentResponses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, experimentResponseDBTypes, false, experimentResponsePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExperimentResponse struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ExperimentResponse: %s", err)
+	}
+
+	count, err = ExperimentResponses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
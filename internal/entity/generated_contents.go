@@ -0,0 +1,1001 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// GeneratedContent is an object representing the database table.
+type GeneratedContent struct {
+	ID            string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Kind          string    `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Input         string    `boil:"input" json:"input" toml:"input" yaml:"input"`
+	Language      string    `boil:"language" json:"language" toml:"language" yaml:"language"`
+	PromptVersion string    `boil:"prompt_version" json:"prompt_version" toml:"prompt_version" yaml:"prompt_version"`
+	Model         string    `boil:"model" json:"model" toml:"model" yaml:"model"`
+	Content       string    `boil:"content" json:"content" toml:"content" yaml:"content"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *generatedContentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L generatedContentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GeneratedContentColumns = struct {
+	ID            string
+	Kind          string
+	Input         string
+	Language      string
+	PromptVersion string
+	Model         string
+	Content       string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "id",
+	Kind:          "kind",
+	Input:         "input",
+	Language:      "language",
+	PromptVersion: "prompt_version",
+	Model:         "model",
+	Content:       "content",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+}
+
+var GeneratedContentTableColumns = struct {
+	ID            string
+	Kind          string
+	Input         string
+	Language      string
+	PromptVersion string
+	Model         string
+	Content       string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "generated_contents.id",
+	Kind:          "generated_contents.kind",
+	Input:         "generated_contents.input",
+	Language:      "generated_contents.language",
+	PromptVersion: "generated_contents.prompt_version",
+	Model:         "generated_contents.model",
+	Content:       "generated_contents.content",
+	CreatedAt:     "generated_contents.created_at",
+	UpdatedAt:     "generated_contents.updated_at",
+}
+
+// Generated where
+
+var GeneratedContentWhere = struct {
+	ID            whereHelperstring
+	Kind          whereHelperstring
+	Input         whereHelperstring
+	Language      whereHelperstring
+	PromptVersion whereHelperstring
+	Model         whereHelperstring
+	Content       whereHelperstring
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"generated_contents\".\"id\""},
+	Kind:          whereHelperstring{field: "\"generated_contents\".\"kind\""},
+	Input:         whereHelperstring{field: "\"generated_contents\".\"input\""},
+	Language:      whereHelperstring{field: "\"generated_contents\".\"language\""},
+	PromptVersion: whereHelperstring{field: "\"generated_contents\".\"prompt_version\""},
+	Model:         whereHelperstring{field: "\"generated_contents\".\"model\""},
+	Content:       whereHelperstring{field: "\"generated_contents\".\"content\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"generated_contents\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"generated_contents\".\"updated_at\""},
+}
+
+// GeneratedContentRels is where relationship names are stored.
+var GeneratedContentRels = struct {
+}{}
+
+// generatedContentR is where relationships are stored.
+type generatedContentR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*generatedContentR) NewStruct() *generatedContentR {
+	return &generatedContentR{}
+}
+
+// generatedContentL is where Load methods for each relationship are stored.
+type generatedContentL struct{}
+
+var (
+	generatedContentAllColumns            = []string{"id", "kind", "input", "language", "prompt_version", "model", "content", "created_at", "updated_at"}
+	generatedContentColumnsWithoutDefault = []string{"kind", "input", "language", "prompt_version", "model", "content"}
+	generatedContentColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	generatedContentPrimaryKeyColumns     = []string{"id"}
+	generatedContentGeneratedColumns      = []string{}
+)
+
+type (
+	// GeneratedContentSlice is an alias for a slice of pointers to GeneratedContent.
+	// This should almost always be used instead of []GeneratedContent.
+	GeneratedContentSlice []*GeneratedContent
+	// GeneratedContentHook is the signature for custom GeneratedContent hook methods
+	GeneratedContentHook func(context.Context, boil.ContextExecutor, *GeneratedContent) error
+
+	generatedContentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	generatedContentType                 = reflect.TypeOf(&GeneratedContent{})
+	generatedContentMapping              = queries.MakeStructMapping(generatedContentType)
+	generatedContentPrimaryKeyMapping, _ = queries.BindMapping(generatedContentType, generatedContentMapping, generatedContentPrimaryKeyColumns)
+	generatedContentInsertCacheMut       sync.RWMutex
+	generatedContentInsertCache          = make(map[string]insertCache)
+	generatedContentUpdateCacheMut       sync.RWMutex
+	generatedContentUpdateCache          = make(map[string]updateCache)
+	generatedContentUpsertCacheMut       sync.RWMutex
+	generatedContentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var generatedContentAfterSelectMu sync.Mutex
+var generatedContentAfterSelectHooks []GeneratedContentHook
+
+var generatedContentBeforeInsertMu sync.Mutex
+var generatedContentBeforeInsertHooks []GeneratedContentHook
+var generatedContentAfterInsertMu sync.Mutex
+var generatedContentAfterInsertHooks []GeneratedContentHook
+
+var generatedContentBeforeUpdateMu sync.Mutex
+var generatedContentBeforeUpdateHooks []GeneratedContentHook
+var generatedContentAfterUpdateMu sync.Mutex
+var generatedContentAfterUpdateHooks []GeneratedContentHook
+
+var generatedContentBeforeDeleteMu sync.Mutex
+var generatedContentBeforeDeleteHooks []GeneratedContentHook
+var generatedContentAfterDeleteMu sync.Mutex
+var generatedContentAfterDeleteHooks []GeneratedContentHook
+
+var generatedContentBeforeUpsertMu sync.Mutex
+var generatedContentBeforeUpsertHooks []GeneratedContentHook
+var generatedContentAfterUpsertMu sync.Mutex
+var generatedContentAfterUpsertHooks []GeneratedContentHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *GeneratedContent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range generatedContentAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *GeneratedContent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range generatedContentBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *GeneratedContent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range generatedContentAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *GeneratedContent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range generatedContentBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *GeneratedContent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range generatedContentAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *GeneratedContent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range generatedContentBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *GeneratedContent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range generatedContentAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *GeneratedContent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range generatedContentBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *GeneratedContent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range generatedContentAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGeneratedContentHook registers your hook function for all future operations.
+func AddGeneratedContentHook(hookPoint boil.HookPoint, generatedContentHook GeneratedContentHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		generatedContentAfterSelectMu.Lock()
+		generatedContentAfterSelectHooks = append(generatedContentAfterSelectHooks, generatedContentHook)
+		generatedContentAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		generatedContentBeforeInsertMu.Lock()
+		generatedContentBeforeInsertHooks = append(generatedContentBeforeInsertHooks, generatedContentHook)
+		generatedContentBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		generatedContentAfterInsertMu.Lock()
+		generatedContentAfterInsertHooks = append(generatedContentAfterInsertHooks, generatedContentHook)
+		generatedContentAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		generatedContentBeforeUpdateMu.Lock()
+		generatedContentBeforeUpdateHooks = append(generatedContentBeforeUpdateHooks, generatedContentHook)
+		generatedContentBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		generatedContentAfterUpdateMu.Lock()
+		generatedContentAfterUpdateHooks = append(generatedContentAfterUpdateHooks, generatedContentHook)
+		generatedContentAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		generatedContentBeforeDeleteMu.Lock()
+		generatedContentBeforeDeleteHooks = append(generatedContentBeforeDeleteHooks, generatedContentHook)
+		generatedContentBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		generatedContentAfterDeleteMu.Lock()
+		generatedContentAfterDeleteHooks = append(generatedContentAfterDeleteHooks, generatedContentHook)
+		generatedContentAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		generatedContentBeforeUpsertMu.Lock()
+		generatedContentBeforeUpsertHooks = append(generatedContentBeforeUpsertHooks, generatedContentHook)
+		generatedContentBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		generatedContentAfterUpsertMu.Lock()
+		generatedContentAfterUpsertHooks = append(generatedContentAfterUpsertHooks, generatedContentHook)
+		generatedContentAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single generatedContent record from the query.
+func (q generatedContentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*GeneratedContent, error) {
+	o := &GeneratedContent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for generated_contents")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all GeneratedContent records from the query.
+func (q generatedContentQuery) All(ctx context.Context, exec boil.ContextExecutor) (GeneratedContentSlice, error) {
+	var o []*GeneratedContent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to GeneratedContent slice")
+	}
+
+	if len(generatedContentAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all GeneratedContent records in the query.
+func (q generatedContentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count generated_contents rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q generatedContentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if generated_contents exists")
+	}
+
+	return count > 0, nil
+}
+
+// GeneratedContents retrieves all the records using an executor.
+func GeneratedContents(mods ...qm.QueryMod) generatedContentQuery {
+	mods = append(mods, qm.From("\"generated_contents\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"generated_contents\".*"})
+	}
+
+	return generatedContentQuery{q}
+}
+
+// FindGeneratedContent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGeneratedContent(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*GeneratedContent, error) {
+	generatedContentObj := &GeneratedContent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"generated_contents\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, generatedContentObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from generated_contents")
+	}
+
+	if err = generatedContentObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return generatedContentObj, err
+	}
+
+	return generatedContentObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *GeneratedContent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no generated_contents provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(generatedContentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	generatedContentInsertCacheMut.RLock()
+	cache, cached := generatedContentInsertCache[key]
+	generatedContentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			generatedContentAllColumns,
+			generatedContentColumnsWithDefault,
+			generatedContentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(generatedContentType, generatedContentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(generatedContentType, generatedContentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"generated_contents\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"generated_contents\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into generated_contents")
+	}
+
+	if !cached {
+		generatedContentInsertCacheMut.Lock()
+		generatedContentInsertCache[key] = cache
+		generatedContentInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the GeneratedContent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *GeneratedContent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	generatedContentUpdateCacheMut.RLock()
+	cache, cached := generatedContentUpdateCache[key]
+	generatedContentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			generatedContentAllColumns,
+			generatedContentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update generated_contents, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"generated_contents\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, generatedContentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(generatedContentType, generatedContentMapping, append(wl, generatedContentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update generated_contents row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for generated_contents")
+	}
+
+	if !cached {
+		generatedContentUpdateCacheMut.Lock()
+		generatedContentUpdateCache[key] = cache
+		generatedContentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q generatedContentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for generated_contents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for generated_contents")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GeneratedContentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), generatedContentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"generated_contents\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, generatedContentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in generatedContent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all generatedContent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *GeneratedContent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no generated_contents provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(generatedContentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	generatedContentUpsertCacheMut.RLock()
+	cache, cached := generatedContentUpsertCache[key]
+	generatedContentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			generatedContentAllColumns,
+			generatedContentColumnsWithDefault,
+			generatedContentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			generatedContentAllColumns,
+			generatedContentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert generated_contents, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(generatedContentAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(generatedContentPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert generated_contents, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(generatedContentPrimaryKeyColumns))
+			copy(conflict, generatedContentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"generated_contents\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(generatedContentType, generatedContentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(generatedContentType, generatedContentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert generated_contents")
+	}
+
+	if !cached {
+		generatedContentUpsertCacheMut.Lock()
+		generatedContentUpsertCache[key] = cache
+		generatedContentUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single GeneratedContent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *GeneratedContent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no GeneratedContent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), generatedContentPrimaryKeyMapping)
+	sql := "DELETE FROM \"generated_contents\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from generated_contents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for generated_contents")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q generatedContentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no generatedContentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from generated_contents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for generated_contents")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GeneratedContentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(generatedContentBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), generatedContentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"generated_contents\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, generatedContentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from generatedContent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for generated_contents")
+	}
+
+	if len(generatedContentAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *GeneratedContent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGeneratedContent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GeneratedContentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GeneratedContentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), generatedContentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"generated_contents\".* FROM \"generated_contents\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, generatedContentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in GeneratedContentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GeneratedContentExists checks if the GeneratedContent row exists.
+func GeneratedContentExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"generated_contents\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if generated_contents exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the GeneratedContent row exists.
+func (o *GeneratedContent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return GeneratedContentExists(ctx, exec, o.ID)
+}
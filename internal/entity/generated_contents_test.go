@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testGeneratedContents(t *testing.T) {
+	t.Parallel()
+
+	query := GeneratedContents()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testGeneratedContentsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := GeneratedContents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGeneratedContentsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := GeneratedContents().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := GeneratedContents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGeneratedContentsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := GeneratedContentSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := GeneratedContents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGeneratedContentsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := GeneratedContentExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if GeneratedContent exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected GeneratedContentExists to return true, but got false.")
+	}
+}
+
+func testGeneratedContentsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	generatedContentFound, err := FindGeneratedContent(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if generatedContentFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testGeneratedContentsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = GeneratedContents().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGeneratedContentsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := GeneratedContents().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testGeneratedContentsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	generatedContentOne := &GeneratedContent{}
+	generatedContentTwo := &GeneratedContent{}
+	if err = randomize.Struct(seed, generatedContentOne, generatedContentDBTypes, false, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, generatedContentTwo, generatedContentDBTypes, false, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = generatedContentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = generatedContentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := GeneratedContents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testGeneratedContentsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	generatedContentOne := &GeneratedContent{}
+	generatedContentTwo := &GeneratedContent{}
+	if err = randomize.Struct(seed, generatedContentOne, generatedContentDBTypes, false, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, generatedContentTwo, generatedContentDBTypes, false, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = generatedContentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = generatedContentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := GeneratedContents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func generatedContentBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *GeneratedContent) error {
+	*o = GeneratedContent{}
+	return nil
+}
+
+func generatedContentAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *GeneratedContent) error {
+	*o = GeneratedContent{}
+	return nil
+}
+
+func generatedContentAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *GeneratedContent) error {
+	*o = GeneratedContent{}
+	return nil
+}
+
+func generatedContentBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *GeneratedContent) error {
+	*o = GeneratedContent{}
+	return nil
+}
+
+func generatedContentAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *GeneratedContent) error {
+	*o = GeneratedContent{}
+	return nil
+}
+
+func generatedContentBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *GeneratedContent) error {
+	*o = GeneratedContent{}
+	return nil
+}
+
+func generatedContentAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *GeneratedContent) error {
+	*o = GeneratedContent{}
+	return nil
+}
+
+func generatedContentBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *GeneratedContent) error {
+	*o = GeneratedContent{}
+	return nil
+}
+
+func generatedContentAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *GeneratedContent) error {
+	*o = GeneratedContent{}
+	return nil
+}
+
+func testGeneratedContentsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &GeneratedContent{}
+	o := &GeneratedContent{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent object: %s", err)
+	}
+
+	AddGeneratedContentHook(boil.BeforeInsertHook, generatedContentBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	generatedContentBeforeInsertHooks = []GeneratedContentHook{}
+
+	AddGeneratedContentHook(boil.AfterInsertHook, generatedContentAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	generatedContentAfterInsertHooks = []GeneratedContentHook{}
+
+	AddGeneratedContentHook(boil.AfterSelectHook, generatedContentAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	generatedContentAfterSelectHooks = []GeneratedContentHook{}
+
+	AddGeneratedContentHook(boil.BeforeUpdateHook, generatedContentBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	generatedContentBeforeUpdateHooks = []GeneratedContentHook{}
+
+	AddGeneratedContentHook(boil.AfterUpdateHook, generatedContentAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	generatedContentAfterUpdateHooks = []GeneratedContentHook{}
+
+	AddGeneratedContentHook(boil.BeforeDeleteHook, generatedContentBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	generatedContentBeforeDeleteHooks = []GeneratedContentHook{}
+
+	AddGeneratedContentHook(boil.AfterDeleteHook, generatedContentAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	generatedContentAfterDeleteHooks = []GeneratedContentHook{}
+
+	AddGeneratedContentHook(boil.BeforeUpsertHook, generatedContentBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	generatedContentBeforeUpsertHooks = []GeneratedContentHook{}
+
+	AddGeneratedContentHook(boil.AfterUpsertHook, generatedContentAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	generatedContentAfterUpsertHooks = []GeneratedContentHook{}
+}
+
+func testGeneratedContentsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := GeneratedContents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testGeneratedContentsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(generatedContentColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := GeneratedContents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testGeneratedContentsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGeneratedContentsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := GeneratedContentSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGeneratedContentsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := GeneratedContents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	generatedContentDBTypes = map[string]string{`ID`: `uuid`, `Kind`: `character varying`, `Input`: `text`, `Language`: `character varying`, `PromptVersion`: `character varying`, `Model`: `character varying`, `Content`: `text`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                       = bytes.MinRead
+)
+
+func testGeneratedContentsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(generatedContentPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(generatedContentAllColumns) == len(generatedContentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := GeneratedContents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testGeneratedContentsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(generatedContentAllColumns) == len(generatedContentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &GeneratedContent{}
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := GeneratedContents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, generatedContentDBTypes, true, generatedContentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(generatedContentAllColumns, generatedContentPrimaryKeyColumns) {
+		fields = generatedContentAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			generatedContentAllColumns,
+			generatedContentPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := GeneratedContentSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testGeneratedContentsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(generatedContentAllColumns) == len(generatedContentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := GeneratedContent{}
+	if err = randomize.Struct(seed, &o, generatedContentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert GeneratedContent: %s", err)
+	}
+
+	count, err := GeneratedContents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, generatedContentDBTypes, false, generatedContentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize GeneratedContent struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert GeneratedContent: %s", err)
+	}
+
+	count, err = GeneratedContents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
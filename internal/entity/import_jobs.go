@@ -0,0 +1,1431 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ImportJob is an object representing the database table.
+type ImportJob struct {
+	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID         string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	DeckID         string    `boil:"deck_id" json:"deck_id" toml:"deck_id" yaml:"deck_id"`
+	NativeLanguage string    `boil:"native_language" json:"native_language" toml:"native_language" yaml:"native_language"`
+	Status         string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Total          int       `boil:"total" json:"total" toml:"total" yaml:"total"`
+	Imported       int       `boil:"imported" json:"imported" toml:"imported" yaml:"imported"`
+	Skipped        int       `boil:"skipped" json:"skipped" toml:"skipped" yaml:"skipped"`
+	Failed         int       `boil:"failed" json:"failed" toml:"failed" yaml:"failed"`
+	Failures       string    `boil:"failures" json:"failures" toml:"failures" yaml:"failures"`
+	FinishedAt     null.Time `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *importJobR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L importJobL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ImportJobColumns = struct {
+	ID             string
+	UserID         string
+	DeckID         string
+	NativeLanguage string
+	Status         string
+	Total          string
+	Imported       string
+	Skipped        string
+	Failed         string
+	Failures       string
+	FinishedAt     string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	UserID:         "user_id",
+	DeckID:         "deck_id",
+	NativeLanguage: "native_language",
+	Status:         "status",
+	Total:          "total",
+	Imported:       "imported",
+	Skipped:        "skipped",
+	Failed:         "failed",
+	Failures:       "failures",
+	FinishedAt:     "finished_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var ImportJobTableColumns = struct {
+	ID             string
+	UserID         string
+	DeckID         string
+	NativeLanguage string
+	Status         string
+	Total          string
+	Imported       string
+	Skipped        string
+	Failed         string
+	Failures       string
+	FinishedAt     string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "import_jobs.id",
+	UserID:         "import_jobs.user_id",
+	DeckID:         "import_jobs.deck_id",
+	NativeLanguage: "import_jobs.native_language",
+	Status:         "import_jobs.status",
+	Total:          "import_jobs.total",
+	Imported:       "import_jobs.imported",
+	Skipped:        "import_jobs.skipped",
+	Failed:         "import_jobs.failed",
+	Failures:       "import_jobs.failures",
+	FinishedAt:     "import_jobs.finished_at",
+	CreatedAt:      "import_jobs.created_at",
+	UpdatedAt:      "import_jobs.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var ImportJobWhere = struct {
+	ID             whereHelperstring
+	UserID         whereHelperstring
+	DeckID         whereHelperstring
+	NativeLanguage whereHelperstring
+	Status         whereHelperstring
+	Total          whereHelperint
+	Imported       whereHelperint
+	Skipped        whereHelperint
+	Failed         whereHelperint
+	Failures       whereHelperstring
+	FinishedAt     whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"import_jobs\".\"id\""},
+	UserID:         whereHelperstring{field: "\"import_jobs\".\"user_id\""},
+	DeckID:         whereHelperstring{field: "\"import_jobs\".\"deck_id\""},
+	NativeLanguage: whereHelperstring{field: "\"import_jobs\".\"native_language\""},
+	Status:         whereHelperstring{field: "\"import_jobs\".\"status\""},
+	Total:          whereHelperint{field: "\"import_jobs\".\"total\""},
+	Imported:       whereHelperint{field: "\"import_jobs\".\"imported\""},
+	Skipped:        whereHelperint{field: "\"import_jobs\".\"skipped\""},
+	Failed:         whereHelperint{field: "\"import_jobs\".\"failed\""},
+	Failures:       whereHelperstring{field: "\"import_jobs\".\"failures\""},
+	FinishedAt:     whereHelpernull_Time{field: "\"import_jobs\".\"finished_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"import_jobs\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"import_jobs\".\"updated_at\""},
+}
+
+// ImportJobRels is where relationship names are stored.
+var ImportJobRels = struct {
+	User string
+	Deck string
+}{
+	User: "User",
+	Deck: "Deck",
+}
+
+// importJobR is where relationships are stored.
+type importJobR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Deck *Deck `boil:"Deck" json:"Deck" toml:"Deck" yaml:"Deck"`
+}
+
+// NewStruct creates a new relationship struct
+func (*importJobR) NewStruct() *importJobR {
+	return &importJobR{}
+}
+
+func (r *importJobR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *importJobR) GetDeck() *Deck {
+	if r == nil {
+		return nil
+	}
+	return r.Deck
+}
+
+// importJobL is where Load methods for each relationship are stored.
+type importJobL struct{}
+
+var (
+	importJobAllColumns            = []string{"id", "user_id", "deck_id", "native_language", "status", "total", "imported", "skipped", "failed", "failures", "finished_at", "created_at", "updated_at"}
+	importJobColumnsWithoutDefault = []string{"user_id", "deck_id", "native_language", "status", "total"}
+	importJobColumnsWithDefault    = []string{"id", "imported", "skipped", "failed", "failures", "finished_at", "created_at", "updated_at"}
+	importJobPrimaryKeyColumns     = []string{"id"}
+	importJobGeneratedColumns      = []string{}
+)
+
+type (
+	// ImportJobSlice is an alias for a slice of pointers to ImportJob.
+	// This should almost always be used instead of []ImportJob.
+	ImportJobSlice []*ImportJob
+	// ImportJobHook is the signature for custom ImportJob hook methods
+	ImportJobHook func(context.Context, boil.ContextExecutor, *ImportJob) error
+
+	importJobQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	importJobType                 = reflect.TypeOf(&ImportJob{})
+	importJobMapping              = queries.MakeStructMapping(importJobType)
+	importJobPrimaryKeyMapping, _ = queries.BindMapping(importJobType, importJobMapping, importJobPrimaryKeyColumns)
+	importJobInsertCacheMut       sync.RWMutex
+	importJobInsertCache          = make(map[string]insertCache)
+	importJobUpdateCacheMut       sync.RWMutex
+	importJobUpdateCache          = make(map[string]updateCache)
+	importJobUpsertCacheMut       sync.RWMutex
+	importJobUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var importJobAfterSelectMu sync.Mutex
+var importJobAfterSelectHooks []ImportJobHook
+
+var importJobBeforeInsertMu sync.Mutex
+var importJobBeforeInsertHooks []ImportJobHook
+var importJobAfterInsertMu sync.Mutex
+var importJobAfterInsertHooks []ImportJobHook
+
+var importJobBeforeUpdateMu sync.Mutex
+var importJobBeforeUpdateHooks []ImportJobHook
+var importJobAfterUpdateMu sync.Mutex
+var importJobAfterUpdateHooks []ImportJobHook
+
+var importJobBeforeDeleteMu sync.Mutex
+var importJobBeforeDeleteHooks []ImportJobHook
+var importJobAfterDeleteMu sync.Mutex
+var importJobAfterDeleteHooks []ImportJobHook
+
+var importJobBeforeUpsertMu sync.Mutex
+var importJobBeforeUpsertHooks []ImportJobHook
+var importJobAfterUpsertMu sync.Mutex
+var importJobAfterUpsertHooks []ImportJobHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ImportJob) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importJobAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ImportJob) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importJobBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ImportJob) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importJobAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ImportJob) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importJobBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ImportJob) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importJobAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ImportJob) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importJobBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ImportJob) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importJobAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ImportJob) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importJobBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ImportJob) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importJobAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddImportJobHook registers your hook function for all future operations.
+func AddImportJobHook(hookPoint boil.HookPoint, importJobHook ImportJobHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		importJobAfterSelectMu.Lock()
+		importJobAfterSelectHooks = append(importJobAfterSelectHooks, importJobHook)
+		importJobAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		importJobBeforeInsertMu.Lock()
+		importJobBeforeInsertHooks = append(importJobBeforeInsertHooks, importJobHook)
+		importJobBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		importJobAfterInsertMu.Lock()
+		importJobAfterInsertHooks = append(importJobAfterInsertHooks, importJobHook)
+		importJobAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		importJobBeforeUpdateMu.Lock()
+		importJobBeforeUpdateHooks = append(importJobBeforeUpdateHooks, importJobHook)
+		importJobBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		importJobAfterUpdateMu.Lock()
+		importJobAfterUpdateHooks = append(importJobAfterUpdateHooks, importJobHook)
+		importJobAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		importJobBeforeDeleteMu.Lock()
+		importJobBeforeDeleteHooks = append(importJobBeforeDeleteHooks, importJobHook)
+		importJobBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		importJobAfterDeleteMu.Lock()
+		importJobAfterDeleteHooks = append(importJobAfterDeleteHooks, importJobHook)
+		importJobAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		importJobBeforeUpsertMu.Lock()
+		importJobBeforeUpsertHooks = append(importJobBeforeUpsertHooks, importJobHook)
+		importJobBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		importJobAfterUpsertMu.Lock()
+		importJobAfterUpsertHooks = append(importJobAfterUpsertHooks, importJobHook)
+		importJobAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single importJob record from the query.
+func (q importJobQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ImportJob, error) {
+	o := &ImportJob{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for import_jobs")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ImportJob records from the query.
+func (q importJobQuery) All(ctx context.Context, exec boil.ContextExecutor) (ImportJobSlice, error) {
+	var o []*ImportJob
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to ImportJob slice")
+	}
+
+	if len(importJobAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ImportJob records in the query.
+func (q importJobQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count import_jobs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q importJobQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if import_jobs exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *ImportJob) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Deck pointed to by the foreign key.
+func (o *ImportJob) Deck(mods ...qm.QueryMod) deckQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DeckID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Decks(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (importJobL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeImportJob interface{}, mods queries.Applicator) error {
+	var slice []*ImportJob
+	var object *ImportJob
+
+	if singular {
+		var ok bool
+		object, ok = maybeImportJob.(*ImportJob)
+		if !ok {
+			object = new(ImportJob)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImportJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImportJob))
+			}
+		}
+	} else {
+		s, ok := maybeImportJob.(*[]*ImportJob)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImportJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImportJob))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &importJobR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &importJobR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ImportJobs = append(foreign.R.ImportJobs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ImportJobs = append(foreign.R.ImportJobs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDeck allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (importJobL) LoadDeck(ctx context.Context, e boil.ContextExecutor, singular bool, maybeImportJob interface{}, mods queries.Applicator) error {
+	var slice []*ImportJob
+	var object *ImportJob
+
+	if singular {
+		var ok bool
+		object, ok = maybeImportJob.(*ImportJob)
+		if !ok {
+			object = new(ImportJob)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImportJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImportJob))
+			}
+		}
+	} else {
+		s, ok := maybeImportJob.(*[]*ImportJob)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImportJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImportJob))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &importJobR{}
+		}
+		args[object.DeckID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &importJobR{}
+			}
+
+			args[obj.DeckID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`decks`),
+		qm.WhereIn(`decks.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Deck")
+	}
+
+	var resultSlice []*Deck
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Deck")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for decks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for decks")
+	}
+
+	if len(deckAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Deck = foreign
+		if foreign.R == nil {
+			foreign.R = &deckR{}
+		}
+		foreign.R.ImportJobs = append(foreign.R.ImportJobs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DeckID == foreign.ID {
+				local.R.Deck = foreign
+				if foreign.R == nil {
+					foreign.R = &deckR{}
+				}
+				foreign.R.ImportJobs = append(foreign.R.ImportJobs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the importJob to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ImportJobs.
+func (o *ImportJob) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"import_jobs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, importJobPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &importJobR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImportJobs: ImportJobSlice{o},
+		}
+	} else {
+		related.R.ImportJobs = append(related.R.ImportJobs, o)
+	}
+
+	return nil
+}
+
+// SetDeck of the importJob to the related item.
+// Sets o.R.Deck to related.
+// Adds o to related.R.ImportJobs.
+func (o *ImportJob) SetDeck(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Deck) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"import_jobs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"deck_id"}),
+		strmangle.WhereClause("\"", "\"", 2, importJobPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DeckID = related.ID
+	if o.R == nil {
+		o.R = &importJobR{
+			Deck: related,
+		}
+	} else {
+		o.R.Deck = related
+	}
+
+	if related.R == nil {
+		related.R = &deckR{
+			ImportJobs: ImportJobSlice{o},
+		}
+	} else {
+		related.R.ImportJobs = append(related.R.ImportJobs, o)
+	}
+
+	return nil
+}
+
+// ImportJobs retrieves all the records using an executor.
+func ImportJobs(mods ...qm.QueryMod) importJobQuery {
+	mods = append(mods, qm.From("\"import_jobs\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"import_jobs\".*"})
+	}
+
+	return importJobQuery{q}
+}
+
+// FindImportJob retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindImportJob(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ImportJob, error) {
+	importJobObj := &ImportJob{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"import_jobs\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, importJobObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from import_jobs")
+	}
+
+	if err = importJobObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return importJobObj, err
+	}
+
+	return importJobObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ImportJob) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no import_jobs provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(importJobColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	importJobInsertCacheMut.RLock()
+	cache, cached := importJobInsertCache[key]
+	importJobInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			importJobAllColumns,
+			importJobColumnsWithDefault,
+			importJobColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(importJobType, importJobMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(importJobType, importJobMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"import_jobs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"import_jobs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into import_jobs")
+	}
+
+	if !cached {
+		importJobInsertCacheMut.Lock()
+		importJobInsertCache[key] = cache
+		importJobInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ImportJob.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ImportJob) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	importJobUpdateCacheMut.RLock()
+	cache, cached := importJobUpdateCache[key]
+	importJobUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			importJobAllColumns,
+			importJobPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update import_jobs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"import_jobs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, importJobPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(importJobType, importJobMapping, append(wl, importJobPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update import_jobs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for import_jobs")
+	}
+
+	if !cached {
+		importJobUpdateCacheMut.Lock()
+		importJobUpdateCache[key] = cache
+		importJobUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q importJobQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for import_jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for import_jobs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ImportJobSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), importJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"import_jobs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, importJobPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in importJob slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all importJob")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ImportJob) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no import_jobs provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(importJobColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	importJobUpsertCacheMut.RLock()
+	cache, cached := importJobUpsertCache[key]
+	importJobUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			importJobAllColumns,
+			importJobColumnsWithDefault,
+			importJobColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			importJobAllColumns,
+			importJobPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert import_jobs, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(importJobAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(importJobPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert import_jobs, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(importJobPrimaryKeyColumns))
+			copy(conflict, importJobPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"import_jobs\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(importJobType, importJobMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(importJobType, importJobMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert import_jobs")
+	}
+
+	if !cached {
+		importJobUpsertCacheMut.Lock()
+		importJobUpsertCache[key] = cache
+		importJobUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ImportJob record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ImportJob) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no ImportJob provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), importJobPrimaryKeyMapping)
+	sql := "DELETE FROM \"import_jobs\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from import_jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for import_jobs")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q importJobQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no importJobQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from import_jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for import_jobs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ImportJobSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(importJobBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), importJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"import_jobs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, importJobPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from importJob slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for import_jobs")
+	}
+
+	if len(importJobAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ImportJob) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindImportJob(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ImportJobSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ImportJobSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), importJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"import_jobs\".* FROM \"import_jobs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, importJobPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in ImportJobSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ImportJobExists checks if the ImportJob row exists.
+func ImportJobExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"import_jobs\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if import_jobs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ImportJob row exists.
+func (o *ImportJob) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ImportJobExists(ctx, exec, o.ID)
+}
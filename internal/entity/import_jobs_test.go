@@ -0,0 +1,969 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testImportJobs(t *testing.T) {
+	t.Parallel()
+
+	query := ImportJobs()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testImportJobsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ImportJobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testImportJobsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ImportJobs().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ImportJobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testImportJobsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ImportJobSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ImportJobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testImportJobsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ImportJobExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ImportJob exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ImportJobExists to return true, but got false.")
+	}
+}
+
+func testImportJobsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	importJobFound, err := FindImportJob(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if importJobFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testImportJobsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ImportJobs().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testImportJobsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ImportJobs().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testImportJobsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	importJobOne := &ImportJob{}
+	importJobTwo := &ImportJob{}
+	if err = randomize.Struct(seed, importJobOne, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+	if err = randomize.Struct(seed, importJobTwo, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = importJobOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = importJobTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ImportJobs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testImportJobsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	importJobOne := &ImportJob{}
+	importJobTwo := &ImportJob{}
+	if err = randomize.Struct(seed, importJobOne, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+	if err = randomize.Struct(seed, importJobTwo, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = importJobOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = importJobTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ImportJobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func importJobBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ImportJob) error {
+	*o = ImportJob{}
+	return nil
+}
+
+func importJobAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ImportJob) error {
+	*o = ImportJob{}
+	return nil
+}
+
+func importJobAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ImportJob) error {
+	*o = ImportJob{}
+	return nil
+}
+
+func importJobBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ImportJob) error {
+	*o = ImportJob{}
+	return nil
+}
+
+func importJobAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ImportJob) error {
+	*o = ImportJob{}
+	return nil
+}
+
+func importJobBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ImportJob) error {
+	*o = ImportJob{}
+	return nil
+}
+
+func importJobAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ImportJob) error {
+	*o = ImportJob{}
+	return nil
+}
+
+func importJobBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ImportJob) error {
+	*o = ImportJob{}
+	return nil
+}
+
+func importJobAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ImportJob) error {
+	*o = ImportJob{}
+	return nil
+}
+
+func testImportJobsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ImportJob{}
+	o := &ImportJob{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, importJobDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ImportJob object: %s", err)
+	}
+
+	AddImportJobHook(boil.BeforeInsertHook, importJobBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	importJobBeforeInsertHooks = []ImportJobHook{}
+
+	AddImportJobHook(boil.AfterInsertHook, importJobAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	importJobAfterInsertHooks = []ImportJobHook{}
+
+	AddImportJobHook(boil.AfterSelectHook, importJobAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	importJobAfterSelectHooks = []ImportJobHook{}
+
+	AddImportJobHook(boil.BeforeUpdateHook, importJobBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	importJobBeforeUpdateHooks = []ImportJobHook{}
+
+	AddImportJobHook(boil.AfterUpdateHook, importJobAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	importJobAfterUpdateHooks = []ImportJobHook{}
+
+	AddImportJobHook(boil.BeforeDeleteHook, importJobBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	importJobBeforeDeleteHooks = []ImportJobHook{}
+
+	AddImportJobHook(boil.AfterDeleteHook, importJobAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	importJobAfterDeleteHooks = []ImportJobHook{}
+
+	AddImportJobHook(boil.BeforeUpsertHook, importJobBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	importJobBeforeUpsertHooks = []ImportJobHook{}
+
+	AddImportJobHook(boil.AfterUpsertHook, importJobAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	importJobAfterUpsertHooks = []ImportJobHook{}
+}
+
+func testImportJobsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ImportJobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testImportJobsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(importJobColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ImportJobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testImportJobToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ImportJob
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ImportJobSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ImportJob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testImportJobToOneDeckUsingDeck(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ImportJob
+	var foreign Deck
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, deckDBTypes, false, deckColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Deck struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.DeckID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Deck().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddDeckHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Deck) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ImportJobSlice{&local}
+	if err = local.L.LoadDeck(ctx, tx, false, (*[]*ImportJob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Deck == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Deck = nil
+	if err = local.L.LoadDeck(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Deck == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testImportJobToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ImportJob
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, importJobDBTypes, false, strmangle.SetComplement(importJobPrimaryKeyColumns, importJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ImportJobs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+func testImportJobToOneSetOpDeckUsingDeck(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ImportJob
+	var b, c Deck
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, importJobDBTypes, false, strmangle.SetComplement(importJobPrimaryKeyColumns, importJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, deckDBTypes, false, strmangle.SetComplement(deckPrimaryKeyColumns, deckColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, deckDBTypes, false, strmangle.SetComplement(deckPrimaryKeyColumns, deckColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Deck{&b, &c} {
+		err = a.SetDeck(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Deck != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ImportJobs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.DeckID != x.ID {
+			t.Error("foreign key was wrong value", a.DeckID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.DeckID))
+		reflect.Indirect(reflect.ValueOf(&a.DeckID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.DeckID != x.ID {
+			t.Error("foreign key was wrong value", a.DeckID, x.ID)
+		}
+	}
+}
+
+func testImportJobsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testImportJobsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ImportJobSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testImportJobsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ImportJobs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	importJobDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `DeckID`: `uuid`, `NativeLanguage`: `character varying`, `Status`: `character varying`, `Total`: `integer`, `Imported`: `integer`, `Skipped`: `integer`, `Failed`: `integer`, `Failures`: `text`, `FinishedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                = bytes.MinRead
+)
+
+func testImportJobsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(importJobPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(importJobAllColumns) == len(importJobPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ImportJobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testImportJobsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(importJobAllColumns) == len(importJobPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportJob{}
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ImportJobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, importJobDBTypes, true, importJobPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(importJobAllColumns, importJobPrimaryKeyColumns) {
+		fields = importJobAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			importJobAllColumns,
+			importJobPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ImportJobSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testImportJobsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(importJobAllColumns) == len(importJobPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ImportJob{}
+	if err = randomize.Struct(seed, &o, importJobDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ImportJob: %s", err)
+	}
+
+	count, err := ImportJobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, importJobDBTypes, false, importJobPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ImportJob: %s", err)
+	}
+
+	count, err = ImportJobs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
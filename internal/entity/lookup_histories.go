@@ -0,0 +1,1183 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LookupHistory is an object representing the database table.
+type LookupHistory struct {
+	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID         string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Kind           string    `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Query          string    `boil:"query" json:"query" toml:"query" yaml:"query"`
+	NativeLanguage string    `boil:"native_language" json:"native_language" toml:"native_language" yaml:"native_language"`
+	Result         string    `boil:"result" json:"result" toml:"result" yaml:"result"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *lookupHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L lookupHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LookupHistoryColumns = struct {
+	ID             string
+	UserID         string
+	Kind           string
+	Query          string
+	NativeLanguage string
+	Result         string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	UserID:         "user_id",
+	Kind:           "kind",
+	Query:          "query",
+	NativeLanguage: "native_language",
+	Result:         "result",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var LookupHistoryTableColumns = struct {
+	ID             string
+	UserID         string
+	Kind           string
+	Query          string
+	NativeLanguage string
+	Result         string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "lookup_histories.id",
+	UserID:         "lookup_histories.user_id",
+	Kind:           "lookup_histories.kind",
+	Query:          "lookup_histories.query",
+	NativeLanguage: "lookup_histories.native_language",
+	Result:         "lookup_histories.result",
+	CreatedAt:      "lookup_histories.created_at",
+	UpdatedAt:      "lookup_histories.updated_at",
+}
+
+// Generated where
+
+var LookupHistoryWhere = struct {
+	ID             whereHelperstring
+	UserID         whereHelperstring
+	Kind           whereHelperstring
+	Query          whereHelperstring
+	NativeLanguage whereHelperstring
+	Result         whereHelperstring
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"lookup_histories\".\"id\""},
+	UserID:         whereHelperstring{field: "\"lookup_histories\".\"user_id\""},
+	Kind:           whereHelperstring{field: "\"lookup_histories\".\"kind\""},
+	Query:          whereHelperstring{field: "\"lookup_histories\".\"query\""},
+	NativeLanguage: whereHelperstring{field: "\"lookup_histories\".\"native_language\""},
+	Result:         whereHelperstring{field: "\"lookup_histories\".\"result\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"lookup_histories\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"lookup_histories\".\"updated_at\""},
+}
+
+// LookupHistoryRels is where relationship names are stored.
+var LookupHistoryRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// lookupHistoryR is where relationships are stored.
+type lookupHistoryR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*lookupHistoryR) NewStruct() *lookupHistoryR {
+	return &lookupHistoryR{}
+}
+
+func (r *lookupHistoryR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// lookupHistoryL is where Load methods for each relationship are stored.
+type lookupHistoryL struct{}
+
+var (
+	lookupHistoryAllColumns            = []string{"id", "user_id", "kind", "query", "native_language", "result", "created_at", "updated_at"}
+	lookupHistoryColumnsWithoutDefault = []string{"user_id", "kind", "query", "native_language", "result"}
+	lookupHistoryColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	lookupHistoryPrimaryKeyColumns     = []string{"id"}
+	lookupHistoryGeneratedColumns      = []string{}
+)
+
+type (
+	// LookupHistorySlice is an alias for a slice of pointers to LookupHistory.
+	// This should almost always be used instead of []LookupHistory.
+	LookupHistorySlice []*LookupHistory
+	// LookupHistoryHook is the signature for custom LookupHistory hook methods
+	LookupHistoryHook func(context.Context, boil.ContextExecutor, *LookupHistory) error
+
+	lookupHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	lookupHistoryType                 = reflect.TypeOf(&LookupHistory{})
+	lookupHistoryMapping              = queries.MakeStructMapping(lookupHistoryType)
+	lookupHistoryPrimaryKeyMapping, _ = queries.BindMapping(lookupHistoryType, lookupHistoryMapping, lookupHistoryPrimaryKeyColumns)
+	lookupHistoryInsertCacheMut       sync.RWMutex
+	lookupHistoryInsertCache          = make(map[string]insertCache)
+	lookupHistoryUpdateCacheMut       sync.RWMutex
+	lookupHistoryUpdateCache          = make(map[string]updateCache)
+	lookupHistoryUpsertCacheMut       sync.RWMutex
+	lookupHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var lookupHistoryAfterSelectMu sync.Mutex
+var lookupHistoryAfterSelectHooks []LookupHistoryHook
+
+var lookupHistoryBeforeInsertMu sync.Mutex
+var lookupHistoryBeforeInsertHooks []LookupHistoryHook
+var lookupHistoryAfterInsertMu sync.Mutex
+var lookupHistoryAfterInsertHooks []LookupHistoryHook
+
+var lookupHistoryBeforeUpdateMu sync.Mutex
+var lookupHistoryBeforeUpdateHooks []LookupHistoryHook
+var lookupHistoryAfterUpdateMu sync.Mutex
+var lookupHistoryAfterUpdateHooks []LookupHistoryHook
+
+var lookupHistoryBeforeDeleteMu sync.Mutex
+var lookupHistoryBeforeDeleteHooks []LookupHistoryHook
+var lookupHistoryAfterDeleteMu sync.Mutex
+var lookupHistoryAfterDeleteHooks []LookupHistoryHook
+
+var lookupHistoryBeforeUpsertMu sync.Mutex
+var lookupHistoryBeforeUpsertHooks []LookupHistoryHook
+var lookupHistoryAfterUpsertMu sync.Mutex
+var lookupHistoryAfterUpsertHooks []LookupHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LookupHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range lookupHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LookupHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range lookupHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LookupHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range lookupHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LookupHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range lookupHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LookupHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range lookupHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LookupHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range lookupHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LookupHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range lookupHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LookupHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range lookupHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LookupHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range lookupHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLookupHistoryHook registers your hook function for all future operations.
+func AddLookupHistoryHook(hookPoint boil.HookPoint, lookupHistoryHook LookupHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		lookupHistoryAfterSelectMu.Lock()
+		lookupHistoryAfterSelectHooks = append(lookupHistoryAfterSelectHooks, lookupHistoryHook)
+		lookupHistoryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		lookupHistoryBeforeInsertMu.Lock()
+		lookupHistoryBeforeInsertHooks = append(lookupHistoryBeforeInsertHooks, lookupHistoryHook)
+		lookupHistoryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		lookupHistoryAfterInsertMu.Lock()
+		lookupHistoryAfterInsertHooks = append(lookupHistoryAfterInsertHooks, lookupHistoryHook)
+		lookupHistoryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		lookupHistoryBeforeUpdateMu.Lock()
+		lookupHistoryBeforeUpdateHooks = append(lookupHistoryBeforeUpdateHooks, lookupHistoryHook)
+		lookupHistoryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		lookupHistoryAfterUpdateMu.Lock()
+		lookupHistoryAfterUpdateHooks = append(lookupHistoryAfterUpdateHooks, lookupHistoryHook)
+		lookupHistoryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		lookupHistoryBeforeDeleteMu.Lock()
+		lookupHistoryBeforeDeleteHooks = append(lookupHistoryBeforeDeleteHooks, lookupHistoryHook)
+		lookupHistoryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		lookupHistoryAfterDeleteMu.Lock()
+		lookupHistoryAfterDeleteHooks = append(lookupHistoryAfterDeleteHooks, lookupHistoryHook)
+		lookupHistoryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		lookupHistoryBeforeUpsertMu.Lock()
+		lookupHistoryBeforeUpsertHooks = append(lookupHistoryBeforeUpsertHooks, lookupHistoryHook)
+		lookupHistoryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		lookupHistoryAfterUpsertMu.Lock()
+		lookupHistoryAfterUpsertHooks = append(lookupHistoryAfterUpsertHooks, lookupHistoryHook)
+		lookupHistoryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single lookupHistory record from the query.
+func (q lookupHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LookupHistory, error) {
+	o := &LookupHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for lookup_histories")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all LookupHistory records from the query.
+func (q lookupHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (LookupHistorySlice, error) {
+	var o []*LookupHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to LookupHistory slice")
+	}
+
+	if len(lookupHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all LookupHistory records in the query.
+func (q lookupHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count lookup_histories rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q lookupHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if lookup_histories exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *LookupHistory) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (lookupHistoryL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLookupHistory interface{}, mods queries.Applicator) error {
+	var slice []*LookupHistory
+	var object *LookupHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybeLookupHistory.(*LookupHistory)
+		if !ok {
+			object = new(LookupHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLookupHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLookupHistory))
+			}
+		}
+	} else {
+		s, ok := maybeLookupHistory.(*[]*LookupHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLookupHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLookupHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &lookupHistoryR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &lookupHistoryR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.LookupHistories = append(foreign.R.LookupHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.LookupHistories = append(foreign.R.LookupHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the lookupHistory to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.LookupHistories.
+func (o *LookupHistory) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"lookup_histories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, lookupHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &lookupHistoryR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			LookupHistories: LookupHistorySlice{o},
+		}
+	} else {
+		related.R.LookupHistories = append(related.R.LookupHistories, o)
+	}
+
+	return nil
+}
+
+// LookupHistories retrieves all the records using an executor.
+func LookupHistories(mods ...qm.QueryMod) lookupHistoryQuery {
+	mods = append(mods, qm.From("\"lookup_histories\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"lookup_histories\".*"})
+	}
+
+	return lookupHistoryQuery{q}
+}
+
+// FindLookupHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLookupHistory(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*LookupHistory, error) {
+	lookupHistoryObj := &LookupHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"lookup_histories\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, lookupHistoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from lookup_histories")
+	}
+
+	if err = lookupHistoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return lookupHistoryObj, err
+	}
+
+	return lookupHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LookupHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no lookup_histories provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(lookupHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	lookupHistoryInsertCacheMut.RLock()
+	cache, cached := lookupHistoryInsertCache[key]
+	lookupHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			lookupHistoryAllColumns,
+			lookupHistoryColumnsWithDefault,
+			lookupHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(lookupHistoryType, lookupHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(lookupHistoryType, lookupHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"lookup_histories\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"lookup_histories\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into lookup_histories")
+	}
+
+	if !cached {
+		lookupHistoryInsertCacheMut.Lock()
+		lookupHistoryInsertCache[key] = cache
+		lookupHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the LookupHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LookupHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	lookupHistoryUpdateCacheMut.RLock()
+	cache, cached := lookupHistoryUpdateCache[key]
+	lookupHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			lookupHistoryAllColumns,
+			lookupHistoryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update lookup_histories, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"lookup_histories\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, lookupHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(lookupHistoryType, lookupHistoryMapping, append(wl, lookupHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update lookup_histories row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for lookup_histories")
+	}
+
+	if !cached {
+		lookupHistoryUpdateCacheMut.Lock()
+		lookupHistoryUpdateCache[key] = cache
+		lookupHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q lookupHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for lookup_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for lookup_histories")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LookupHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), lookupHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"lookup_histories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, lookupHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in lookupHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all lookupHistory")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LookupHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no lookup_histories provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(lookupHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	lookupHistoryUpsertCacheMut.RLock()
+	cache, cached := lookupHistoryUpsertCache[key]
+	lookupHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			lookupHistoryAllColumns,
+			lookupHistoryColumnsWithDefault,
+			lookupHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			lookupHistoryAllColumns,
+			lookupHistoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert lookup_histories, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(lookupHistoryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(lookupHistoryPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert lookup_histories, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(lookupHistoryPrimaryKeyColumns))
+			copy(conflict, lookupHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"lookup_histories\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(lookupHistoryType, lookupHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(lookupHistoryType, lookupHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert lookup_histories")
+	}
+
+	if !cached {
+		lookupHistoryUpsertCacheMut.Lock()
+		lookupHistoryUpsertCache[key] = cache
+		lookupHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single LookupHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LookupHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no LookupHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), lookupHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"lookup_histories\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from lookup_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for lookup_histories")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q lookupHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no lookupHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from lookup_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for lookup_histories")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LookupHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(lookupHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), lookupHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"lookup_histories\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, lookupHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from lookupHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for lookup_histories")
+	}
+
+	if len(lookupHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LookupHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLookupHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LookupHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LookupHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), lookupHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"lookup_histories\".* FROM \"lookup_histories\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, lookupHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in LookupHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LookupHistoryExists checks if the LookupHistory row exists.
+func LookupHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"lookup_histories\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if lookup_histories exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the LookupHistory row exists.
+func (o *LookupHistory) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LookupHistoryExists(ctx, exec, o.ID)
+}
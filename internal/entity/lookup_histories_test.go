@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLookupHistories(t *testing.T) {
+	t.Parallel()
+
+	query := LookupHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLookupHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LookupHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLookupHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := LookupHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LookupHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLookupHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LookupHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LookupHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLookupHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LookupHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if LookupHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LookupHistoryExists to return true, but got false.")
+	}
+}
+
+func testLookupHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	lookupHistoryFound, err := FindLookupHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if lookupHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLookupHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = LookupHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLookupHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := LookupHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLookupHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	lookupHistoryOne := &LookupHistory{}
+	lookupHistoryTwo := &LookupHistory{}
+	if err = randomize.Struct(seed, lookupHistoryOne, lookupHistoryDBTypes, false, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, lookupHistoryTwo, lookupHistoryDBTypes, false, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = lookupHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = lookupHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LookupHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLookupHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	lookupHistoryOne := &LookupHistory{}
+	lookupHistoryTwo := &LookupHistory{}
+	if err = randomize.Struct(seed, lookupHistoryOne, lookupHistoryDBTypes, false, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, lookupHistoryTwo, lookupHistoryDBTypes, false, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = lookupHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = lookupHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LookupHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func lookupHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *LookupHistory) error {
+	*o = LookupHistory{}
+	return nil
+}
+
+func lookupHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *LookupHistory) error {
+	*o = LookupHistory{}
+	return nil
+}
+
+func lookupHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *LookupHistory) error {
+	*o = LookupHistory{}
+	return nil
+}
+
+func lookupHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LookupHistory) error {
+	*o = LookupHistory{}
+	return nil
+}
+
+func lookupHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LookupHistory) error {
+	*o = LookupHistory{}
+	return nil
+}
+
+func lookupHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LookupHistory) error {
+	*o = LookupHistory{}
+	return nil
+}
+
+func lookupHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LookupHistory) error {
+	*o = LookupHistory{}
+	return nil
+}
+
+func lookupHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LookupHistory) error {
+	*o = LookupHistory{}
+	return nil
+}
+
+func lookupHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LookupHistory) error {
+	*o = LookupHistory{}
+	return nil
+}
+
+func testLookupHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &LookupHistory{}
+	o := &LookupHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize LookupHistory object: %s", err)
+	}
+
+	AddLookupHistoryHook(boil.BeforeInsertHook, lookupHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	lookupHistoryBeforeInsertHooks = []LookupHistoryHook{}
+
+	AddLookupHistoryHook(boil.AfterInsertHook, lookupHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	lookupHistoryAfterInsertHooks = []LookupHistoryHook{}
+
+	AddLookupHistoryHook(boil.AfterSelectHook, lookupHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	lookupHistoryAfterSelectHooks = []LookupHistoryHook{}
+
+	AddLookupHistoryHook(boil.BeforeUpdateHook, lookupHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	lookupHistoryBeforeUpdateHooks = []LookupHistoryHook{}
+
+	AddLookupHistoryHook(boil.AfterUpdateHook, lookupHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	lookupHistoryAfterUpdateHooks = []LookupHistoryHook{}
+
+	AddLookupHistoryHook(boil.BeforeDeleteHook, lookupHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	lookupHistoryBeforeDeleteHooks = []LookupHistoryHook{}
+
+	AddLookupHistoryHook(boil.AfterDeleteHook, lookupHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	lookupHistoryAfterDeleteHooks = []LookupHistoryHook{}
+
+	AddLookupHistoryHook(boil.BeforeUpsertHook, lookupHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	lookupHistoryBeforeUpsertHooks = []LookupHistoryHook{}
+
+	AddLookupHistoryHook(boil.AfterUpsertHook, lookupHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	lookupHistoryAfterUpsertHooks = []LookupHistoryHook{}
+}
+
+func testLookupHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LookupHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLookupHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(lookupHistoryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LookupHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLookupHistoryToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local LookupHistory
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, lookupHistoryDBTypes, false, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := LookupHistorySlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*LookupHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testLookupHistoryToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LookupHistory
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, lookupHistoryDBTypes, false, strmangle.SetComplement(lookupHistoryPrimaryKeyColumns, lookupHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.LookupHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testLookupHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLookupHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LookupHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLookupHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LookupHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	lookupHistoryDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `Kind`: `character varying`, `Query`: `text`, `NativeLanguage`: `character varying`, `Result`: `text`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                    = bytes.MinRead
+)
+
+func testLookupHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(lookupHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(lookupHistoryAllColumns) == len(lookupHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LookupHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLookupHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(lookupHistoryAllColumns) == len(lookupHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LookupHistory{}
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LookupHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, lookupHistoryDBTypes, true, lookupHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(lookupHistoryAllColumns, lookupHistoryPrimaryKeyColumns) {
+		fields = lookupHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			lookupHistoryAllColumns,
+			lookupHistoryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LookupHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testLookupHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(lookupHistoryAllColumns) == len(lookupHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := LookupHistory{}
+	if err = randomize.Struct(seed, &o, lookupHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LookupHistory: %s", err)
+	}
+
+	count, err := LookupHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, lookupHistoryDBTypes, false, lookupHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LookupHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LookupHistory: %s", err)
+	}
+
+	count, err = LookupHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -6,11 +6,31 @@ package entity
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("DeckEntries", testDeckEntriesUpsert)
+
+	t.Run("Decks", testDecksUpsert)
+
+	t.Run("ExperimentResponses", testExperimentResponsesUpsert)
+
+	t.Run("GeneratedContents", testGeneratedContentsUpsert)
+
 	t.Run("GooseDBVersions", testGooseDBVersionsUpsert)
 
+	t.Run("ImportJobs", testImportJobsUpsert)
+
+	t.Run("LookupHistories", testLookupHistoriesUpsert)
+
 	t.Run("PaymentTransactions", testPaymentTransactionsUpsert)
 
+	t.Run("Quizzes", testQuizzesUpsert)
+
+	t.Run("ReviewCards", testReviewCardsUpsert)
+
+	t.Run("ReviewLogs", testReviewLogsUpsert)
+
 	t.Run("Subscriptions", testSubscriptionsUpsert)
 
+	t.Run("TokenUsages", testTokenUsagesUpsert)
+
 	t.Run("Users", testUsersUpsert)
 }
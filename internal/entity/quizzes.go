@@ -0,0 +1,1236 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Quiz is an object representing the database table.
+type Quiz struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID         string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	NativeLanguage string      `boil:"native_language" json:"native_language" toml:"native_language" yaml:"native_language"`
+	Questions      string      `boil:"questions" json:"questions" toml:"questions" yaml:"questions"`
+	QuestionCount  int         `boil:"question_count" json:"question_count" toml:"question_count" yaml:"question_count"`
+	Answers        null.String `boil:"answers" json:"answers,omitempty" toml:"answers" yaml:"answers,omitempty"`
+	CorrectCount   null.Int    `boil:"correct_count" json:"correct_count,omitempty" toml:"correct_count" yaml:"correct_count,omitempty"`
+	SubmittedAt    null.Time   `boil:"submitted_at" json:"submitted_at,omitempty" toml:"submitted_at" yaml:"submitted_at,omitempty"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *quizR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L quizL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var QuizColumns = struct {
+	ID             string
+	UserID         string
+	NativeLanguage string
+	Questions      string
+	QuestionCount  string
+	Answers        string
+	CorrectCount   string
+	SubmittedAt    string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	UserID:         "user_id",
+	NativeLanguage: "native_language",
+	Questions:      "questions",
+	QuestionCount:  "question_count",
+	Answers:        "answers",
+	CorrectCount:   "correct_count",
+	SubmittedAt:    "submitted_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var QuizTableColumns = struct {
+	ID             string
+	UserID         string
+	NativeLanguage string
+	Questions      string
+	QuestionCount  string
+	Answers        string
+	CorrectCount   string
+	SubmittedAt    string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "quizzes.id",
+	UserID:         "quizzes.user_id",
+	NativeLanguage: "quizzes.native_language",
+	Questions:      "quizzes.questions",
+	QuestionCount:  "quizzes.question_count",
+	Answers:        "quizzes.answers",
+	CorrectCount:   "quizzes.correct_count",
+	SubmittedAt:    "quizzes.submitted_at",
+	CreatedAt:      "quizzes.created_at",
+	UpdatedAt:      "quizzes.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var QuizWhere = struct {
+	ID             whereHelperstring
+	UserID         whereHelperstring
+	NativeLanguage whereHelperstring
+	Questions      whereHelperstring
+	QuestionCount  whereHelperint
+	Answers        whereHelpernull_String
+	CorrectCount   whereHelpernull_Int
+	SubmittedAt    whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"quizzes\".\"id\""},
+	UserID:         whereHelperstring{field: "\"quizzes\".\"user_id\""},
+	NativeLanguage: whereHelperstring{field: "\"quizzes\".\"native_language\""},
+	Questions:      whereHelperstring{field: "\"quizzes\".\"questions\""},
+	QuestionCount:  whereHelperint{field: "\"quizzes\".\"question_count\""},
+	Answers:        whereHelpernull_String{field: "\"quizzes\".\"answers\""},
+	CorrectCount:   whereHelpernull_Int{field: "\"quizzes\".\"correct_count\""},
+	SubmittedAt:    whereHelpernull_Time{field: "\"quizzes\".\"submitted_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"quizzes\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"quizzes\".\"updated_at\""},
+}
+
+// QuizRels is where relationship names are stored.
+var QuizRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// quizR is where relationships are stored.
+type quizR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*quizR) NewStruct() *quizR {
+	return &quizR{}
+}
+
+func (r *quizR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// quizL is where Load methods for each relationship are stored.
+type quizL struct{}
+
+var (
+	quizAllColumns            = []string{"id", "user_id", "native_language", "questions", "question_count", "answers", "correct_count", "submitted_at", "created_at", "updated_at"}
+	quizColumnsWithoutDefault = []string{"user_id", "native_language", "questions", "question_count"}
+	quizColumnsWithDefault    = []string{"id", "answers", "correct_count", "submitted_at", "created_at", "updated_at"}
+	quizPrimaryKeyColumns     = []string{"id"}
+	quizGeneratedColumns      = []string{}
+)
+
+type (
+	// QuizSlice is an alias for a slice of pointers to Quiz.
+	// This should almost always be used instead of []Quiz.
+	QuizSlice []*Quiz
+	// QuizHook is the signature for custom Quiz hook methods
+	QuizHook func(context.Context, boil.ContextExecutor, *Quiz) error
+
+	quizQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	quizType                 = reflect.TypeOf(&Quiz{})
+	quizMapping              = queries.MakeStructMapping(quizType)
+	quizPrimaryKeyMapping, _ = queries.BindMapping(quizType, quizMapping, quizPrimaryKeyColumns)
+	quizInsertCacheMut       sync.RWMutex
+	quizInsertCache          = make(map[string]insertCache)
+	quizUpdateCacheMut       sync.RWMutex
+	quizUpdateCache          = make(map[string]updateCache)
+	quizUpsertCacheMut       sync.RWMutex
+	quizUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var quizAfterSelectMu sync.Mutex
+var quizAfterSelectHooks []QuizHook
+
+var quizBeforeInsertMu sync.Mutex
+var quizBeforeInsertHooks []QuizHook
+var quizAfterInsertMu sync.Mutex
+var quizAfterInsertHooks []QuizHook
+
+var quizBeforeUpdateMu sync.Mutex
+var quizBeforeUpdateHooks []QuizHook
+var quizAfterUpdateMu sync.Mutex
+var quizAfterUpdateHooks []QuizHook
+
+var quizBeforeDeleteMu sync.Mutex
+var quizBeforeDeleteHooks []QuizHook
+var quizAfterDeleteMu sync.Mutex
+var quizAfterDeleteHooks []QuizHook
+
+var quizBeforeUpsertMu sync.Mutex
+var quizBeforeUpsertHooks []QuizHook
+var quizAfterUpsertMu sync.Mutex
+var quizAfterUpsertHooks []QuizHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Quiz) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range quizAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Quiz) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range quizBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Quiz) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range quizAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Quiz) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range quizBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Quiz) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range quizAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Quiz) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range quizBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Quiz) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range quizAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Quiz) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range quizBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Quiz) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range quizAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddQuizHook registers your hook function for all future operations.
+func AddQuizHook(hookPoint boil.HookPoint, quizHook QuizHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		quizAfterSelectMu.Lock()
+		quizAfterSelectHooks = append(quizAfterSelectHooks, quizHook)
+		quizAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		quizBeforeInsertMu.Lock()
+		quizBeforeInsertHooks = append(quizBeforeInsertHooks, quizHook)
+		quizBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		quizAfterInsertMu.Lock()
+		quizAfterInsertHooks = append(quizAfterInsertHooks, quizHook)
+		quizAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		quizBeforeUpdateMu.Lock()
+		quizBeforeUpdateHooks = append(quizBeforeUpdateHooks, quizHook)
+		quizBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		quizAfterUpdateMu.Lock()
+		quizAfterUpdateHooks = append(quizAfterUpdateHooks, quizHook)
+		quizAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		quizBeforeDeleteMu.Lock()
+		quizBeforeDeleteHooks = append(quizBeforeDeleteHooks, quizHook)
+		quizBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		quizAfterDeleteMu.Lock()
+		quizAfterDeleteHooks = append(quizAfterDeleteHooks, quizHook)
+		quizAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		quizBeforeUpsertMu.Lock()
+		quizBeforeUpsertHooks = append(quizBeforeUpsertHooks, quizHook)
+		quizBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		quizAfterUpsertMu.Lock()
+		quizAfterUpsertHooks = append(quizAfterUpsertHooks, quizHook)
+		quizAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single quiz record from the query.
+func (q quizQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Quiz, error) {
+	o := &Quiz{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for quizzes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Quiz records from the query.
+func (q quizQuery) All(ctx context.Context, exec boil.ContextExecutor) (QuizSlice, error) {
+	var o []*Quiz
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to Quiz slice")
+	}
+
+	if len(quizAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Quiz records in the query.
+func (q quizQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count quizzes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q quizQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if quizzes exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *Quiz) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (quizL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeQuiz interface{}, mods queries.Applicator) error {
+	var slice []*Quiz
+	var object *Quiz
+
+	if singular {
+		var ok bool
+		object, ok = maybeQuiz.(*Quiz)
+		if !ok {
+			object = new(Quiz)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeQuiz)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeQuiz))
+			}
+		}
+	} else {
+		s, ok := maybeQuiz.(*[]*Quiz)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeQuiz)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeQuiz))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &quizR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &quizR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Quizzes = append(foreign.R.Quizzes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Quizzes = append(foreign.R.Quizzes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the quiz to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Quizzes.
+func (o *Quiz) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"quizzes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, quizPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &quizR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Quizzes: QuizSlice{o},
+		}
+	} else {
+		related.R.Quizzes = append(related.R.Quizzes, o)
+	}
+
+	return nil
+}
+
+// Quizzes retrieves all the records using an executor.
+func Quizzes(mods ...qm.QueryMod) quizQuery {
+	mods = append(mods, qm.From("\"quizzes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"quizzes\".*"})
+	}
+
+	return quizQuery{q}
+}
+
+// FindQuiz retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindQuiz(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Quiz, error) {
+	quizObj := &Quiz{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"quizzes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, quizObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from quizzes")
+	}
+
+	if err = quizObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return quizObj, err
+	}
+
+	return quizObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Quiz) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no quizzes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(quizColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	quizInsertCacheMut.RLock()
+	cache, cached := quizInsertCache[key]
+	quizInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			quizAllColumns,
+			quizColumnsWithDefault,
+			quizColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(quizType, quizMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(quizType, quizMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"quizzes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"quizzes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into quizzes")
+	}
+
+	if !cached {
+		quizInsertCacheMut.Lock()
+		quizInsertCache[key] = cache
+		quizInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Quiz.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Quiz) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	quizUpdateCacheMut.RLock()
+	cache, cached := quizUpdateCache[key]
+	quizUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			quizAllColumns,
+			quizPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update quizzes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"quizzes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, quizPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(quizType, quizMapping, append(wl, quizPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update quizzes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for quizzes")
+	}
+
+	if !cached {
+		quizUpdateCacheMut.Lock()
+		quizUpdateCache[key] = cache
+		quizUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q quizQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for quizzes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for quizzes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o QuizSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), quizPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"quizzes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, quizPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in quiz slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all quiz")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Quiz) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no quizzes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(quizColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	quizUpsertCacheMut.RLock()
+	cache, cached := quizUpsertCache[key]
+	quizUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			quizAllColumns,
+			quizColumnsWithDefault,
+			quizColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			quizAllColumns,
+			quizPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert quizzes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(quizAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(quizPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert quizzes, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(quizPrimaryKeyColumns))
+			copy(conflict, quizPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"quizzes\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(quizType, quizMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(quizType, quizMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert quizzes")
+	}
+
+	if !cached {
+		quizUpsertCacheMut.Lock()
+		quizUpsertCache[key] = cache
+		quizUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Quiz record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Quiz) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no Quiz provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), quizPrimaryKeyMapping)
+	sql := "DELETE FROM \"quizzes\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from quizzes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for quizzes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q quizQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no quizQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from quizzes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for quizzes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o QuizSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(quizBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), quizPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"quizzes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, quizPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from quiz slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for quizzes")
+	}
+
+	if len(quizAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Quiz) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindQuiz(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *QuizSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := QuizSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), quizPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"quizzes\".* FROM \"quizzes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, quizPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in QuizSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// QuizExists checks if the Quiz row exists.
+func QuizExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"quizzes\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if quizzes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Quiz row exists.
+func (o *Quiz) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return QuizExists(ctx, exec, o.ID)
+}
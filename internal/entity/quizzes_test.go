@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testQuizzes(t *testing.T) {
+	t.Parallel()
+
+	query := Quizzes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testQuizzesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Quizzes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testQuizzesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Quizzes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Quizzes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testQuizzesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := QuizSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Quizzes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testQuizzesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := QuizExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Quiz exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected QuizExists to return true, but got false.")
+	}
+}
+
+func testQuizzesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	quizFound, err := FindQuiz(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if quizFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testQuizzesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Quizzes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testQuizzesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Quizzes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testQuizzesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	quizOne := &Quiz{}
+	quizTwo := &Quiz{}
+	if err = randomize.Struct(seed, quizOne, quizDBTypes, false, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+	if err = randomize.Struct(seed, quizTwo, quizDBTypes, false, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = quizOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = quizTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Quizzes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testQuizzesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	quizOne := &Quiz{}
+	quizTwo := &Quiz{}
+	if err = randomize.Struct(seed, quizOne, quizDBTypes, false, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+	if err = randomize.Struct(seed, quizTwo, quizDBTypes, false, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = quizOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = quizTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Quizzes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func quizBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Quiz) error {
+	*o = Quiz{}
+	return nil
+}
+
+func quizAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Quiz) error {
+	*o = Quiz{}
+	return nil
+}
+
+func quizAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Quiz) error {
+	*o = Quiz{}
+	return nil
+}
+
+func quizBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Quiz) error {
+	*o = Quiz{}
+	return nil
+}
+
+func quizAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Quiz) error {
+	*o = Quiz{}
+	return nil
+}
+
+func quizBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Quiz) error {
+	*o = Quiz{}
+	return nil
+}
+
+func quizAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Quiz) error {
+	*o = Quiz{}
+	return nil
+}
+
+func quizBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Quiz) error {
+	*o = Quiz{}
+	return nil
+}
+
+func quizAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Quiz) error {
+	*o = Quiz{}
+	return nil
+}
+
+func testQuizzesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Quiz{}
+	o := &Quiz{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, quizDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Quiz object: %s", err)
+	}
+
+	AddQuizHook(boil.BeforeInsertHook, quizBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	quizBeforeInsertHooks = []QuizHook{}
+
+	AddQuizHook(boil.AfterInsertHook, quizAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	quizAfterInsertHooks = []QuizHook{}
+
+	AddQuizHook(boil.AfterSelectHook, quizAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	quizAfterSelectHooks = []QuizHook{}
+
+	AddQuizHook(boil.BeforeUpdateHook, quizBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	quizBeforeUpdateHooks = []QuizHook{}
+
+	AddQuizHook(boil.AfterUpdateHook, quizAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	quizAfterUpdateHooks = []QuizHook{}
+
+	AddQuizHook(boil.BeforeDeleteHook, quizBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	quizBeforeDeleteHooks = []QuizHook{}
+
+	AddQuizHook(boil.AfterDeleteHook, quizAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	quizAfterDeleteHooks = []QuizHook{}
+
+	AddQuizHook(boil.BeforeUpsertHook, quizBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	quizBeforeUpsertHooks = []QuizHook{}
+
+	AddQuizHook(boil.AfterUpsertHook, quizAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	quizAfterUpsertHooks = []QuizHook{}
+}
+
+func testQuizzesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Quizzes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testQuizzesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(quizColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Quizzes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testQuizToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Quiz
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, quizDBTypes, false, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := QuizSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*Quiz)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testQuizToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Quiz
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, quizDBTypes, false, strmangle.SetComplement(quizPrimaryKeyColumns, quizColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Quizzes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testQuizzesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testQuizzesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := QuizSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testQuizzesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Quizzes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	quizDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `NativeLanguage`: `character varying`, `Questions`: `text`, `QuestionCount`: `integer`, `Answers`: `text`, `CorrectCount`: `integer`, `SubmittedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_           = bytes.MinRead
+)
+
+func testQuizzesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(quizPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(quizAllColumns) == len(quizPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Quizzes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testQuizzesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(quizAllColumns) == len(quizPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Quiz{}
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Quizzes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, quizDBTypes, true, quizPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(quizAllColumns, quizPrimaryKeyColumns) {
+		fields = quizAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			quizAllColumns,
+			quizPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := QuizSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testQuizzesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(quizAllColumns) == len(quizPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Quiz{}
+	if err = randomize.Struct(seed, &o, quizDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Quiz: %s", err)
+	}
+
+	count, err := Quizzes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, quizDBTypes, false, quizPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Quiz struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Quiz: %s", err)
+	}
+
+	count, err = Quizzes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
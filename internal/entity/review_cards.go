@@ -0,0 +1,1240 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReviewCard is an object representing the database table.
+type ReviewCard struct {
+	DeckEntryID    string    `boil:"deck_entry_id" json:"deck_entry_id" toml:"deck_entry_id" yaml:"deck_entry_id"`
+	Scheduler      string    `boil:"scheduler" json:"scheduler" toml:"scheduler" yaml:"scheduler"`
+	Repetitions    int       `boil:"repetitions" json:"repetitions" toml:"repetitions" yaml:"repetitions"`
+	Lapses         int       `boil:"lapses" json:"lapses" toml:"lapses" yaml:"lapses"`
+	EaseFactor     float64   `boil:"ease_factor" json:"ease_factor" toml:"ease_factor" yaml:"ease_factor"`
+	Stability      float64   `boil:"stability" json:"stability" toml:"stability" yaml:"stability"`
+	Difficulty     float64   `boil:"difficulty" json:"difficulty" toml:"difficulty" yaml:"difficulty"`
+	IntervalDays   int       `boil:"interval_days" json:"interval_days" toml:"interval_days" yaml:"interval_days"`
+	DueAt          time.Time `boil:"due_at" json:"due_at" toml:"due_at" yaml:"due_at"`
+	LastReviewedAt time.Time `boil:"last_reviewed_at" json:"last_reviewed_at" toml:"last_reviewed_at" yaml:"last_reviewed_at"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *reviewCardR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reviewCardL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReviewCardColumns = struct {
+	DeckEntryID    string
+	Scheduler      string
+	Repetitions    string
+	Lapses         string
+	EaseFactor     string
+	Stability      string
+	Difficulty     string
+	IntervalDays   string
+	DueAt          string
+	LastReviewedAt string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	DeckEntryID:    "deck_entry_id",
+	Scheduler:      "scheduler",
+	Repetitions:    "repetitions",
+	Lapses:         "lapses",
+	EaseFactor:     "ease_factor",
+	Stability:      "stability",
+	Difficulty:     "difficulty",
+	IntervalDays:   "interval_days",
+	DueAt:          "due_at",
+	LastReviewedAt: "last_reviewed_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var ReviewCardTableColumns = struct {
+	DeckEntryID    string
+	Scheduler      string
+	Repetitions    string
+	Lapses         string
+	EaseFactor     string
+	Stability      string
+	Difficulty     string
+	IntervalDays   string
+	DueAt          string
+	LastReviewedAt string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	DeckEntryID:    "review_cards.deck_entry_id",
+	Scheduler:      "review_cards.scheduler",
+	Repetitions:    "review_cards.repetitions",
+	Lapses:         "review_cards.lapses",
+	EaseFactor:     "review_cards.ease_factor",
+	Stability:      "review_cards.stability",
+	Difficulty:     "review_cards.difficulty",
+	IntervalDays:   "review_cards.interval_days",
+	DueAt:          "review_cards.due_at",
+	LastReviewedAt: "review_cards.last_reviewed_at",
+	CreatedAt:      "review_cards.created_at",
+	UpdatedAt:      "review_cards.updated_at",
+}
+
+// Generated where
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ReviewCardWhere = struct {
+	DeckEntryID    whereHelperstring
+	Scheduler      whereHelperstring
+	Repetitions    whereHelperint
+	Lapses         whereHelperint
+	EaseFactor     whereHelperfloat64
+	Stability      whereHelperfloat64
+	Difficulty     whereHelperfloat64
+	IntervalDays   whereHelperint
+	DueAt          whereHelpertime_Time
+	LastReviewedAt whereHelpertime_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	DeckEntryID:    whereHelperstring{field: "\"review_cards\".\"deck_entry_id\""},
+	Scheduler:      whereHelperstring{field: "\"review_cards\".\"scheduler\""},
+	Repetitions:    whereHelperint{field: "\"review_cards\".\"repetitions\""},
+	Lapses:         whereHelperint{field: "\"review_cards\".\"lapses\""},
+	EaseFactor:     whereHelperfloat64{field: "\"review_cards\".\"ease_factor\""},
+	Stability:      whereHelperfloat64{field: "\"review_cards\".\"stability\""},
+	Difficulty:     whereHelperfloat64{field: "\"review_cards\".\"difficulty\""},
+	IntervalDays:   whereHelperint{field: "\"review_cards\".\"interval_days\""},
+	DueAt:          whereHelpertime_Time{field: "\"review_cards\".\"due_at\""},
+	LastReviewedAt: whereHelpertime_Time{field: "\"review_cards\".\"last_reviewed_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"review_cards\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"review_cards\".\"updated_at\""},
+}
+
+// ReviewCardRels is where relationship names are stored.
+var ReviewCardRels = struct {
+	DeckEntry string
+}{
+	DeckEntry: "DeckEntry",
+}
+
+// reviewCardR is where relationships are stored.
+type reviewCardR struct {
+	DeckEntry *DeckEntry `boil:"DeckEntry" json:"DeckEntry" toml:"DeckEntry" yaml:"DeckEntry"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reviewCardR) NewStruct() *reviewCardR {
+	return &reviewCardR{}
+}
+
+func (r *reviewCardR) GetDeckEntry() *DeckEntry {
+	if r == nil {
+		return nil
+	}
+	return r.DeckEntry
+}
+
+// reviewCardL is where Load methods for each relationship are stored.
+type reviewCardL struct{}
+
+var (
+	reviewCardAllColumns            = []string{"deck_entry_id", "scheduler", "repetitions", "lapses", "ease_factor", "stability", "difficulty", "interval_days", "due_at", "last_reviewed_at", "created_at", "updated_at"}
+	reviewCardColumnsWithoutDefault = []string{"deck_entry_id", "scheduler", "repetitions", "lapses", "ease_factor", "stability", "difficulty", "interval_days", "due_at", "last_reviewed_at"}
+	reviewCardColumnsWithDefault    = []string{"created_at", "updated_at"}
+	reviewCardPrimaryKeyColumns     = []string{"deck_entry_id"}
+	reviewCardGeneratedColumns      = []string{}
+)
+
+type (
+	// ReviewCardSlice is an alias for a slice of pointers to ReviewCard.
+	// This should almost always be used instead of []ReviewCard.
+	ReviewCardSlice []*ReviewCard
+	// ReviewCardHook is the signature for custom ReviewCard hook methods
+	ReviewCardHook func(context.Context, boil.ContextExecutor, *ReviewCard) error
+
+	reviewCardQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reviewCardType                 = reflect.TypeOf(&ReviewCard{})
+	reviewCardMapping              = queries.MakeStructMapping(reviewCardType)
+	reviewCardPrimaryKeyMapping, _ = queries.BindMapping(reviewCardType, reviewCardMapping, reviewCardPrimaryKeyColumns)
+	reviewCardInsertCacheMut       sync.RWMutex
+	reviewCardInsertCache          = make(map[string]insertCache)
+	reviewCardUpdateCacheMut       sync.RWMutex
+	reviewCardUpdateCache          = make(map[string]updateCache)
+	reviewCardUpsertCacheMut       sync.RWMutex
+	reviewCardUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reviewCardAfterSelectMu sync.Mutex
+var reviewCardAfterSelectHooks []ReviewCardHook
+
+var reviewCardBeforeInsertMu sync.Mutex
+var reviewCardBeforeInsertHooks []ReviewCardHook
+var reviewCardAfterInsertMu sync.Mutex
+var reviewCardAfterInsertHooks []ReviewCardHook
+
+var reviewCardBeforeUpdateMu sync.Mutex
+var reviewCardBeforeUpdateHooks []ReviewCardHook
+var reviewCardAfterUpdateMu sync.Mutex
+var reviewCardAfterUpdateHooks []ReviewCardHook
+
+var reviewCardBeforeDeleteMu sync.Mutex
+var reviewCardBeforeDeleteHooks []ReviewCardHook
+var reviewCardAfterDeleteMu sync.Mutex
+var reviewCardAfterDeleteHooks []ReviewCardHook
+
+var reviewCardBeforeUpsertMu sync.Mutex
+var reviewCardBeforeUpsertHooks []ReviewCardHook
+var reviewCardAfterUpsertMu sync.Mutex
+var reviewCardAfterUpsertHooks []ReviewCardHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReviewCard) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewCardAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReviewCard) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewCardBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReviewCard) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewCardAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReviewCard) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewCardBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReviewCard) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewCardAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReviewCard) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewCardBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReviewCard) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewCardAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReviewCard) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewCardBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReviewCard) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewCardAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReviewCardHook registers your hook function for all future operations.
+func AddReviewCardHook(hookPoint boil.HookPoint, reviewCardHook ReviewCardHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		reviewCardAfterSelectMu.Lock()
+		reviewCardAfterSelectHooks = append(reviewCardAfterSelectHooks, reviewCardHook)
+		reviewCardAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		reviewCardBeforeInsertMu.Lock()
+		reviewCardBeforeInsertHooks = append(reviewCardBeforeInsertHooks, reviewCardHook)
+		reviewCardBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		reviewCardAfterInsertMu.Lock()
+		reviewCardAfterInsertHooks = append(reviewCardAfterInsertHooks, reviewCardHook)
+		reviewCardAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		reviewCardBeforeUpdateMu.Lock()
+		reviewCardBeforeUpdateHooks = append(reviewCardBeforeUpdateHooks, reviewCardHook)
+		reviewCardBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		reviewCardAfterUpdateMu.Lock()
+		reviewCardAfterUpdateHooks = append(reviewCardAfterUpdateHooks, reviewCardHook)
+		reviewCardAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		reviewCardBeforeDeleteMu.Lock()
+		reviewCardBeforeDeleteHooks = append(reviewCardBeforeDeleteHooks, reviewCardHook)
+		reviewCardBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		reviewCardAfterDeleteMu.Lock()
+		reviewCardAfterDeleteHooks = append(reviewCardAfterDeleteHooks, reviewCardHook)
+		reviewCardAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		reviewCardBeforeUpsertMu.Lock()
+		reviewCardBeforeUpsertHooks = append(reviewCardBeforeUpsertHooks, reviewCardHook)
+		reviewCardBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		reviewCardAfterUpsertMu.Lock()
+		reviewCardAfterUpsertHooks = append(reviewCardAfterUpsertHooks, reviewCardHook)
+		reviewCardAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single reviewCard record from the query.
+func (q reviewCardQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReviewCard, error) {
+	o := &ReviewCard{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for review_cards")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ReviewCard records from the query.
+func (q reviewCardQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReviewCardSlice, error) {
+	var o []*ReviewCard
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to ReviewCard slice")
+	}
+
+	if len(reviewCardAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ReviewCard records in the query.
+func (q reviewCardQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count review_cards rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q reviewCardQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if review_cards exists")
+	}
+
+	return count > 0, nil
+}
+
+// DeckEntry pointed to by the foreign key.
+func (o *ReviewCard) DeckEntry(mods ...qm.QueryMod) deckEntryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DeckEntryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DeckEntries(queryMods...)
+}
+
+// LoadDeckEntry allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reviewCardL) LoadDeckEntry(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReviewCard interface{}, mods queries.Applicator) error {
+	var slice []*ReviewCard
+	var object *ReviewCard
+
+	if singular {
+		var ok bool
+		object, ok = maybeReviewCard.(*ReviewCard)
+		if !ok {
+			object = new(ReviewCard)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReviewCard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReviewCard))
+			}
+		}
+	} else {
+		s, ok := maybeReviewCard.(*[]*ReviewCard)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReviewCard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReviewCard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reviewCardR{}
+		}
+		args[object.DeckEntryID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reviewCardR{}
+			}
+
+			args[obj.DeckEntryID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`deck_entries`),
+		qm.WhereIn(`deck_entries.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DeckEntry")
+	}
+
+	var resultSlice []*DeckEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DeckEntry")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for deck_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deck_entries")
+	}
+
+	if len(deckEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DeckEntry = foreign
+		if foreign.R == nil {
+			foreign.R = &deckEntryR{}
+		}
+		foreign.R.ReviewCard = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DeckEntryID == foreign.ID {
+				local.R.DeckEntry = foreign
+				if foreign.R == nil {
+					foreign.R = &deckEntryR{}
+				}
+				foreign.R.ReviewCard = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDeckEntry of the reviewCard to the related item.
+// Sets o.R.DeckEntry to related.
+// Adds o to related.R.ReviewCard.
+func (o *ReviewCard) SetDeckEntry(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DeckEntry) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"review_cards\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"deck_entry_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reviewCardPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.DeckEntryID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DeckEntryID = related.ID
+	if o.R == nil {
+		o.R = &reviewCardR{
+			DeckEntry: related,
+		}
+	} else {
+		o.R.DeckEntry = related
+	}
+
+	if related.R == nil {
+		related.R = &deckEntryR{
+			ReviewCard: o,
+		}
+	} else {
+		related.R.ReviewCard = o
+	}
+
+	return nil
+}
+
+// ReviewCards retrieves all the records using an executor.
+func ReviewCards(mods ...qm.QueryMod) reviewCardQuery {
+	mods = append(mods, qm.From("\"review_cards\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"review_cards\".*"})
+	}
+
+	return reviewCardQuery{q}
+}
+
+// FindReviewCard retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReviewCard(ctx context.Context, exec boil.ContextExecutor, deckEntryID string, selectCols ...string) (*ReviewCard, error) {
+	reviewCardObj := &ReviewCard{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"review_cards\" where \"deck_entry_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, deckEntryID)
+
+	err := q.Bind(ctx, exec, reviewCardObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from review_cards")
+	}
+
+	if err = reviewCardObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return reviewCardObj, err
+	}
+
+	return reviewCardObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReviewCard) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no review_cards provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reviewCardColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reviewCardInsertCacheMut.RLock()
+	cache, cached := reviewCardInsertCache[key]
+	reviewCardInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reviewCardAllColumns,
+			reviewCardColumnsWithDefault,
+			reviewCardColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reviewCardType, reviewCardMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reviewCardType, reviewCardMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"review_cards\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"review_cards\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into review_cards")
+	}
+
+	if !cached {
+		reviewCardInsertCacheMut.Lock()
+		reviewCardInsertCache[key] = cache
+		reviewCardInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ReviewCard.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReviewCard) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reviewCardUpdateCacheMut.RLock()
+	cache, cached := reviewCardUpdateCache[key]
+	reviewCardUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reviewCardAllColumns,
+			reviewCardPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update review_cards, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"review_cards\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reviewCardPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reviewCardType, reviewCardMapping, append(wl, reviewCardPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update review_cards row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for review_cards")
+	}
+
+	if !cached {
+		reviewCardUpdateCacheMut.Lock()
+		reviewCardUpdateCache[key] = cache
+		reviewCardUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reviewCardQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for review_cards")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for review_cards")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReviewCardSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewCardPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"review_cards\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reviewCardPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in reviewCard slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all reviewCard")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReviewCard) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no review_cards provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reviewCardColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reviewCardUpsertCacheMut.RLock()
+	cache, cached := reviewCardUpsertCache[key]
+	reviewCardUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			reviewCardAllColumns,
+			reviewCardColumnsWithDefault,
+			reviewCardColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reviewCardAllColumns,
+			reviewCardPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert review_cards, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(reviewCardAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(reviewCardPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert review_cards, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(reviewCardPrimaryKeyColumns))
+			copy(conflict, reviewCardPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"review_cards\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(reviewCardType, reviewCardMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reviewCardType, reviewCardMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert review_cards")
+	}
+
+	if !cached {
+		reviewCardUpsertCacheMut.Lock()
+		reviewCardUpsertCache[key] = cache
+		reviewCardUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ReviewCard record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReviewCard) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no ReviewCard provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reviewCardPrimaryKeyMapping)
+	sql := "DELETE FROM \"review_cards\" WHERE \"deck_entry_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from review_cards")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for review_cards")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q reviewCardQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no reviewCardQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from review_cards")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for review_cards")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReviewCardSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reviewCardBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewCardPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"review_cards\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reviewCardPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from reviewCard slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for review_cards")
+	}
+
+	if len(reviewCardAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReviewCard) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReviewCard(ctx, exec, o.DeckEntryID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReviewCardSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReviewCardSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewCardPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"review_cards\".* FROM \"review_cards\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reviewCardPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in ReviewCardSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReviewCardExists checks if the ReviewCard row exists.
+func ReviewCardExists(ctx context.Context, exec boil.ContextExecutor, deckEntryID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"review_cards\" where \"deck_entry_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, deckEntryID)
+	}
+	row := exec.QueryRowContext(ctx, sql, deckEntryID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if review_cards exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ReviewCard row exists.
+func (o *ReviewCard) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ReviewCardExists(ctx, exec, o.DeckEntryID)
+}
@@ -0,0 +1,847 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testReviewCards(t *testing.T) {
+	t.Parallel()
+
+	query := ReviewCards()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testReviewCardsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewCards().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewCardsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ReviewCards().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewCards().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewCardsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReviewCardSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewCards().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewCardsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ReviewCardExists(ctx, tx, o.DeckEntryID)
+	if err != nil {
+		t.Errorf("Unable to check if ReviewCard exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ReviewCardExists to return true, but got false.")
+	}
+}
+
+func testReviewCardsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	reviewCardFound, err := FindReviewCard(ctx, tx, o.DeckEntryID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if reviewCardFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testReviewCardsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ReviewCards().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewCardsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ReviewCards().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testReviewCardsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	reviewCardOne := &ReviewCard{}
+	reviewCardTwo := &ReviewCard{}
+	if err = randomize.Struct(seed, reviewCardOne, reviewCardDBTypes, false, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reviewCardTwo, reviewCardDBTypes, false, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reviewCardOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reviewCardTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReviewCards().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testReviewCardsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	reviewCardOne := &ReviewCard{}
+	reviewCardTwo := &ReviewCard{}
+	if err = randomize.Struct(seed, reviewCardOne, reviewCardDBTypes, false, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reviewCardTwo, reviewCardDBTypes, false, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reviewCardOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reviewCardTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewCards().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func reviewCardBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewCard) error {
+	*o = ReviewCard{}
+	return nil
+}
+
+func reviewCardAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewCard) error {
+	*o = ReviewCard{}
+	return nil
+}
+
+func reviewCardAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ReviewCard) error {
+	*o = ReviewCard{}
+	return nil
+}
+
+func reviewCardBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReviewCard) error {
+	*o = ReviewCard{}
+	return nil
+}
+
+func reviewCardAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReviewCard) error {
+	*o = ReviewCard{}
+	return nil
+}
+
+func reviewCardBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReviewCard) error {
+	*o = ReviewCard{}
+	return nil
+}
+
+func reviewCardAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReviewCard) error {
+	*o = ReviewCard{}
+	return nil
+}
+
+func reviewCardBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewCard) error {
+	*o = ReviewCard{}
+	return nil
+}
+
+func reviewCardAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewCard) error {
+	*o = ReviewCard{}
+	return nil
+}
+
+func testReviewCardsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ReviewCard{}
+	o := &ReviewCard{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ReviewCard object: %s", err)
+	}
+
+	AddReviewCardHook(boil.BeforeInsertHook, reviewCardBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	reviewCardBeforeInsertHooks = []ReviewCardHook{}
+
+	AddReviewCardHook(boil.AfterInsertHook, reviewCardAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	reviewCardAfterInsertHooks = []ReviewCardHook{}
+
+	AddReviewCardHook(boil.AfterSelectHook, reviewCardAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	reviewCardAfterSelectHooks = []ReviewCardHook{}
+
+	AddReviewCardHook(boil.BeforeUpdateHook, reviewCardBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	reviewCardBeforeUpdateHooks = []ReviewCardHook{}
+
+	AddReviewCardHook(boil.AfterUpdateHook, reviewCardAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	reviewCardAfterUpdateHooks = []ReviewCardHook{}
+
+	AddReviewCardHook(boil.BeforeDeleteHook, reviewCardBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	reviewCardBeforeDeleteHooks = []ReviewCardHook{}
+
+	AddReviewCardHook(boil.AfterDeleteHook, reviewCardAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	reviewCardAfterDeleteHooks = []ReviewCardHook{}
+
+	AddReviewCardHook(boil.BeforeUpsertHook, reviewCardBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	reviewCardBeforeUpsertHooks = []ReviewCardHook{}
+
+	AddReviewCardHook(boil.AfterUpsertHook, reviewCardAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	reviewCardAfterUpsertHooks = []ReviewCardHook{}
+}
+
+func testReviewCardsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewCards().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReviewCardsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(reviewCardColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewCards().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReviewCardToOneDeckEntryUsingDeckEntry(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ReviewCard
+	var foreign DeckEntry
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, reviewCardDBTypes, false, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, deckEntryDBTypes, false, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.DeckEntryID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.DeckEntry().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddDeckEntryHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ReviewCardSlice{&local}
+	if err = local.L.LoadDeckEntry(ctx, tx, false, (*[]*ReviewCard)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.DeckEntry == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.DeckEntry = nil
+	if err = local.L.LoadDeckEntry(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.DeckEntry == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testReviewCardToOneSetOpDeckEntryUsingDeckEntry(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ReviewCard
+	var b, c DeckEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, reviewCardDBTypes, false, strmangle.SetComplement(reviewCardPrimaryKeyColumns, reviewCardColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, deckEntryDBTypes, false, strmangle.SetComplement(deckEntryPrimaryKeyColumns, deckEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, deckEntryDBTypes, false, strmangle.SetComplement(deckEntryPrimaryKeyColumns, deckEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*DeckEntry{&b, &c} {
+		err = a.SetDeckEntry(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.DeckEntry != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ReviewCard != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.DeckEntryID != x.ID {
+			t.Error("foreign key was wrong value", a.DeckEntryID)
+		}
+
+		if exists, err := ReviewCardExists(ctx, tx, a.DeckEntryID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testReviewCardsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewCardsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReviewCardSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewCardsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReviewCards().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	reviewCardDBTypes = map[string]string{`DeckEntryID`: `uuid`, `Scheduler`: `character varying`, `Repetitions`: `integer`, `Lapses`: `integer`, `EaseFactor`: `double precision`, `Stability`: `double precision`, `Difficulty`: `double precision`, `IntervalDays`: `integer`, `DueAt`: `timestamp without time zone`, `LastReviewedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                 = bytes.MinRead
+)
+
+func testReviewCardsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(reviewCardPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(reviewCardAllColumns) == len(reviewCardPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewCards().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testReviewCardsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(reviewCardAllColumns) == len(reviewCardPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewCard{}
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewCards().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reviewCardDBTypes, true, reviewCardPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(reviewCardAllColumns, reviewCardPrimaryKeyColumns) {
+		fields = reviewCardAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			reviewCardAllColumns,
+			reviewCardPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ReviewCardSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testReviewCardsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(reviewCardAllColumns) == len(reviewCardPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ReviewCard{}
+	if err = randomize.Struct(seed, &o, reviewCardDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReviewCard: %s", err)
+	}
+
+	count, err := ReviewCards().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, reviewCardDBTypes, false, reviewCardPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewCard struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReviewCard: %s", err)
+	}
+
+	count, err = ReviewCards().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1398 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReviewLog is an object representing the database table.
+type ReviewLog struct {
+	ID           string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	DeckEntryID  string    `boil:"deck_entry_id" json:"deck_entry_id" toml:"deck_entry_id" yaml:"deck_entry_id"`
+	UserID       string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Scheduler    string    `boil:"scheduler" json:"scheduler" toml:"scheduler" yaml:"scheduler"`
+	Rating       int16     `boil:"rating" json:"rating" toml:"rating" yaml:"rating"`
+	ElapsedDays  int       `boil:"elapsed_days" json:"elapsed_days" toml:"elapsed_days" yaml:"elapsed_days"`
+	IntervalDays int       `boil:"interval_days" json:"interval_days" toml:"interval_days" yaml:"interval_days"`
+	DueAt        time.Time `boil:"due_at" json:"due_at" toml:"due_at" yaml:"due_at"`
+	ReviewedAt   time.Time `boil:"reviewed_at" json:"reviewed_at" toml:"reviewed_at" yaml:"reviewed_at"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *reviewLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reviewLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReviewLogColumns = struct {
+	ID           string
+	DeckEntryID  string
+	UserID       string
+	Scheduler    string
+	Rating       string
+	ElapsedDays  string
+	IntervalDays string
+	DueAt        string
+	ReviewedAt   string
+	CreatedAt    string
+}{
+	ID:           "id",
+	DeckEntryID:  "deck_entry_id",
+	UserID:       "user_id",
+	Scheduler:    "scheduler",
+	Rating:       "rating",
+	ElapsedDays:  "elapsed_days",
+	IntervalDays: "interval_days",
+	DueAt:        "due_at",
+	ReviewedAt:   "reviewed_at",
+	CreatedAt:    "created_at",
+}
+
+var ReviewLogTableColumns = struct {
+	ID           string
+	DeckEntryID  string
+	UserID       string
+	Scheduler    string
+	Rating       string
+	ElapsedDays  string
+	IntervalDays string
+	DueAt        string
+	ReviewedAt   string
+	CreatedAt    string
+}{
+	ID:           "review_logs.id",
+	DeckEntryID:  "review_logs.deck_entry_id",
+	UserID:       "review_logs.user_id",
+	Scheduler:    "review_logs.scheduler",
+	Rating:       "review_logs.rating",
+	ElapsedDays:  "review_logs.elapsed_days",
+	IntervalDays: "review_logs.interval_days",
+	DueAt:        "review_logs.due_at",
+	ReviewedAt:   "review_logs.reviewed_at",
+	CreatedAt:    "review_logs.created_at",
+}
+
+// Generated where
+
+type whereHelperint16 struct{ field string }
+
+func (w whereHelperint16) EQ(x int16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint16) NEQ(x int16) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint16) LT(x int16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint16) LTE(x int16) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint16) GT(x int16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint16) GTE(x int16) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint16) IN(slice []int16) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint16) NIN(slice []int16) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ReviewLogWhere = struct {
+	ID           whereHelperstring
+	DeckEntryID  whereHelperstring
+	UserID       whereHelperstring
+	Scheduler    whereHelperstring
+	Rating       whereHelperint16
+	ElapsedDays  whereHelperint
+	IntervalDays whereHelperint
+	DueAt        whereHelpertime_Time
+	ReviewedAt   whereHelpertime_Time
+	CreatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperstring{field: "\"review_logs\".\"id\""},
+	DeckEntryID:  whereHelperstring{field: "\"review_logs\".\"deck_entry_id\""},
+	UserID:       whereHelperstring{field: "\"review_logs\".\"user_id\""},
+	Scheduler:    whereHelperstring{field: "\"review_logs\".\"scheduler\""},
+	Rating:       whereHelperint16{field: "\"review_logs\".\"rating\""},
+	ElapsedDays:  whereHelperint{field: "\"review_logs\".\"elapsed_days\""},
+	IntervalDays: whereHelperint{field: "\"review_logs\".\"interval_days\""},
+	DueAt:        whereHelpertime_Time{field: "\"review_logs\".\"due_at\""},
+	ReviewedAt:   whereHelpertime_Time{field: "\"review_logs\".\"reviewed_at\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"review_logs\".\"created_at\""},
+}
+
+// ReviewLogRels is where relationship names are stored.
+var ReviewLogRels = struct {
+	DeckEntry string
+	User      string
+}{
+	DeckEntry: "DeckEntry",
+	User:      "User",
+}
+
+// reviewLogR is where relationships are stored.
+type reviewLogR struct {
+	DeckEntry *DeckEntry `boil:"DeckEntry" json:"DeckEntry" toml:"DeckEntry" yaml:"DeckEntry"`
+	User      *User      `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reviewLogR) NewStruct() *reviewLogR {
+	return &reviewLogR{}
+}
+
+func (r *reviewLogR) GetDeckEntry() *DeckEntry {
+	if r == nil {
+		return nil
+	}
+	return r.DeckEntry
+}
+
+func (r *reviewLogR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// reviewLogL is where Load methods for each relationship are stored.
+type reviewLogL struct{}
+
+var (
+	reviewLogAllColumns            = []string{"id", "deck_entry_id", "user_id", "scheduler", "rating", "elapsed_days", "interval_days", "due_at", "reviewed_at", "created_at"}
+	reviewLogColumnsWithoutDefault = []string{"deck_entry_id", "user_id", "scheduler", "rating", "elapsed_days", "interval_days", "due_at", "reviewed_at"}
+	reviewLogColumnsWithDefault    = []string{"id", "created_at"}
+	reviewLogPrimaryKeyColumns     = []string{"id"}
+	reviewLogGeneratedColumns      = []string{}
+)
+
+type (
+	// ReviewLogSlice is an alias for a slice of pointers to ReviewLog.
+	// This should almost always be used instead of []ReviewLog.
+	ReviewLogSlice []*ReviewLog
+	// ReviewLogHook is the signature for custom ReviewLog hook methods
+	ReviewLogHook func(context.Context, boil.ContextExecutor, *ReviewLog) error
+
+	reviewLogQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reviewLogType                 = reflect.TypeOf(&ReviewLog{})
+	reviewLogMapping              = queries.MakeStructMapping(reviewLogType)
+	reviewLogPrimaryKeyMapping, _ = queries.BindMapping(reviewLogType, reviewLogMapping, reviewLogPrimaryKeyColumns)
+	reviewLogInsertCacheMut       sync.RWMutex
+	reviewLogInsertCache          = make(map[string]insertCache)
+	reviewLogUpdateCacheMut       sync.RWMutex
+	reviewLogUpdateCache          = make(map[string]updateCache)
+	reviewLogUpsertCacheMut       sync.RWMutex
+	reviewLogUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reviewLogAfterSelectMu sync.Mutex
+var reviewLogAfterSelectHooks []ReviewLogHook
+
+var reviewLogBeforeInsertMu sync.Mutex
+var reviewLogBeforeInsertHooks []ReviewLogHook
+var reviewLogAfterInsertMu sync.Mutex
+var reviewLogAfterInsertHooks []ReviewLogHook
+
+var reviewLogBeforeUpdateMu sync.Mutex
+var reviewLogBeforeUpdateHooks []ReviewLogHook
+var reviewLogAfterUpdateMu sync.Mutex
+var reviewLogAfterUpdateHooks []ReviewLogHook
+
+var reviewLogBeforeDeleteMu sync.Mutex
+var reviewLogBeforeDeleteHooks []ReviewLogHook
+var reviewLogAfterDeleteMu sync.Mutex
+var reviewLogAfterDeleteHooks []ReviewLogHook
+
+var reviewLogBeforeUpsertMu sync.Mutex
+var reviewLogBeforeUpsertHooks []ReviewLogHook
+var reviewLogAfterUpsertMu sync.Mutex
+var reviewLogAfterUpsertHooks []ReviewLogHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReviewLog) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewLogAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReviewLog) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewLogBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReviewLog) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewLogAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReviewLog) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewLogBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReviewLog) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewLogAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReviewLog) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewLogBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReviewLog) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewLogAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReviewLog) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewLogBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReviewLog) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewLogAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReviewLogHook registers your hook function for all future operations.
+func AddReviewLogHook(hookPoint boil.HookPoint, reviewLogHook ReviewLogHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		reviewLogAfterSelectMu.Lock()
+		reviewLogAfterSelectHooks = append(reviewLogAfterSelectHooks, reviewLogHook)
+		reviewLogAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		reviewLogBeforeInsertMu.Lock()
+		reviewLogBeforeInsertHooks = append(reviewLogBeforeInsertHooks, reviewLogHook)
+		reviewLogBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		reviewLogAfterInsertMu.Lock()
+		reviewLogAfterInsertHooks = append(reviewLogAfterInsertHooks, reviewLogHook)
+		reviewLogAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		reviewLogBeforeUpdateMu.Lock()
+		reviewLogBeforeUpdateHooks = append(reviewLogBeforeUpdateHooks, reviewLogHook)
+		reviewLogBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		reviewLogAfterUpdateMu.Lock()
+		reviewLogAfterUpdateHooks = append(reviewLogAfterUpdateHooks, reviewLogHook)
+		reviewLogAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		reviewLogBeforeDeleteMu.Lock()
+		reviewLogBeforeDeleteHooks = append(reviewLogBeforeDeleteHooks, reviewLogHook)
+		reviewLogBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		reviewLogAfterDeleteMu.Lock()
+		reviewLogAfterDeleteHooks = append(reviewLogAfterDeleteHooks, reviewLogHook)
+		reviewLogAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		reviewLogBeforeUpsertMu.Lock()
+		reviewLogBeforeUpsertHooks = append(reviewLogBeforeUpsertHooks, reviewLogHook)
+		reviewLogBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		reviewLogAfterUpsertMu.Lock()
+		reviewLogAfterUpsertHooks = append(reviewLogAfterUpsertHooks, reviewLogHook)
+		reviewLogAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single reviewLog record from the query.
+func (q reviewLogQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReviewLog, error) {
+	o := &ReviewLog{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for review_logs")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ReviewLog records from the query.
+func (q reviewLogQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReviewLogSlice, error) {
+	var o []*ReviewLog
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to ReviewLog slice")
+	}
+
+	if len(reviewLogAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ReviewLog records in the query.
+func (q reviewLogQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count review_logs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q reviewLogQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if review_logs exists")
+	}
+
+	return count > 0, nil
+}
+
+// DeckEntry pointed to by the foreign key.
+func (o *ReviewLog) DeckEntry(mods ...qm.QueryMod) deckEntryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DeckEntryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DeckEntries(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *ReviewLog) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadDeckEntry allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reviewLogL) LoadDeckEntry(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReviewLog interface{}, mods queries.Applicator) error {
+	var slice []*ReviewLog
+	var object *ReviewLog
+
+	if singular {
+		var ok bool
+		object, ok = maybeReviewLog.(*ReviewLog)
+		if !ok {
+			object = new(ReviewLog)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReviewLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReviewLog))
+			}
+		}
+	} else {
+		s, ok := maybeReviewLog.(*[]*ReviewLog)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReviewLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReviewLog))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reviewLogR{}
+		}
+		args[object.DeckEntryID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reviewLogR{}
+			}
+
+			args[obj.DeckEntryID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`deck_entries`),
+		qm.WhereIn(`deck_entries.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DeckEntry")
+	}
+
+	var resultSlice []*DeckEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DeckEntry")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for deck_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deck_entries")
+	}
+
+	if len(deckEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DeckEntry = foreign
+		if foreign.R == nil {
+			foreign.R = &deckEntryR{}
+		}
+		foreign.R.ReviewLogs = append(foreign.R.ReviewLogs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DeckEntryID == foreign.ID {
+				local.R.DeckEntry = foreign
+				if foreign.R == nil {
+					foreign.R = &deckEntryR{}
+				}
+				foreign.R.ReviewLogs = append(foreign.R.ReviewLogs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reviewLogL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReviewLog interface{}, mods queries.Applicator) error {
+	var slice []*ReviewLog
+	var object *ReviewLog
+
+	if singular {
+		var ok bool
+		object, ok = maybeReviewLog.(*ReviewLog)
+		if !ok {
+			object = new(ReviewLog)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReviewLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReviewLog))
+			}
+		}
+	} else {
+		s, ok := maybeReviewLog.(*[]*ReviewLog)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReviewLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReviewLog))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reviewLogR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reviewLogR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ReviewLogs = append(foreign.R.ReviewLogs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ReviewLogs = append(foreign.R.ReviewLogs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDeckEntry of the reviewLog to the related item.
+// Sets o.R.DeckEntry to related.
+// Adds o to related.R.ReviewLogs.
+func (o *ReviewLog) SetDeckEntry(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DeckEntry) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"review_logs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"deck_entry_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reviewLogPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DeckEntryID = related.ID
+	if o.R == nil {
+		o.R = &reviewLogR{
+			DeckEntry: related,
+		}
+	} else {
+		o.R.DeckEntry = related
+	}
+
+	if related.R == nil {
+		related.R = &deckEntryR{
+			ReviewLogs: ReviewLogSlice{o},
+		}
+	} else {
+		related.R.ReviewLogs = append(related.R.ReviewLogs, o)
+	}
+
+	return nil
+}
+
+// SetUser of the reviewLog to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ReviewLogs.
+func (o *ReviewLog) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"review_logs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reviewLogPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &reviewLogR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ReviewLogs: ReviewLogSlice{o},
+		}
+	} else {
+		related.R.ReviewLogs = append(related.R.ReviewLogs, o)
+	}
+
+	return nil
+}
+
+// ReviewLogs retrieves all the records using an executor.
+func ReviewLogs(mods ...qm.QueryMod) reviewLogQuery {
+	mods = append(mods, qm.From("\"review_logs\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"review_logs\".*"})
+	}
+
+	return reviewLogQuery{q}
+}
+
+// FindReviewLog retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReviewLog(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ReviewLog, error) {
+	reviewLogObj := &ReviewLog{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"review_logs\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, reviewLogObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from review_logs")
+	}
+
+	if err = reviewLogObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return reviewLogObj, err
+	}
+
+	return reviewLogObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReviewLog) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no review_logs provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reviewLogColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reviewLogInsertCacheMut.RLock()
+	cache, cached := reviewLogInsertCache[key]
+	reviewLogInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reviewLogAllColumns,
+			reviewLogColumnsWithDefault,
+			reviewLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reviewLogType, reviewLogMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reviewLogType, reviewLogMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"review_logs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"review_logs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into review_logs")
+	}
+
+	if !cached {
+		reviewLogInsertCacheMut.Lock()
+		reviewLogInsertCache[key] = cache
+		reviewLogInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ReviewLog.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReviewLog) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reviewLogUpdateCacheMut.RLock()
+	cache, cached := reviewLogUpdateCache[key]
+	reviewLogUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reviewLogAllColumns,
+			reviewLogPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update review_logs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"review_logs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reviewLogPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reviewLogType, reviewLogMapping, append(wl, reviewLogPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update review_logs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for review_logs")
+	}
+
+	if !cached {
+		reviewLogUpdateCacheMut.Lock()
+		reviewLogUpdateCache[key] = cache
+		reviewLogUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reviewLogQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for review_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for review_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReviewLogSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"review_logs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reviewLogPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in reviewLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all reviewLog")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReviewLog) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no review_logs provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reviewLogColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reviewLogUpsertCacheMut.RLock()
+	cache, cached := reviewLogUpsertCache[key]
+	reviewLogUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			reviewLogAllColumns,
+			reviewLogColumnsWithDefault,
+			reviewLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reviewLogAllColumns,
+			reviewLogPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert review_logs, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(reviewLogAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(reviewLogPrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert review_logs, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(reviewLogPrimaryKeyColumns))
+			copy(conflict, reviewLogPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"review_logs\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(reviewLogType, reviewLogMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reviewLogType, reviewLogMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert review_logs")
+	}
+
+	if !cached {
+		reviewLogUpsertCacheMut.Lock()
+		reviewLogUpsertCache[key] = cache
+		reviewLogUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ReviewLog record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReviewLog) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no ReviewLog provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reviewLogPrimaryKeyMapping)
+	sql := "DELETE FROM \"review_logs\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from review_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for review_logs")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q reviewLogQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no reviewLogQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from review_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for review_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReviewLogSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reviewLogBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"review_logs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reviewLogPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from reviewLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for review_logs")
+	}
+
+	if len(reviewLogAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReviewLog) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReviewLog(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReviewLogSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReviewLogSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"review_logs\".* FROM \"review_logs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reviewLogPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in ReviewLogSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReviewLogExists checks if the ReviewLog row exists.
+func ReviewLogExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"review_logs\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if review_logs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ReviewLog row exists.
+func (o *ReviewLog) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ReviewLogExists(ctx, exec, o.ID)
+}
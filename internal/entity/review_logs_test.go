@@ -0,0 +1,969 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testReviewLogs(t *testing.T) {
+	t.Parallel()
+
+	query := ReviewLogs()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testReviewLogsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewLogsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ReviewLogs().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewLogsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReviewLogSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewLogsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ReviewLogExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ReviewLog exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ReviewLogExists to return true, but got false.")
+	}
+}
+
+func testReviewLogsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	reviewLogFound, err := FindReviewLog(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if reviewLogFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testReviewLogsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ReviewLogs().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewLogsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ReviewLogs().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testReviewLogsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	reviewLogOne := &ReviewLog{}
+	reviewLogTwo := &ReviewLog{}
+	if err = randomize.Struct(seed, reviewLogOne, reviewLogDBTypes, false, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reviewLogTwo, reviewLogDBTypes, false, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reviewLogOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reviewLogTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReviewLogs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testReviewLogsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	reviewLogOne := &ReviewLog{}
+	reviewLogTwo := &ReviewLog{}
+	if err = randomize.Struct(seed, reviewLogOne, reviewLogDBTypes, false, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reviewLogTwo, reviewLogDBTypes, false, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reviewLogOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reviewLogTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func reviewLogBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewLog) error {
+	*o = ReviewLog{}
+	return nil
+}
+
+func reviewLogAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewLog) error {
+	*o = ReviewLog{}
+	return nil
+}
+
+func reviewLogAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ReviewLog) error {
+	*o = ReviewLog{}
+	return nil
+}
+
+func reviewLogBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReviewLog) error {
+	*o = ReviewLog{}
+	return nil
+}
+
+func reviewLogAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReviewLog) error {
+	*o = ReviewLog{}
+	return nil
+}
+
+func reviewLogBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReviewLog) error {
+	*o = ReviewLog{}
+	return nil
+}
+
+func reviewLogAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReviewLog) error {
+	*o = ReviewLog{}
+	return nil
+}
+
+func reviewLogBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewLog) error {
+	*o = ReviewLog{}
+	return nil
+}
+
+func reviewLogAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewLog) error {
+	*o = ReviewLog{}
+	return nil
+}
+
+func testReviewLogsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ReviewLog{}
+	o := &ReviewLog{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ReviewLog object: %s", err)
+	}
+
+	AddReviewLogHook(boil.BeforeInsertHook, reviewLogBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	reviewLogBeforeInsertHooks = []ReviewLogHook{}
+
+	AddReviewLogHook(boil.AfterInsertHook, reviewLogAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	reviewLogAfterInsertHooks = []ReviewLogHook{}
+
+	AddReviewLogHook(boil.AfterSelectHook, reviewLogAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	reviewLogAfterSelectHooks = []ReviewLogHook{}
+
+	AddReviewLogHook(boil.BeforeUpdateHook, reviewLogBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	reviewLogBeforeUpdateHooks = []ReviewLogHook{}
+
+	AddReviewLogHook(boil.AfterUpdateHook, reviewLogAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	reviewLogAfterUpdateHooks = []ReviewLogHook{}
+
+	AddReviewLogHook(boil.BeforeDeleteHook, reviewLogBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	reviewLogBeforeDeleteHooks = []ReviewLogHook{}
+
+	AddReviewLogHook(boil.AfterDeleteHook, reviewLogAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	reviewLogAfterDeleteHooks = []ReviewLogHook{}
+
+	AddReviewLogHook(boil.BeforeUpsertHook, reviewLogBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	reviewLogBeforeUpsertHooks = []ReviewLogHook{}
+
+	AddReviewLogHook(boil.AfterUpsertHook, reviewLogAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	reviewLogAfterUpsertHooks = []ReviewLogHook{}
+}
+
+func testReviewLogsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReviewLogsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(reviewLogColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReviewLogToOneDeckEntryUsingDeckEntry(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ReviewLog
+	var foreign DeckEntry
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, reviewLogDBTypes, false, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, deckEntryDBTypes, false, deckEntryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DeckEntry struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.DeckEntryID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.DeckEntry().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddDeckEntryHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *DeckEntry) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ReviewLogSlice{&local}
+	if err = local.L.LoadDeckEntry(ctx, tx, false, (*[]*ReviewLog)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.DeckEntry == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.DeckEntry = nil
+	if err = local.L.LoadDeckEntry(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.DeckEntry == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testReviewLogToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ReviewLog
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, reviewLogDBTypes, false, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ReviewLogSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ReviewLog)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testReviewLogToOneSetOpDeckEntryUsingDeckEntry(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ReviewLog
+	var b, c DeckEntry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, reviewLogDBTypes, false, strmangle.SetComplement(reviewLogPrimaryKeyColumns, reviewLogColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, deckEntryDBTypes, false, strmangle.SetComplement(deckEntryPrimaryKeyColumns, deckEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, deckEntryDBTypes, false, strmangle.SetComplement(deckEntryPrimaryKeyColumns, deckEntryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*DeckEntry{&b, &c} {
+		err = a.SetDeckEntry(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.DeckEntry != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ReviewLogs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.DeckEntryID != x.ID {
+			t.Error("foreign key was wrong value", a.DeckEntryID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.DeckEntryID))
+		reflect.Indirect(reflect.ValueOf(&a.DeckEntryID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.DeckEntryID != x.ID {
+			t.Error("foreign key was wrong value", a.DeckEntryID, x.ID)
+		}
+	}
+}
+func testReviewLogToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ReviewLog
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, reviewLogDBTypes, false, strmangle.SetComplement(reviewLogPrimaryKeyColumns, reviewLogColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ReviewLogs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testReviewLogsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewLogsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReviewLogSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewLogsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReviewLogs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	reviewLogDBTypes = map[string]string{`ID`: `uuid`, `DeckEntryID`: `uuid`, `UserID`: `uuid`, `Scheduler`: `character varying`, `Rating`: `smallint`, `ElapsedDays`: `integer`, `IntervalDays`: `integer`, `DueAt`: `timestamp without time zone`, `ReviewedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`}
+	_                = bytes.MinRead
+)
+
+func testReviewLogsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(reviewLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(reviewLogAllColumns) == len(reviewLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testReviewLogsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(reviewLogAllColumns) == len(reviewLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewLog{}
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reviewLogDBTypes, true, reviewLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(reviewLogAllColumns, reviewLogPrimaryKeyColumns) {
+		fields = reviewLogAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			reviewLogAllColumns,
+			reviewLogPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ReviewLogSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testReviewLogsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(reviewLogAllColumns) == len(reviewLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ReviewLog{}
+	if err = randomize.Struct(seed, &o, reviewLogDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReviewLog: %s", err)
+	}
+
+	count, err := ReviewLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, reviewLogDBTypes, false, reviewLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewLog struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReviewLog: %s", err)
+	}
+
+	count, err = ReviewLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
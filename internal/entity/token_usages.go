@@ -0,0 +1,1190 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TokenUsage is an object representing the database table.
+type TokenUsage struct {
+	UserID           string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Endpoint         string    `boil:"endpoint" json:"endpoint" toml:"endpoint" yaml:"endpoint"`
+	Day              time.Time `boil:"day" json:"day" toml:"day" yaml:"day"`
+	Requests         int       `boil:"requests" json:"requests" toml:"requests" yaml:"requests"`
+	PromptTokens     int       `boil:"prompt_tokens" json:"prompt_tokens" toml:"prompt_tokens" yaml:"prompt_tokens"`
+	CompletionTokens int       `boil:"completion_tokens" json:"completion_tokens" toml:"completion_tokens" yaml:"completion_tokens"`
+	TotalTokens      int       `boil:"total_tokens" json:"total_tokens" toml:"total_tokens" yaml:"total_tokens"`
+	CreatedAt        time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tokenUsageR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tokenUsageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TokenUsageColumns = struct {
+	UserID           string
+	Endpoint         string
+	Day              string
+	Requests         string
+	PromptTokens     string
+	CompletionTokens string
+	TotalTokens      string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	UserID:           "user_id",
+	Endpoint:         "endpoint",
+	Day:              "day",
+	Requests:         "requests",
+	PromptTokens:     "prompt_tokens",
+	CompletionTokens: "completion_tokens",
+	TotalTokens:      "total_tokens",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+}
+
+var TokenUsageTableColumns = struct {
+	UserID           string
+	Endpoint         string
+	Day              string
+	Requests         string
+	PromptTokens     string
+	CompletionTokens string
+	TotalTokens      string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	UserID:           "token_usages.user_id",
+	Endpoint:         "token_usages.endpoint",
+	Day:              "token_usages.day",
+	Requests:         "token_usages.requests",
+	PromptTokens:     "token_usages.prompt_tokens",
+	CompletionTokens: "token_usages.completion_tokens",
+	TotalTokens:      "token_usages.total_tokens",
+	CreatedAt:        "token_usages.created_at",
+	UpdatedAt:        "token_usages.updated_at",
+}
+
+// Generated where
+
+var TokenUsageWhere = struct {
+	UserID           whereHelperstring
+	Endpoint         whereHelperstring
+	Day              whereHelpertime_Time
+	Requests         whereHelperint
+	PromptTokens     whereHelperint
+	CompletionTokens whereHelperint
+	TotalTokens      whereHelperint
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
+}{
+	UserID:           whereHelperstring{field: "\"token_usages\".\"user_id\""},
+	Endpoint:         whereHelperstring{field: "\"token_usages\".\"endpoint\""},
+	Day:              whereHelpertime_Time{field: "\"token_usages\".\"day\""},
+	Requests:         whereHelperint{field: "\"token_usages\".\"requests\""},
+	PromptTokens:     whereHelperint{field: "\"token_usages\".\"prompt_tokens\""},
+	CompletionTokens: whereHelperint{field: "\"token_usages\".\"completion_tokens\""},
+	TotalTokens:      whereHelperint{field: "\"token_usages\".\"total_tokens\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"token_usages\".\"created_at\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"token_usages\".\"updated_at\""},
+}
+
+// TokenUsageRels is where relationship names are stored.
+var TokenUsageRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// tokenUsageR is where relationships are stored.
+type tokenUsageR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tokenUsageR) NewStruct() *tokenUsageR {
+	return &tokenUsageR{}
+}
+
+func (r *tokenUsageR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// tokenUsageL is where Load methods for each relationship are stored.
+type tokenUsageL struct{}
+
+var (
+	tokenUsageAllColumns            = []string{"user_id", "endpoint", "day", "requests", "prompt_tokens", "completion_tokens", "total_tokens", "created_at", "updated_at"}
+	tokenUsageColumnsWithoutDefault = []string{"user_id", "endpoint", "day"}
+	tokenUsageColumnsWithDefault    = []string{"requests", "prompt_tokens", "completion_tokens", "total_tokens", "created_at", "updated_at"}
+	tokenUsagePrimaryKeyColumns     = []string{"user_id", "endpoint", "day"}
+	tokenUsageGeneratedColumns      = []string{}
+)
+
+type (
+	// TokenUsageSlice is an alias for a slice of pointers to TokenUsage.
+	// This should almost always be used instead of []TokenUsage.
+	TokenUsageSlice []*TokenUsage
+	// TokenUsageHook is the signature for custom TokenUsage hook methods
+	TokenUsageHook func(context.Context, boil.ContextExecutor, *TokenUsage) error
+
+	tokenUsageQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tokenUsageType                 = reflect.TypeOf(&TokenUsage{})
+	tokenUsageMapping              = queries.MakeStructMapping(tokenUsageType)
+	tokenUsagePrimaryKeyMapping, _ = queries.BindMapping(tokenUsageType, tokenUsageMapping, tokenUsagePrimaryKeyColumns)
+	tokenUsageInsertCacheMut       sync.RWMutex
+	tokenUsageInsertCache          = make(map[string]insertCache)
+	tokenUsageUpdateCacheMut       sync.RWMutex
+	tokenUsageUpdateCache          = make(map[string]updateCache)
+	tokenUsageUpsertCacheMut       sync.RWMutex
+	tokenUsageUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tokenUsageAfterSelectMu sync.Mutex
+var tokenUsageAfterSelectHooks []TokenUsageHook
+
+var tokenUsageBeforeInsertMu sync.Mutex
+var tokenUsageBeforeInsertHooks []TokenUsageHook
+var tokenUsageAfterInsertMu sync.Mutex
+var tokenUsageAfterInsertHooks []TokenUsageHook
+
+var tokenUsageBeforeUpdateMu sync.Mutex
+var tokenUsageBeforeUpdateHooks []TokenUsageHook
+var tokenUsageAfterUpdateMu sync.Mutex
+var tokenUsageAfterUpdateHooks []TokenUsageHook
+
+var tokenUsageBeforeDeleteMu sync.Mutex
+var tokenUsageBeforeDeleteHooks []TokenUsageHook
+var tokenUsageAfterDeleteMu sync.Mutex
+var tokenUsageAfterDeleteHooks []TokenUsageHook
+
+var tokenUsageBeforeUpsertMu sync.Mutex
+var tokenUsageBeforeUpsertHooks []TokenUsageHook
+var tokenUsageAfterUpsertMu sync.Mutex
+var tokenUsageAfterUpsertHooks []TokenUsageHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TokenUsage) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenUsageAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TokenUsage) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenUsageBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TokenUsage) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenUsageAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TokenUsage) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenUsageBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TokenUsage) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenUsageAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TokenUsage) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenUsageBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TokenUsage) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenUsageAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TokenUsage) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenUsageBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TokenUsage) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenUsageAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTokenUsageHook registers your hook function for all future operations.
+func AddTokenUsageHook(hookPoint boil.HookPoint, tokenUsageHook TokenUsageHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tokenUsageAfterSelectMu.Lock()
+		tokenUsageAfterSelectHooks = append(tokenUsageAfterSelectHooks, tokenUsageHook)
+		tokenUsageAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tokenUsageBeforeInsertMu.Lock()
+		tokenUsageBeforeInsertHooks = append(tokenUsageBeforeInsertHooks, tokenUsageHook)
+		tokenUsageBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tokenUsageAfterInsertMu.Lock()
+		tokenUsageAfterInsertHooks = append(tokenUsageAfterInsertHooks, tokenUsageHook)
+		tokenUsageAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tokenUsageBeforeUpdateMu.Lock()
+		tokenUsageBeforeUpdateHooks = append(tokenUsageBeforeUpdateHooks, tokenUsageHook)
+		tokenUsageBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tokenUsageAfterUpdateMu.Lock()
+		tokenUsageAfterUpdateHooks = append(tokenUsageAfterUpdateHooks, tokenUsageHook)
+		tokenUsageAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tokenUsageBeforeDeleteMu.Lock()
+		tokenUsageBeforeDeleteHooks = append(tokenUsageBeforeDeleteHooks, tokenUsageHook)
+		tokenUsageBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tokenUsageAfterDeleteMu.Lock()
+		tokenUsageAfterDeleteHooks = append(tokenUsageAfterDeleteHooks, tokenUsageHook)
+		tokenUsageAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tokenUsageBeforeUpsertMu.Lock()
+		tokenUsageBeforeUpsertHooks = append(tokenUsageBeforeUpsertHooks, tokenUsageHook)
+		tokenUsageBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tokenUsageAfterUpsertMu.Lock()
+		tokenUsageAfterUpsertHooks = append(tokenUsageAfterUpsertHooks, tokenUsageHook)
+		tokenUsageAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tokenUsage record from the query.
+func (q tokenUsageQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TokenUsage, error) {
+	o := &TokenUsage{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: failed to execute a one query for token_usages")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TokenUsage records from the query.
+func (q tokenUsageQuery) All(ctx context.Context, exec boil.ContextExecutor) (TokenUsageSlice, error) {
+	var o []*TokenUsage
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entity: failed to assign all query results to TokenUsage slice")
+	}
+
+	if len(tokenUsageAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TokenUsage records in the query.
+func (q tokenUsageQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to count token_usages rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tokenUsageQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: failed to check if token_usages exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TokenUsage) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tokenUsageL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTokenUsage interface{}, mods queries.Applicator) error {
+	var slice []*TokenUsage
+	var object *TokenUsage
+
+	if singular {
+		var ok bool
+		object, ok = maybeTokenUsage.(*TokenUsage)
+		if !ok {
+			object = new(TokenUsage)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTokenUsage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTokenUsage))
+			}
+		}
+	} else {
+		s, ok := maybeTokenUsage.(*[]*TokenUsage)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTokenUsage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTokenUsage))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tokenUsageR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tokenUsageR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TokenUsages = append(foreign.R.TokenUsages, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TokenUsages = append(foreign.R.TokenUsages, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the tokenUsage to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.TokenUsages.
+func (o *TokenUsage) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"token_usages\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, tokenUsagePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.Endpoint, o.Day}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &tokenUsageR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TokenUsages: TokenUsageSlice{o},
+		}
+	} else {
+		related.R.TokenUsages = append(related.R.TokenUsages, o)
+	}
+
+	return nil
+}
+
+// TokenUsages retrieves all the records using an executor.
+func TokenUsages(mods ...qm.QueryMod) tokenUsageQuery {
+	mods = append(mods, qm.From("\"token_usages\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"token_usages\".*"})
+	}
+
+	return tokenUsageQuery{q}
+}
+
+// FindTokenUsage retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTokenUsage(ctx context.Context, exec boil.ContextExecutor, userID string, endpoint string, day time.Time, selectCols ...string) (*TokenUsage, error) {
+	tokenUsageObj := &TokenUsage{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"token_usages\" where \"user_id\"=$1 AND \"endpoint\"=$2 AND \"day\"=$3", sel,
+	)
+
+	q := queries.Raw(query, userID, endpoint, day)
+
+	err := q.Bind(ctx, exec, tokenUsageObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entity: unable to select from token_usages")
+	}
+
+	if err = tokenUsageObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tokenUsageObj, err
+	}
+
+	return tokenUsageObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TokenUsage) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entity: no token_usages provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tokenUsageColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tokenUsageInsertCacheMut.RLock()
+	cache, cached := tokenUsageInsertCache[key]
+	tokenUsageInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tokenUsageAllColumns,
+			tokenUsageColumnsWithDefault,
+			tokenUsageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tokenUsageType, tokenUsageMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tokenUsageType, tokenUsageMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"token_usages\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"token_usages\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to insert into token_usages")
+	}
+
+	if !cached {
+		tokenUsageInsertCacheMut.Lock()
+		tokenUsageInsertCache[key] = cache
+		tokenUsageInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TokenUsage.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TokenUsage) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tokenUsageUpdateCacheMut.RLock()
+	cache, cached := tokenUsageUpdateCache[key]
+	tokenUsageUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tokenUsageAllColumns,
+			tokenUsagePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("entity: unable to update token_usages, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"token_usages\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, tokenUsagePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tokenUsageType, tokenUsageMapping, append(wl, tokenUsagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update token_usages row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by update for token_usages")
+	}
+
+	if !cached {
+		tokenUsageUpdateCacheMut.Lock()
+		tokenUsageUpdateCache[key] = cache
+		tokenUsageUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tokenUsageQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all for token_usages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected for token_usages")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TokenUsageSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entity: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenUsagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"token_usages\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, tokenUsagePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to update all in tokenUsage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to retrieve rows affected all in update all tokenUsage")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TokenUsage) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("entity: no token_usages provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tokenUsageColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tokenUsageUpsertCacheMut.RLock()
+	cache, cached := tokenUsageUpsertCache[key]
+	tokenUsageUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tokenUsageAllColumns,
+			tokenUsageColumnsWithDefault,
+			tokenUsageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tokenUsageAllColumns,
+			tokenUsagePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entity: unable to upsert token_usages, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tokenUsageAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(tokenUsagePrimaryKeyColumns) == 0 {
+				return errors.New("entity: unable to upsert token_usages, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(tokenUsagePrimaryKeyColumns))
+			copy(conflict, tokenUsagePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"token_usages\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(tokenUsageType, tokenUsageMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tokenUsageType, tokenUsageMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to upsert token_usages")
+	}
+
+	if !cached {
+		tokenUsageUpsertCacheMut.Lock()
+		tokenUsageUpsertCache[key] = cache
+		tokenUsageUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TokenUsage record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TokenUsage) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entity: no TokenUsage provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tokenUsagePrimaryKeyMapping)
+	sql := "DELETE FROM \"token_usages\" WHERE \"user_id\"=$1 AND \"endpoint\"=$2 AND \"day\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete from token_usages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by delete for token_usages")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tokenUsageQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entity: no tokenUsageQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from token_usages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for token_usages")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TokenUsageSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tokenUsageBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenUsagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"token_usages\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, tokenUsagePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: unable to delete all from tokenUsage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entity: failed to get rows affected by deleteall for token_usages")
+	}
+
+	if len(tokenUsageAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TokenUsage) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTokenUsage(ctx, exec, o.UserID, o.Endpoint, o.Day)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TokenUsageSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TokenUsageSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenUsagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"token_usages\".* FROM \"token_usages\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, tokenUsagePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entity: unable to reload all in TokenUsageSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TokenUsageExists checks if the TokenUsage row exists.
+func TokenUsageExists(ctx context.Context, exec boil.ContextExecutor, userID string, endpoint string, day time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"token_usages\" where \"user_id\"=$1 AND \"endpoint\"=$2 AND \"day\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, endpoint, day)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, endpoint, day)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entity: unable to check if token_usages exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TokenUsage row exists.
+func (o *TokenUsage) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TokenUsageExists(ctx, exec, o.UserID, o.Endpoint, o.Day)
+}
@@ -0,0 +1,847 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entity
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTokenUsages(t *testing.T) {
+	t.Parallel()
+
+	query := TokenUsages()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTokenUsagesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TokenUsages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTokenUsagesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TokenUsages().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TokenUsages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTokenUsagesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TokenUsageSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TokenUsages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTokenUsagesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TokenUsageExists(ctx, tx, o.UserID, o.Endpoint, o.Day)
+	if err != nil {
+		t.Errorf("Unable to check if TokenUsage exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TokenUsageExists to return true, but got false.")
+	}
+}
+
+func testTokenUsagesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	tokenUsageFound, err := FindTokenUsage(ctx, tx, o.UserID, o.Endpoint, o.Day)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if tokenUsageFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTokenUsagesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TokenUsages().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTokenUsagesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TokenUsages().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTokenUsagesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	tokenUsageOne := &TokenUsage{}
+	tokenUsageTwo := &TokenUsage{}
+	if err = randomize.Struct(seed, tokenUsageOne, tokenUsageDBTypes, false, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+	if err = randomize.Struct(seed, tokenUsageTwo, tokenUsageDBTypes, false, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = tokenUsageOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = tokenUsageTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TokenUsages().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTokenUsagesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	tokenUsageOne := &TokenUsage{}
+	tokenUsageTwo := &TokenUsage{}
+	if err = randomize.Struct(seed, tokenUsageOne, tokenUsageDBTypes, false, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+	if err = randomize.Struct(seed, tokenUsageTwo, tokenUsageDBTypes, false, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = tokenUsageOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = tokenUsageTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TokenUsages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func tokenUsageBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TokenUsage) error {
+	*o = TokenUsage{}
+	return nil
+}
+
+func tokenUsageAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TokenUsage) error {
+	*o = TokenUsage{}
+	return nil
+}
+
+func tokenUsageAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TokenUsage) error {
+	*o = TokenUsage{}
+	return nil
+}
+
+func tokenUsageBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TokenUsage) error {
+	*o = TokenUsage{}
+	return nil
+}
+
+func tokenUsageAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TokenUsage) error {
+	*o = TokenUsage{}
+	return nil
+}
+
+func tokenUsageBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TokenUsage) error {
+	*o = TokenUsage{}
+	return nil
+}
+
+func tokenUsageAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TokenUsage) error {
+	*o = TokenUsage{}
+	return nil
+}
+
+func tokenUsageBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TokenUsage) error {
+	*o = TokenUsage{}
+	return nil
+}
+
+func tokenUsageAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TokenUsage) error {
+	*o = TokenUsage{}
+	return nil
+}
+
+func testTokenUsagesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TokenUsage{}
+	o := &TokenUsage{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TokenUsage object: %s", err)
+	}
+
+	AddTokenUsageHook(boil.BeforeInsertHook, tokenUsageBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	tokenUsageBeforeInsertHooks = []TokenUsageHook{}
+
+	AddTokenUsageHook(boil.AfterInsertHook, tokenUsageAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	tokenUsageAfterInsertHooks = []TokenUsageHook{}
+
+	AddTokenUsageHook(boil.AfterSelectHook, tokenUsageAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	tokenUsageAfterSelectHooks = []TokenUsageHook{}
+
+	AddTokenUsageHook(boil.BeforeUpdateHook, tokenUsageBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	tokenUsageBeforeUpdateHooks = []TokenUsageHook{}
+
+	AddTokenUsageHook(boil.AfterUpdateHook, tokenUsageAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	tokenUsageAfterUpdateHooks = []TokenUsageHook{}
+
+	AddTokenUsageHook(boil.BeforeDeleteHook, tokenUsageBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	tokenUsageBeforeDeleteHooks = []TokenUsageHook{}
+
+	AddTokenUsageHook(boil.AfterDeleteHook, tokenUsageAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	tokenUsageAfterDeleteHooks = []TokenUsageHook{}
+
+	AddTokenUsageHook(boil.BeforeUpsertHook, tokenUsageBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	tokenUsageBeforeUpsertHooks = []TokenUsageHook{}
+
+	AddTokenUsageHook(boil.AfterUpsertHook, tokenUsageAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	tokenUsageAfterUpsertHooks = []TokenUsageHook{}
+}
+
+func testTokenUsagesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TokenUsages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTokenUsagesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(tokenUsageColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TokenUsages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTokenUsageToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TokenUsage
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, tokenUsageDBTypes, false, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := TokenUsageSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*TokenUsage)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testTokenUsageToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TokenUsage
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, tokenUsageDBTypes, false, strmangle.SetComplement(tokenUsagePrimaryKeyColumns, tokenUsageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TokenUsages[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := TokenUsageExists(ctx, tx, a.UserID, a.Endpoint, a.Day); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testTokenUsagesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTokenUsagesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TokenUsageSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTokenUsagesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TokenUsages().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	tokenUsageDBTypes = map[string]string{`UserID`: `uuid`, `Endpoint`: `character varying`, `Day`: `date`, `Requests`: `integer`, `PromptTokens`: `integer`, `CompletionTokens`: `integer`, `TotalTokens`: `integer`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                 = bytes.MinRead
+)
+
+func testTokenUsagesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(tokenUsagePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(tokenUsageAllColumns) == len(tokenUsagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TokenUsages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTokenUsagesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(tokenUsageAllColumns) == len(tokenUsagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TokenUsage{}
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TokenUsages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, tokenUsageDBTypes, true, tokenUsagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(tokenUsageAllColumns, tokenUsagePrimaryKeyColumns) {
+		fields = tokenUsageAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			tokenUsageAllColumns,
+			tokenUsagePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TokenUsageSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTokenUsagesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(tokenUsageAllColumns) == len(tokenUsagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TokenUsage{}
+	if err = randomize.Struct(seed, &o, tokenUsageDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TokenUsage: %s", err)
+	}
+
+	count, err := TokenUsages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, tokenUsageDBTypes, false, tokenUsagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TokenUsage struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TokenUsage: %s", err)
+	}
+
+	count, err = TokenUsages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
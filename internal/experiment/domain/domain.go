@@ -0,0 +1,34 @@
+package domain
+
+// Exposure is a response served by a variant of a prompt experiment.
+type Exposure struct {
+	Experiment    string
+	Variant       string
+	PromptID      string
+	PromptVersion string
+	// UserID is empty for anonymous requests.
+	UserID string
+	// SessionID is the device ID of anonymous requests, empty when none was sent.
+	SessionID        string
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+}
+
+// Rater is who gives feedback on a response. Only the user, or for anonymous requests the device, that was
+// served a response can rate it.
+type Rater struct {
+	UserID    string
+	SessionID string
+}
+
+// VariantResult sums up the responses served by one variant of an experiment.
+type VariantResult struct {
+	Variant          string `boil:"variant"`
+	Responses        int    `boil:"responses"`
+	PromptTokens     int    `boil:"prompt_tokens"`
+	CompletionTokens int    `boil:"completion_tokens"`
+	TotalTokens      int    `boil:"total_tokens"`
+	ThumbsUp         int    `boil:"thumbs_up"`
+	ThumbsDown       int    `boil:"thumbs_down"`
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go
+//
+
+// Package mock_experiment is a generated GoMock package.
+package mock_experiment
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	domain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/domain"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Feedback mocks base method.
+func (m *MockService) Feedback(ctx context.Context, responseID string, rater domain.Rater, helpful bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feedback", ctx, responseID, rater, helpful)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Feedback indicates an expected call of Feedback.
+func (mr *MockServiceMockRecorder) Feedback(ctx, responseID, rater, helpful any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feedback", reflect.TypeOf((*MockService)(nil).Feedback), ctx, responseID, rater, helpful)
+}
+
+// Record mocks base method.
+func (m *MockService) Record(ctx context.Context, exposure domain.Exposure) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, exposure)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockServiceMockRecorder) Record(ctx, exposure any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockService)(nil).Record), ctx, exposure)
+}
+
+// Results mocks base method.
+func (m *MockService) Results(ctx context.Context, experiment string) ([]domain.VariantResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Results", ctx, experiment)
+	ret0, _ := ret[0].([]domain.VariantResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Results indicates an expected call of Results.
+func (mr *MockServiceMockRecorder) Results(ctx, experiment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Results", reflect.TypeOf((*MockService)(nil).Results), ctx, experiment)
+}
@@ -0,0 +1,124 @@
+// Package experiment records which variant of a prompt experiment served each response, collects
+// thumbs up/down feedback on those responses and sums both up per variant.
+package experiment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/storage"
+)
+
+var (
+	// ErrResponseNotFound is also returned for the responses of someone else, so that they cannot be found by ID.
+	ErrResponseNotFound = errors.New("experiment response not found")
+	ErrAlreadyRated     = errors.New("experiment response already rated")
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+type Service interface {
+	// Record stores a served response and returns its ID, which feedback on it is given with.
+	Record(ctx context.Context, exposure domain.Exposure) (string, error)
+	// Feedback records the thumbs up (helpful) or down of rater on a response they were served. A response can be
+	// rated once.
+	Feedback(ctx context.Context, responseID string, rater domain.Rater, helpful bool) error
+	Results(ctx context.Context, experiment string) ([]domain.VariantResult, error)
+}
+
+type service struct {
+	logger     *zap.Logger
+	repository storage.ExperimentRepository
+}
+
+func NewExperimentService(logger *zap.Logger, repository storage.ExperimentRepository) Service {
+	return &service{
+		logger:     logger,
+		repository: repository,
+	}
+}
+
+func (s *service) Record(ctx context.Context, exposure domain.Exposure) (string, error) {
+	response := &entity.ExperimentResponse{
+		Experiment:       exposure.Experiment,
+		Variant:          exposure.Variant,
+		PromptID:         exposure.PromptID,
+		PromptVersion:    exposure.PromptVersion,
+		PromptTokens:     exposure.PromptTokens,
+		CompletionTokens: exposure.CompletionTokens,
+		TotalTokens:      exposure.TotalTokens,
+	}
+
+	if exposure.UserID != "" {
+		response.UserID = null.StringFrom(exposure.UserID)
+	} else if exposure.SessionID != "" {
+		response.SessionID = null.StringFrom(exposure.SessionID)
+	}
+
+	response, err := s.repository.InsertResponse(ctx, response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.ID, nil
+}
+
+func (s *service) Feedback(ctx context.Context, responseID string, rater domain.Rater, helpful bool) error {
+	if _, err := uuid.Parse(responseID); err != nil {
+		return ErrResponseNotFound
+	}
+
+	response, err := s.repository.GetResponse(ctx, responseID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrResponseNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get experiment response: %w", err)
+	}
+
+	if !servedTo(response, rater) {
+		return ErrResponseNotFound
+	}
+
+	if response.Rating.Valid {
+		return ErrAlreadyRated
+	}
+
+	rating := int16(-1)
+	if helpful {
+		rating = 1
+	}
+
+	rated, err := s.repository.RateResponse(ctx, responseID, rating)
+	if err != nil {
+		return fmt.Errorf("failed to record feedback: %w", err)
+	}
+
+	if !rated {
+		return ErrAlreadyRated
+	}
+
+	return nil
+}
+
+// servedTo reports whether response was served to rater. Responses served to no one in particular, anonymous
+// requests without a device ID, cannot be rated.
+func servedTo(response *entity.ExperimentResponse, rater domain.Rater) bool {
+	if response.UserID.Valid {
+		return rater.UserID == response.UserID.String
+	}
+
+	return response.SessionID.Valid && rater.SessionID == response.SessionID.String
+}
+
+func (s *service) Results(ctx context.Context, experiment string) ([]domain.VariantResult, error) {
+	return s.repository.GetResults(ctx, experiment)
+}
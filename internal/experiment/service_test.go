@@ -0,0 +1,140 @@
+package experiment_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/domain"
+	mockstorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/storage/mock"
+)
+
+func TestFeedback(t *testing.T) {
+	const (
+		responseID = "4a3c1f0e-8d8b-4d4c-9a53-0f6f1b7c2a10"
+		userID     = "9b2f6c1e-3a4d-4f5e-8c7b-1d2e3f4a5b6c"
+		deviceID   = "device-1"
+	)
+
+	userResponse := &entity.ExperimentResponse{ID: responseID, UserID: null.StringFrom(userID)}
+	anonymousResponse := &entity.ExperimentResponse{ID: responseID, SessionID: null.StringFrom(deviceID)}
+
+	testCases := []struct {
+		name        string
+		responseID  string
+		rater       domain.Rater
+		helpful     bool
+		mock        func(repository *mockstorage.MockExperimentRepository)
+		expectedErr error
+	}{
+		{
+			name:       "thumbs up from the user served the response",
+			responseID: responseID,
+			rater:      domain.Rater{UserID: userID},
+			helpful:    true,
+			mock: func(repository *mockstorage.MockExperimentRepository) {
+				repository.EXPECT().GetResponse(gomock.Any(), responseID).Return(userResponse, nil)
+				repository.EXPECT().RateResponse(gomock.Any(), responseID, int16(1)).Return(true, nil)
+			},
+		},
+		{
+			name:       "thumbs down from the device served the response",
+			responseID: responseID,
+			rater:      domain.Rater{SessionID: deviceID},
+			helpful:    false,
+			mock: func(repository *mockstorage.MockExperimentRepository) {
+				repository.EXPECT().GetResponse(gomock.Any(), responseID).Return(anonymousResponse, nil)
+				repository.EXPECT().RateResponse(gomock.Any(), responseID, int16(-1)).Return(true, nil)
+			},
+		},
+		{
+			name:       "response of another user",
+			responseID: responseID,
+			rater:      domain.Rater{UserID: "5c4d3e2f-1a0b-4c9d-8e7f-6a5b4c3d2e1f", SessionID: deviceID},
+			mock: func(repository *mockstorage.MockExperimentRepository) {
+				repository.EXPECT().GetResponse(gomock.Any(), responseID).Return(userResponse, nil)
+			},
+			expectedErr: experiment.ErrResponseNotFound,
+		},
+		{
+			name:       "response of another device",
+			responseID: responseID,
+			rater:      domain.Rater{SessionID: "device-2"},
+			mock: func(repository *mockstorage.MockExperimentRepository) {
+				repository.EXPECT().GetResponse(gomock.Any(), responseID).Return(anonymousResponse, nil)
+			},
+			expectedErr: experiment.ErrResponseNotFound,
+		},
+		{
+			name:       "response served to no one in particular",
+			responseID: responseID,
+			rater:      domain.Rater{},
+			mock: func(repository *mockstorage.MockExperimentRepository) {
+				repository.EXPECT().GetResponse(gomock.Any(), responseID).Return(&entity.ExperimentResponse{ID: responseID}, nil)
+			},
+			expectedErr: experiment.ErrResponseNotFound,
+		},
+		{
+			name:       "already rated",
+			responseID: responseID,
+			rater:      domain.Rater{UserID: userID},
+			mock: func(repository *mockstorage.MockExperimentRepository) {
+				rated := *userResponse
+				rated.Rating = null.Int16From(1)
+
+				repository.EXPECT().GetResponse(gomock.Any(), responseID).Return(&rated, nil)
+			},
+			expectedErr: experiment.ErrAlreadyRated,
+		},
+		{
+			name:       "rated concurrently",
+			responseID: responseID,
+			rater:      domain.Rater{UserID: userID},
+			mock: func(repository *mockstorage.MockExperimentRepository) {
+				repository.EXPECT().GetResponse(gomock.Any(), responseID).Return(userResponse, nil)
+				repository.EXPECT().RateResponse(gomock.Any(), responseID, int16(-1)).Return(false, nil)
+			},
+			expectedErr: experiment.ErrAlreadyRated,
+		},
+		{
+			name:       "unknown response",
+			responseID: responseID,
+			rater:      domain.Rater{UserID: userID},
+			mock: func(repository *mockstorage.MockExperimentRepository) {
+				repository.EXPECT().GetResponse(gomock.Any(), responseID).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: experiment.ErrResponseNotFound,
+		},
+		{
+			name:        "malformed response ID",
+			responseID:  "not-a-uuid",
+			mock:        func(*mockstorage.MockExperimentRepository) {},
+			expectedErr: experiment.ErrResponseNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repository := mockstorage.NewMockExperimentRepository(ctrl)
+			tc.mock(repository)
+
+			service := experiment.NewExperimentService(zaptest.NewLogger(t), repository)
+
+			err := service.Feedback(context.Background(), tc.responseID, tc.rater, tc.helpful)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=mock/repository.go
+//
+
+// Package mock_storage is a generated GoMock package.
+package mock_storage
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	domain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/domain"
+)
+
+// MockExperimentRepository is a mock of ExperimentRepository interface.
+type MockExperimentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExperimentRepositoryMockRecorder
+}
+
+// MockExperimentRepositoryMockRecorder is the mock recorder for MockExperimentRepository.
+type MockExperimentRepositoryMockRecorder struct {
+	mock *MockExperimentRepository
+}
+
+// NewMockExperimentRepository creates a new mock instance.
+func NewMockExperimentRepository(ctrl *gomock.Controller) *MockExperimentRepository {
+	mock := &MockExperimentRepository{ctrl: ctrl}
+	mock.recorder = &MockExperimentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExperimentRepository) EXPECT() *MockExperimentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetResponse mocks base method.
+func (m *MockExperimentRepository) GetResponse(ctx context.Context, responseID string) (*entity.ExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResponse", ctx, responseID)
+	ret0, _ := ret[0].(*entity.ExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResponse indicates an expected call of GetResponse.
+func (mr *MockExperimentRepositoryMockRecorder) GetResponse(ctx, responseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResponse", reflect.TypeOf((*MockExperimentRepository)(nil).GetResponse), ctx, responseID)
+}
+
+// GetResults mocks base method.
+func (m *MockExperimentRepository) GetResults(ctx context.Context, experiment string) ([]domain.VariantResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResults", ctx, experiment)
+	ret0, _ := ret[0].([]domain.VariantResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResults indicates an expected call of GetResults.
+func (mr *MockExperimentRepositoryMockRecorder) GetResults(ctx, experiment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResults", reflect.TypeOf((*MockExperimentRepository)(nil).GetResults), ctx, experiment)
+}
+
+// InsertResponse mocks base method.
+func (m *MockExperimentRepository) InsertResponse(ctx context.Context, response *entity.ExperimentResponse) (*entity.ExperimentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertResponse", ctx, response)
+	ret0, _ := ret[0].(*entity.ExperimentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertResponse indicates an expected call of InsertResponse.
+func (mr *MockExperimentRepositoryMockRecorder) InsertResponse(ctx, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertResponse", reflect.TypeOf((*MockExperimentRepository)(nil).InsertResponse), ctx, response)
+}
+
+// RateResponse mocks base method.
+func (m *MockExperimentRepository) RateResponse(ctx context.Context, responseID string, rating int16) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateResponse", ctx, responseID, rating)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateResponse indicates an expected call of RateResponse.
+func (mr *MockExperimentRepositoryMockRecorder) RateResponse(ctx, responseID, rating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateResponse", reflect.TypeOf((*MockExperimentRepository)(nil).RateResponse), ctx, responseID, rating)
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/domain"
+)
+
+//go:generate mockgen -source=repository.go -destination=mock/repository.go
+type ExperimentRepository interface {
+	InsertResponse(ctx context.Context, response *entity.ExperimentResponse) (*entity.ExperimentResponse, error)
+	// GetResponse returns sql.ErrNoRows when there is no response with that ID.
+	GetResponse(ctx context.Context, responseID string) (*entity.ExperimentResponse, error)
+	// RateResponse rates a response that has not been rated yet, and reports whether it did.
+	RateResponse(ctx context.Context, responseID string, rating int16) (bool, error)
+	GetResults(ctx context.Context, experiment string) ([]domain.VariantResult, error)
+}
+
+type experimentRepository struct {
+	db *sqlx.DB
+}
+
+func NewExperimentRepository(db *sqlx.DB) ExperimentRepository {
+	return &experimentRepository{
+		db: db,
+	}
+}
+
+func (r *experimentRepository) InsertResponse(ctx context.Context, response *entity.ExperimentResponse) (*entity.ExperimentResponse, error) {
+	err := response.Insert(ctx, r.db, boil.Infer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert experiment response: %w", err)
+	}
+
+	return response, nil
+}
+
+func (r *experimentRepository) GetResponse(ctx context.Context, responseID string) (*entity.ExperimentResponse, error) {
+	return entity.FindExperimentResponse(ctx, r.db, responseID)
+}
+
+func (r *experimentRepository) RateResponse(ctx context.Context, responseID string, rating int16) (bool, error) {
+	// The check on rating makes concurrent feedback on the same response count once.
+	updated, err := entity.ExperimentResponses(
+		entity.ExperimentResponseWhere.ID.EQ(responseID),
+		entity.ExperimentResponseWhere.Rating.IsNull(),
+	).UpdateAll(ctx, r.db, entity.M{
+		entity.ExperimentResponseColumns.Rating:    null.Int16From(rating),
+		entity.ExperimentResponseColumns.UpdatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to rate experiment response %s: %w", responseID, err)
+	}
+
+	return updated == 1, nil
+}
+
+const resultsQuery = `
+SELECT variant,
+       COUNT(*)                               AS responses,
+       COALESCE(SUM(prompt_tokens), 0)        AS prompt_tokens,
+       COALESCE(SUM(completion_tokens), 0)    AS completion_tokens,
+       COALESCE(SUM(total_tokens), 0)         AS total_tokens,
+       COUNT(*) FILTER (WHERE rating = 1)     AS thumbs_up,
+       COUNT(*) FILTER (WHERE rating = -1)    AS thumbs_down
+FROM experiment_responses
+WHERE experiment = $1
+GROUP BY variant
+ORDER BY variant`
+
+func (r *experimentRepository) GetResults(ctx context.Context, experiment string) ([]domain.VariantResult, error) {
+	var results []domain.VariantResult
+
+	err := queries.Raw(resultsQuery, experiment).Bind(ctx, r.db, &results)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get results of experiment %s: %w", experiment, err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,149 @@
+package experiment
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/ratelimit"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+)
+
+// ResponseIDHeader lists the IDs of the experiment responses in a response, to give feedback on them.
+const ResponseIDHeader = "X-Experiment-Response-Id"
+
+type trackerKey struct{}
+
+// tracker collects the exposures of one request until they are recorded.
+type tracker struct {
+	// session is the device ID of the request.
+	session   string
+	mu        sync.Mutex
+	exposures []domain.Exposure
+}
+
+// Observe notes that p served the response of the request in ctx, using usage tokens (none when it was cached).
+// It does nothing when p is not part of an experiment or the request is not tracked.
+func Observe(ctx context.Context, p *prompt.Prompt, usage openai.Usage) {
+	t, ok := ctx.Value(trackerKey{}).(*tracker)
+	if !ok || p.Experiment == "" {
+		return
+	}
+
+	exposure := domain.Exposure{
+		Experiment:       p.Experiment,
+		Variant:          p.Variant,
+		PromptID:         p.ID,
+		PromptVersion:    p.Version,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		TotalTokens:      usage.TotalTokens,
+	}
+
+	if userID, err := commonContext.GetUserIDString(ctx); err == nil {
+		exposure.UserID = userID
+	} else {
+		exposure.SessionID = t.session
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.exposures = append(t.exposures, exposure)
+}
+
+func (t *tracker) take() []domain.Exposure {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	exposures := t.exposures
+	t.exposures = nil
+
+	return exposures
+}
+
+// Track records the exposures observed while handling a request. Those observed before the response
+// headers are written are listed in the ResponseIDHeader. Those observed later, as with streamed responses,
+// are recorded once the handler returns.
+func Track(logger *zap.Logger, service Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t := &tracker{session: r.Header.Get(ratelimit.DeviceIDHeader)}
+			ctx := context.WithValue(r.Context(), trackerKey{}, t)
+
+			record := func() []string {
+				var ids []string
+
+				for _, exposure := range t.take() {
+					id, err := service.Record(ctx, exposure)
+					if err != nil {
+						logger.Warn("failed to record experiment response",
+							zap.String("experiment", exposure.Experiment),
+							zap.String("variant", exposure.Variant),
+							zap.Error(err))
+
+						continue
+					}
+
+					ids = append(ids, id)
+				}
+
+				return ids
+			}
+
+			tw := &trackingWriter{ResponseWriter: w, beforeHeader: func() {
+				if ids := record(); len(ids) > 0 {
+					w.Header().Set(ResponseIDHeader, strings.Join(ids, ","))
+				}
+			}}
+
+			next.ServeHTTP(tw, r.WithContext(ctx))
+
+			record()
+		})
+	}
+}
+
+type trackingWriter struct {
+	http.ResponseWriter
+	beforeHeader func()
+	wroteHeader  bool
+}
+
+func (w *trackingWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.beforeHeader()
+	}
+
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *trackingWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush keeps server-sent event streams working through the tracker.
+func (w *trackingWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *trackingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
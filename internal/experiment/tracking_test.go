@@ -0,0 +1,88 @@
+package experiment_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/domain"
+	mockexperiment "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/ratelimit"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+var variant = &prompt.Prompt{ID: "word_lookup_definition_concise", Version: "v1", Experiment: "word_lookup_definition_concise", Variant: "concise"}
+
+func TestTrackListsRecordedResponses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockService := mockexperiment.NewMockService(ctrl)
+
+	mockService.EXPECT().Record(gomock.Any(), domain.Exposure{
+		Experiment:       "word_lookup_definition_concise",
+		Variant:          "concise",
+		PromptID:         "word_lookup_definition_concise",
+		PromptVersion:    "v1",
+		UserID:           "user-1",
+		PromptTokens:     10,
+		CompletionTokens: 5,
+		TotalTokens:      15,
+	}).Return("response-1", nil)
+
+	handler := experiment.Track(zaptest.NewLogger(t), mockService)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Prompts outside of experiments are not recorded.
+		experiment.Observe(r.Context(), &prompt.Prompt{ID: "word_history", Version: "v1"}, openai.Usage{TotalTokens: 3})
+		experiment.Observe(r.Context(), variant, openai.Usage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15})
+
+		render.Json(w, http.StatusOK, "A small feline.")
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/search/word", nil)
+	req.Header.Set(ratelimit.DeviceIDHeader, "device-1")
+	req = req.WithContext(commonContext.SetUserIDString(req.Context(), "user-1"))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "response-1", rec.Header().Get(experiment.ResponseIDHeader))
+}
+
+func TestTrackRecordsResponsesObservedAfterTheHeaders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockService := mockexperiment.NewMockService(ctrl)
+
+	// Anonymous responses are recorded with the device they were served to, which alone can rate them.
+	mockService.EXPECT().Record(gomock.Any(), domain.Exposure{
+		Experiment:    "word_lookup_definition_concise",
+		Variant:       "concise",
+		PromptID:      "word_lookup_definition_concise",
+		PromptVersion: "v1",
+		SessionID:     "device-1",
+	}).Return("response-1", nil)
+
+	handler := experiment.Track(zaptest.NewLogger(t), mockService)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		events, err := render.NewEventStream(w)
+		require.NoError(t, err)
+
+		require.NoError(t, events.Event("token", "A small"))
+		experiment.Observe(r.Context(), variant, openai.Usage{})
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v4/word/definition/stream", nil)
+	req.Header.Set(ratelimit.DeviceIDHeader, "device-1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Empty(t, rec.Header().Get(experiment.ResponseIDHeader))
+	assert.True(t, rec.Flushed)
+}
@@ -0,0 +1,317 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	// Registers the "sqlite" driver that Anki collections are written with.
+	_ "modernc.org/sqlite"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/export/domain"
+)
+
+// ankiModelID identifies the note type of exported words, so that every export shares one note type in Anki.
+const ankiModelID = 1760700000000
+
+// fieldSeparator separates the fields of a note in the notes table.
+const fieldSeparator = "\x1f"
+
+// ankiSchema is the schema of collections of Anki 2.1 before its v18 format, which every version still imports.
+const ankiSchema = `
+CREATE TABLE col (
+	id integer PRIMARY KEY, crt integer NOT NULL, mod integer NOT NULL, scm integer NOT NULL, ver integer NOT NULL,
+	dty integer NOT NULL, usn integer NOT NULL, ls integer NOT NULL, conf text NOT NULL, models text NOT NULL,
+	decks text NOT NULL, dconf text NOT NULL, tags text NOT NULL
+);
+CREATE TABLE notes (
+	id integer PRIMARY KEY, guid text NOT NULL, mid integer NOT NULL, mod integer NOT NULL, usn integer NOT NULL,
+	tags text NOT NULL, flds text NOT NULL, sfld integer NOT NULL, csum integer NOT NULL, flags integer NOT NULL,
+	data text NOT NULL
+);
+CREATE TABLE cards (
+	id integer PRIMARY KEY, nid integer NOT NULL, did integer NOT NULL, ord integer NOT NULL, mod integer NOT NULL,
+	usn integer NOT NULL, type integer NOT NULL, queue integer NOT NULL, due integer NOT NULL, ivl integer NOT NULL,
+	factor integer NOT NULL, reps integer NOT NULL, lapses integer NOT NULL, left integer NOT NULL,
+	odue integer NOT NULL, odid integer NOT NULL, flags integer NOT NULL, data text NOT NULL
+);
+CREATE TABLE revlog (
+	id integer PRIMARY KEY, cid integer NOT NULL, usn integer NOT NULL, ease integer NOT NULL, ivl integer NOT NULL,
+	lastIvl integer NOT NULL, factor integer NOT NULL, time integer NOT NULL, type integer NOT NULL
+);
+CREATE TABLE graves (usn integer NOT NULL, oid integer NOT NULL, type integer NOT NULL);
+CREATE INDEX ix_notes_usn ON notes (usn);
+CREATE INDEX ix_cards_usn ON cards (usn);
+CREATE INDEX ix_revlog_usn ON revlog (usn);
+CREATE INDEX ix_cards_nid ON cards (nid);
+CREATE INDEX ix_cards_sched ON cards (did, queue, due);
+CREATE INDEX ix_revlog_cid ON revlog (cid);
+CREATE INDEX ix_notes_csum ON notes (csum);`
+
+const (
+	ankiFront = `<div class="word">{{Word}}</div>`
+	ankiBack  = `{{FrontSide}}<hr id="answer"><div class="reading">{{Reading}}</div>` +
+		`<div class="definition">{{Definition}}</div><div class="examples">{{Examples}}</div>`
+	ankiCSS = `.card { font-family: sans-serif; font-size: 20px; text-align: center; }
+.word { font-size: 36px; }
+.reading { color: #666; }
+.definition, .examples { margin-top: 12px; text-align: left; }`
+)
+
+// ankiFields are the fields of the note type of exported words.
+var ankiFields = []string{"Word", "Reading", "Definition", "Examples"}
+
+// writeAPKG writes notes as an Anki package: a zip holding the SQLite collection and an empty media list. Each deck
+// of the notes becomes an Anki deck, and each note a new card showing the word, with the rest on its back.
+func writeAPKG(ctx context.Context, w io.Writer, notes []domain.Note, now time.Time) error {
+	dir, err := os.MkdirTemp("", "apkg")
+	if err != nil {
+		return fmt.Errorf("failed to create collection directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "collection.anki2")
+	if err = writeCollection(ctx, path, notes, now); err != nil {
+		return err
+	}
+
+	collection, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read collection: %w", err)
+	}
+
+	archive := zip.NewWriter(w)
+
+	for _, entry := range []struct {
+		name    string
+		content []byte
+	}{{"collection.anki2", collection}, {"media", []byte("{}")}} {
+		file, err := archive.Create(entry.name)
+		if err != nil {
+			return fmt.Errorf("failed to add %s to package: %w", entry.name, err)
+		}
+
+		if _, err = file.Write(entry.content); err != nil {
+			return fmt.Errorf("failed to write %s to package: %w", entry.name, err)
+		}
+	}
+
+	if err = archive.Close(); err != nil {
+		return fmt.Errorf("failed to write package: %w", err)
+	}
+
+	return nil
+}
+
+func writeCollection(ctx context.Context, path string, notes []domain.Note, now time.Time) error {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return fmt.Errorf("failed to open collection: %w", err)
+	}
+	defer db.Close()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin collection transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err = tx.ExecContext(ctx, ankiSchema); err != nil {
+		return fmt.Errorf("failed to create collection schema: %w", err)
+	}
+
+	deckIDs := map[string]int64{}
+	for _, note := range notes {
+		deckIDs[note.Deck] = ankiDeckID(note.Deck)
+	}
+
+	col, err := ankiCollection(deckIDs, len(notes), now)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO col VALUES (1, ?, ?, ?, 11, 0, 0, 0, ?, ?, ?, ?, '{}')`,
+		now.Unix(), now.UnixMilli(), now.UnixMilli(), col.conf, col.models, col.decks, col.dconf)
+	if err != nil {
+		return fmt.Errorf("failed to insert collection: %w", err)
+	}
+
+	// Note and card IDs are creation times in milliseconds in Anki, which only need to be unique.
+	firstID := now.UnixMilli()
+
+	for i, note := range notes {
+		id := firstID + int64(i)
+		fields := ankiNoteFields(note)
+
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO notes VALUES (?, ?, ?, ?, -1, '', ?, ?, ?, 0, '')`,
+			id, note.EntryID, ankiModelID, now.Unix(), strings.Join(fields, fieldSeparator), note.Word,
+			checksum(note.Word))
+		if err != nil {
+			return fmt.Errorf("failed to insert note of %q: %w", note.Word, err)
+		}
+
+		// A new card, due in the order of the notes.
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO cards VALUES (?, ?, ?, 0, ?, -1, 0, 0, ?, 0, 0, 0, 0, 0, 0, 0, 0, '')`,
+			id, id, deckIDs[note.Deck], now.Unix(), i+1)
+		if err != nil {
+			return fmt.Errorf("failed to insert card of %q: %w", note.Word, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit collection: %w", err)
+	}
+
+	return nil
+}
+
+// ankiNoteFields returns the fields of the note, in the order of ankiFields, as the HTML Anki shows.
+func ankiNoteFields(note domain.Note) []string {
+	var definition strings.Builder
+	if note.PartOfSpeech != "" {
+		definition.WriteString("<i>" + html.EscapeString(note.PartOfSpeech) + "</i>")
+	}
+
+	if len(note.Senses) > 0 {
+		definition.WriteString("<ol>")
+		for _, sense := range note.Senses {
+			definition.WriteString("<li>" + html.EscapeString(sense) + "</li>")
+		}
+		definition.WriteString("</ol>")
+	}
+
+	examples := make([]string, 0, len(note.Examples))
+	for _, example := range note.Examples {
+		text := html.EscapeString(example.Sentence)
+		if example.Translation != "" {
+			text += "<br><i>" + html.EscapeString(example.Translation) + "</i>"
+		}
+
+		examples = append(examples, text)
+	}
+
+	return []string{
+		html.EscapeString(note.Word),
+		html.EscapeString(note.Reading),
+		definition.String(),
+		strings.Join(examples, "<br><br>"),
+	}
+}
+
+// ankiDeckID derives the ID of a deck from its name, so that exporting a deck again imports into the same Anki deck.
+// IDs 1 and below are kept for Anki's default deck.
+func ankiDeckID(name string) int64 {
+	hash := fnv.New32a()
+	hash.Write([]byte(name))
+
+	return 1<<32 + int64(hash.Sum32())
+}
+
+// checksum is the note checksum Anki finds duplicates with: the first 8 hex digits of the SHA-1 of the sort field.
+func checksum(field string) int64 {
+	sum := sha1.Sum([]byte(field))
+
+	return int64(binary.BigEndian.Uint32(sum[:4]))
+}
+
+type collection struct {
+	conf, models, decks, dconf string
+}
+
+// ankiCollection returns the JSON columns of the col table, with the note type of exported words and the decks.
+func ankiCollection(deckIDs map[string]int64, noteCount int, now time.Time) (*collection, error) {
+	fields := make([]map[string]any, 0, len(ankiFields))
+	for i, name := range ankiFields {
+		fields = append(fields, map[string]any{
+			"name": name, "ord": i, "sticky": false, "rtl": false, "font": "Arial", "size": 20, "media": []string{},
+		})
+	}
+
+	models := map[string]any{
+		fmt.Sprint(ankiModelID): map[string]any{
+			"id":    ankiModelID,
+			"name":  "My Language Aibou",
+			"type":  0,
+			"mod":   now.Unix(),
+			"usn":   -1,
+			"sortf": 0,
+			"did":   1,
+			"tmpls": []map[string]any{{
+				"name": "Recognition", "ord": 0, "qfmt": ankiFront, "afmt": ankiBack, "did": nil,
+				"bqfmt": "", "bafmt": "",
+			}},
+			"flds":      fields,
+			"css":       ankiCSS,
+			"latexPre":  "\\documentclass[12pt]{article}\n\\pagestyle{empty}\n\\begin{document}\n",
+			"latexPost": "\\end{document}",
+			"latexsvg":  false,
+			// The card is generated when the word is not empty.
+			"req":  []any{[]any{0, "any", []int{0}}},
+			"tags": []string{},
+			"vers": []any{},
+		},
+	}
+
+	decks := map[string]any{"1": ankiDeck(1, "Default", now)}
+	for name, id := range deckIDs {
+		decks[fmt.Sprint(id)] = ankiDeck(id, name, now)
+	}
+
+	dconf := map[string]any{
+		"1": map[string]any{
+			"id": 1, "name": "Default", "mod": 0, "usn": 0, "maxTaken": 60, "autoplay": true, "timer": 0,
+			"replayq": true, "dyn": false,
+			"new": map[string]any{
+				"bury": false, "delays": []int{1, 10}, "initialFactor": 2500, "ints": []int{1, 4, 0}, "order": 1,
+				"perDay": 20,
+			},
+			"lapse": map[string]any{"delays": []int{10}, "leechAction": 1, "leechFails": 8, "minInt": 1, "mult": 0},
+			"rev": map[string]any{
+				"bury": false, "ease4": 1.3, "ivlFct": 1, "maxIvl": 36500, "perDay": 200, "hardFactor": 1.2,
+			},
+		},
+	}
+
+	conf := map[string]any{
+		"activeDecks": []int{1}, "curDeck": 1, "newSpread": 0, "collapseTime": 1200, "timeLim": 0,
+		"estTimes": true, "dueCounts": true, "curModel": ankiModelID, "nextPos": noteCount + 1,
+		"sortType": "noteFld", "sortBackwards": false, "addToCur": true,
+	}
+
+	result := &collection{}
+	for _, column := range []struct {
+		value  any
+		target *string
+	}{{conf, &result.conf}, {models, &result.models}, {decks, &result.decks}, {dconf, &result.dconf}} {
+		encoded, err := json.Marshal(column.value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal collection: %w", err)
+		}
+
+		*column.target = string(encoded)
+	}
+
+	return result, nil
+}
+
+func ankiDeck(id int64, name string, now time.Time) map[string]any {
+	return map[string]any{
+		"id": id, "name": name, "mod": now.Unix(), "usn": -1, "desc": "", "dyn": 0, "conf": 1,
+		"collapsed": false, "browserCollapsed": false, "extendNew": 0, "extendRev": 0,
+		"newToday": []int{0, 0}, "revToday": []int{0, 0}, "lrnToday": []int{0, 0}, "timeToday": []int{0, 0},
+	}
+}
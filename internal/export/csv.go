@@ -0,0 +1,56 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/export/domain"
+)
+
+// csvHeader names the columns of CSV and TSV exports.
+var csvHeader = []string{"deck", "word", "reading", "part_of_speech", "definition", "examples"}
+
+// writeCSV writes notes as a table with a header row, one row per note, with fields separated by comma. Senses and
+// examples are joined on one line so that every row is a single line, which spreadsheet and Anki imports expect.
+func writeCSV(w io.Writer, notes []domain.Note, comma rune) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+
+	if err := writer.Write(csvHeader); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	for _, note := range notes {
+		examples := make([]string, 0, len(note.Examples))
+		for _, example := range note.Examples {
+			text := example.Sentence
+			if example.Translation != "" {
+				text += " (" + example.Translation + ")"
+			}
+
+			examples = append(examples, text)
+		}
+
+		err := writer.Write([]string{
+			note.Deck,
+			note.Word,
+			note.Reading,
+			note.PartOfSpeech,
+			strings.Join(note.Senses, "; "),
+			strings.Join(examples, " / "),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write row of %q: %w", note.Word, err)
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write rows: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package domain
+
+// Formats of exports.
+const (
+	// FormatAPKG is an Anki deck package, importable into Anki and AnkiDroid.
+	FormatAPKG = "apkg"
+	FormatCSV  = "csv"
+	FormatTSV  = "tsv"
+)
+
+// Formats are the formats of exports.
+var Formats = []string{FormatAPKG, FormatCSV, FormatTSV}
+
+// File is an export, ready to be downloaded.
+type File struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// Note is a saved word as it is exported: one note per deck entry.
+type Note struct {
+	// EntryID identifies the note, so that exporting a deck again updates the notes imported before.
+	EntryID string
+	Deck    string
+	Word    string
+	// Reading joins the readings of the word, e.g. its furigana and romanization.
+	Reading      string
+	PartOfSpeech string
+	// Senses are the glosses of the word, with their usage notes in brackets.
+	Senses   []string
+	Examples []Example
+}
+
+type Example struct {
+	Sentence    string
+	Translation string
+}
@@ -0,0 +1,158 @@
+// Package export writes the words users saved in their decks as Anki packages and as CSV or TSV tables.
+package export
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	decksdomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/export/domain"
+)
+
+// allDecksName names the exports of every deck of a user.
+const allDecksName = "my-language-aibou"
+
+var ErrInvalidFormat = fmt.Errorf("export formats are %s", strings.Join(domain.Formats, ", "))
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+type Service interface {
+	// Export returns the entries of the deck in format, or those of every deck of the user when deckID is empty. It
+	// returns decks.ErrDeckNotFound when the user has no deck with that ID.
+	Export(ctx context.Context, userID, deckID, format string) (*domain.File, error)
+}
+
+type service struct {
+	logger      *zap.Logger
+	deckService decks.Service
+	now         func() time.Time
+}
+
+// NewExportService returns the export Service. Anki packages are dated with the times now returns, which is
+// time.Now outside tests.
+func NewExportService(logger *zap.Logger, deckService decks.Service, now func() time.Time) Service {
+	return &service{
+		logger:      logger,
+		deckService: deckService,
+		now:         now,
+	}
+}
+
+func (s *service) Export(ctx context.Context, userID, deckID, format string) (*domain.File, error) {
+	if !slices.Contains(domain.Formats, format) {
+		return nil, ErrInvalidFormat
+	}
+
+	name := allDecksName
+
+	var deckList []decksdomain.Deck
+
+	if deckID != "" {
+		deck, err := s.deckService.GetDeck(ctx, userID, deckID)
+		if err != nil {
+			return nil, err
+		}
+
+		name = deck.Name
+		deckList = append(deckList, *deck)
+	} else {
+		summaries, err := s.deckService.ListDecks(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range summaries {
+			deck, err := s.deckService.GetDeck(ctx, userID, summary.ID)
+			if errors.Is(err, decks.ErrDeckNotFound) {
+				// Deleted since it was listed.
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			deckList = append(deckList, *deck)
+		}
+	}
+
+	var notes []domain.Note
+	for _, deck := range deckList {
+		for _, entry := range deck.Entries {
+			notes = append(notes, toNote(deck.Name, entry))
+		}
+	}
+
+	var buffer bytes.Buffer
+
+	file := &domain.File{Name: name + "." + format}
+
+	switch format {
+	case domain.FormatAPKG:
+		file.ContentType = "application/octet-stream"
+		if err := writeAPKG(ctx, &buffer, notes, s.now().UTC()); err != nil {
+			return nil, fmt.Errorf("failed to write Anki package of user %s: %w", userID, err)
+		}
+	case domain.FormatCSV:
+		file.ContentType = "text/csv; charset=utf-8"
+		if err := writeCSV(&buffer, notes, ','); err != nil {
+			return nil, fmt.Errorf("failed to write CSV of user %s: %w", userID, err)
+		}
+	case domain.FormatTSV:
+		file.ContentType = "text/tab-separated-values; charset=utf-8"
+		if err := writeCSV(&buffer, notes, '\t'); err != nil {
+			return nil, fmt.Errorf("failed to write TSV of user %s: %w", userID, err)
+		}
+	}
+
+	s.logger.Sugar().Infow("exported words", "userID", userID, "deckID", deckID, "format", format,
+		"notes", len(notes))
+
+	file.Content = buffer.Bytes()
+
+	return file, nil
+}
+
+// toNote returns the note of an entry, from the lookup saved with it.
+func toNote(deckName string, entry decksdomain.Entry) domain.Note {
+	note := domain.Note{
+		EntryID: entry.ID,
+		Deck:    deckName,
+		Word:    entry.Word,
+	}
+
+	definition := entry.Details.Definition
+	if definition == nil {
+		return note
+	}
+
+	readings := make([]string, 0, len(definition.Readings))
+	for _, reading := range definition.Readings {
+		readings = append(readings, reading.Value)
+	}
+
+	note.Reading = strings.Join(readings, " / ")
+	note.PartOfSpeech = definition.PartOfSpeech
+
+	for _, sense := range definition.Senses {
+		gloss := sense.Gloss
+		if sense.Notes != "" {
+			gloss += " [" + sense.Notes + "]"
+		}
+
+		note.Senses = append(note.Senses, gloss)
+	}
+
+	for _, example := range definition.Examples {
+		note.Examples = append(note.Examples, domain.Example{Sentence: example.Sentence, Translation: example.Translation})
+	}
+
+	return note
+}
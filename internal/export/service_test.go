@@ -0,0 +1,168 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	decksdomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/domain"
+	decksmock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/export"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/export/domain"
+	worddomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
+)
+
+const (
+	userID = "user-1"
+	deckID = "6f1c2d4e-0d1a-4a57-9b1e-3f6a2b7c8d90"
+)
+
+var now = time.Date(2025, 10, 17, 8, 0, 0, 0, time.UTC)
+
+func newService(t *testing.T) (export.Service, *decksmock.MockService) {
+	deckService := decksmock.NewMockService(gomock.NewController(t))
+
+	return export.NewExportService(zaptest.NewLogger(t), deckService, func() time.Time { return now }), deckService
+}
+
+func japaneseDeck() *decksdomain.Deck {
+	return &decksdomain.Deck{
+		ID:   deckID,
+		Name: "JLPT N5",
+		Entries: []decksdomain.Entry{
+			{ID: "entry-1", Word: "猫", Details: worddomain.LookupDetails{Definition: &worddomain.Definition{
+				PartOfSpeech: "noun",
+				Readings:     []worddomain.Reading{{System: "furigana", Value: "ねこ"}, {System: "romanization", Value: "neko"}},
+				Senses:       []worddomain.Sense{{Gloss: "cat"}, {Gloss: "shamisen player", Notes: "archaic"}},
+				Examples:     []worddomain.Example{{Sentence: "猫が寝ている。", Translation: "The cat is sleeping."}},
+			}}},
+			{ID: "entry-2", Word: "<犬>"},
+		},
+	}
+}
+
+func TestExportAPKG(t *testing.T) {
+	service, deckService := newService(t)
+	deckService.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(japaneseDeck(), nil)
+
+	file, err := service.Export(context.Background(), userID, deckID, domain.FormatAPKG)
+	require.NoError(t, err)
+	assert.Equal(t, "JLPT N5.apkg", file.Name)
+
+	archive, err := zip.NewReader(bytes.NewReader(file.Content), int64(len(file.Content)))
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+	for _, f := range archive.File {
+		reader, err := f.Open()
+		require.NoError(t, err)
+
+		files[f.Name], err = io.ReadAll(reader)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, "{}", string(files["media"]))
+
+	path := filepath.Join(t.TempDir(), "collection.anki2")
+	require.NoError(t, os.WriteFile(path, files["collection.anki2"], 0o600))
+
+	db, err := sql.Open("sqlite", path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var models, decksJSON string
+	require.NoError(t, db.QueryRow(`SELECT models, decks FROM col`).Scan(&models, &decksJSON))
+
+	var ankiDecks map[string]struct{ Name string }
+	require.NoError(t, json.Unmarshal([]byte(decksJSON), &ankiDecks))
+
+	var names []string
+	for _, deck := range ankiDecks {
+		names = append(names, deck.Name)
+	}
+
+	assert.ElementsMatch(t, []string{"Default", "JLPT N5"}, names)
+	assert.Contains(t, models, `"name":"Definition"`)
+
+	rows, err := db.Query(`SELECT notes.guid, notes.flds, cards.due FROM notes JOIN cards ON cards.nid = notes.id
+		ORDER BY cards.due`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var notes [][]string
+	for rows.Next() {
+		var guid, fields string
+		var due int
+		require.NoError(t, rows.Scan(&guid, &fields, &due))
+		notes = append(notes, append([]string{guid}, strings.Split(fields, "\x1f")...))
+	}
+	require.NoError(t, rows.Err())
+
+	assert.Equal(t, [][]string{
+		{"entry-1", "猫", "ねこ / neko", "<i>noun</i><ol><li>cat</li><li>shamisen player [archaic]</li></ol>",
+			"猫が寝ている。<br><i>The cat is sleeping.</i>"},
+		{"entry-2", "&lt;犬&gt;", "", "", ""},
+	}, notes)
+}
+
+func TestExportTables(t *testing.T) {
+	testCases := map[string]rune{domain.FormatCSV: ',', domain.FormatTSV: '\t'}
+
+	for format, comma := range testCases {
+		t.Run(format, func(t *testing.T) {
+			service, deckService := newService(t)
+			deckService.EXPECT().ListDecks(gomock.Any(), userID).Return([]decksdomain.Deck{
+				{ID: deckID}, {ID: "deleted"},
+			}, nil)
+			deckService.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(japaneseDeck(), nil)
+			deckService.EXPECT().GetDeck(gomock.Any(), userID, "deleted").Return(nil, decks.ErrDeckNotFound)
+
+			file, err := service.Export(context.Background(), userID, "", format)
+			require.NoError(t, err)
+			assert.Equal(t, "my-language-aibou."+format, file.Name)
+
+			reader := csv.NewReader(bytes.NewReader(file.Content))
+			reader.Comma = comma
+
+			records, err := reader.ReadAll()
+			require.NoError(t, err)
+			assert.Equal(t, [][]string{
+				{"deck", "word", "reading", "part_of_speech", "definition", "examples"},
+				{"JLPT N5", "猫", "ねこ / neko", "noun", "cat; shamisen player [archaic]", "猫が寝ている。 (The cat is sleeping.)"},
+				{"JLPT N5", "<犬>", "", "", "", ""},
+			}, records)
+		})
+	}
+}
+
+func TestExportRefusals(t *testing.T) {
+	t.Run("unknown format", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.Export(context.Background(), userID, deckID, "xlsx")
+		assert.ErrorIs(t, err, export.ErrInvalidFormat)
+	})
+
+	t.Run("deck of another user", func(t *testing.T) {
+		service, deckService := newService(t)
+		deckService.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(nil, decks.ErrDeckNotFound)
+
+		_, err := service.Export(context.Background(), userID, deckID, domain.FormatCSV)
+		assert.ErrorIs(t, err, decks.ErrDeckNotFound)
+	})
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// Entry is a word or sentence a user looked up.
+type Entry struct {
+	ID             string
+	Kind           string
+	Query          string
+	NativeLanguage string
+	// Result is the JSON body the lookup was answered with.
+	Result    string
+	CreatedAt time.Time
+}
+
+// Filter selects a page of the history of a user, newest first.
+type Filter struct {
+	// Search matches the entries whose query contains it, ignoring case. Empty matches every entry.
+	Search string
+	// Kind matches the entries of that kind, or of every kind in that group, e.g. "word". Empty matches every kind.
+	Kind string
+	// Page starts at 1.
+	Page     int
+	PageSize int
+}
+
+// Page is a page of the history of a user, with the number of entries matching the filter across all pages.
+type Page struct {
+	Entries  []Entry
+	Page     int
+	PageSize int
+	Total    int
+}
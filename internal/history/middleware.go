@@ -0,0 +1,103 @@
+package history
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/domain"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+)
+
+// maxQueryBody is how much of a request body is read to find the query. Word and sentence requests are far smaller.
+const maxQueryBody = 64 * 1024
+
+// maxResult is the largest answer kept in the history. Answers are a few kilobytes, and larger ones are not recorded.
+const maxResult = 32 * 1024
+
+// lookupRequest has the fields of the word and sentence request bodies that make up an entry.
+type lookupRequest struct {
+	Word           string `json:"word"`
+	Sentence       string `json:"sentence"`
+	NativeLanguage string `json:"nativeLanguage"`
+}
+
+// Record adds the lookups signed-in users make on a route to their history as kind, with the body they are answered
+// with. Only successful lookups are recorded, and a failure to record them does not fail the request. It must run
+// after the auth middleware.
+func Record(logger *zap.Logger, service Service, kind string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			userID, err := commonContext.GetUserIDString(ctx)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// The handler still reads the whole body, however much of it is read here.
+			raw, _ := io.ReadAll(io.LimitReader(r.Body, maxQueryBody))
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(raw), r.Body), r.Body}
+
+			result := cappedBuffer{limit: maxResult}
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&result)
+
+			next.ServeHTTP(ww, r)
+
+			if ww.Status() != http.StatusOK {
+				return
+			}
+
+			if result.overflowed {
+				logger.Warn("lookup too large for the history", zap.String("userID", userID), zap.String("kind", kind))
+				return
+			}
+
+			var lookup lookupRequest
+			if err := json.Unmarshal(raw, &lookup); err != nil {
+				return
+			}
+
+			err = service.Record(context.WithoutCancel(ctx), userID, domain.Entry{
+				Kind:           kind,
+				Query:          strings.TrimSpace(cmp.Or(lookup.Word, lookup.Sentence)),
+				NativeLanguage: lookup.NativeLanguage,
+				Result:         result.String(),
+			})
+			if err != nil {
+				logger.Error("failed to record lookup history", zap.String("userID", userID), zap.String("kind", kind), zap.Error(err))
+			}
+		})
+	}
+}
+
+// cappedBuffer keeps what is written to it until it would hold more than limit bytes, and then stops keeping it.
+type cappedBuffer struct {
+	bytes.Buffer
+	limit      int
+	overflowed bool
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if b.overflowed || b.Len()+len(p) > b.limit {
+		b.overflowed = true
+		b.Reset()
+
+		return len(p), nil
+	}
+
+	return b.Buffer.Write(p)
+}
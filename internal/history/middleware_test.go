@@ -0,0 +1,122 @@
+package history_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/domain"
+	mockhistory "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/mock"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+const definitionBody = `{"word": " gato ", "nativeLanguage": "English"}`
+
+// newRouter serves POST /word/definition through Record, answering with status and echoing the word it was sent.
+func newRouter(t *testing.T, service history.Service, userID string, status int) http.Handler {
+	router := chi.NewRouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if userID != "" {
+				r = r.WithContext(commonContext.SetUserIDString(r.Context(), userID))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	})
+	router.With(history.Record(zaptest.NewLogger(t), service, history.KindWordDefinition)).
+		Post("/word/definition", func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Word string `json:"word"`
+			}
+
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			render.Json(w, status, "Definition of "+body.Word)
+		})
+
+	return router
+}
+
+func serve(handler http.Handler) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/word/definition", strings.NewReader(definitionBody)))
+
+	return rec
+}
+
+func TestRecordAddsSuccessfulLookups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mockhistory.NewMockService(ctrl)
+
+	service.EXPECT().Record(gomock.Any(), "user-1", domain.Entry{
+		Kind:           history.KindWordDefinition,
+		Query:          "gato",
+		NativeLanguage: "English",
+		Result:         `"Definition of  gato "`,
+	}).Return(nil)
+
+	rec := serve(newRouter(t, service, "user-1", http.StatusOK))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body, _ := io.ReadAll(rec.Body)
+	assert.Equal(t, `"Definition of  gato "`, string(body), "the handler reads the whole body")
+}
+
+func TestRecordSkips(t *testing.T) {
+	testCases := []struct {
+		name   string
+		userID string
+		status int
+	}{
+		{name: "anonymous lookups", status: http.StatusOK},
+		{name: "failed lookups", userID: "user-1", status: http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := mockhistory.NewMockService(ctrl)
+
+			rec := serve(newRouter(t, service, tc.userID, tc.status))
+
+			assert.Equal(t, tc.status, rec.Code)
+		})
+	}
+}
+
+func TestRecordSkipsLargeAnswers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mockhistory.NewMockService(ctrl)
+
+	word := strings.Repeat("a", 40*1024)
+
+	rec := httptest.NewRecorder()
+	newRouter(t, service, "user-1", http.StatusOK).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/word/definition",
+		strings.NewReader(`{"word": "`+word+`", "nativeLanguage": "English"}`)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `"Definition of `+word+`"`, rec.Body.String(), "the answer is not cut")
+}
+
+func TestRecordDoesNotFailTheLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mockhistory.NewMockService(ctrl)
+	service.EXPECT().Record(gomock.Any(), "user-1", gomock.Any()).Return(errors.New("connection refused"))
+
+	rec := serve(newRouter(t, service, "user-1", http.StatusOK))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
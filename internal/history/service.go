@@ -0,0 +1,164 @@
+// Package history keeps the words and sentences each signed-in user looked up, with the answers they were given,
+// so that they can find them again.
+package history
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/storage"
+)
+
+// Kinds of lookups. Every kind starts with its group, "word" or "sentence", which the history can be filtered by.
+const (
+	KindWordDefinition      = "word_definition"
+	KindWordSynonyms        = "word_synonyms"
+	KindWordHistory         = "word_history"
+	KindSentenceExplanation = "sentence_explanation"
+	KindSentenceCorrection  = "sentence_correction"
+)
+
+// Page sizes of List.
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// MaxEntries is how many entries the history of a user keeps. Recording a lookup deletes the oldest ones beyond it.
+const MaxEntries = 1000
+
+var ErrEntryNotFound = errors.New("history entry not found")
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+type Service interface {
+	Record(ctx context.Context, userID string, entry domain.Entry) error
+	// List returns a page of the history of the user. Pages below 1 are the first page, and page sizes outside
+	// 1 to MaxPageSize are DefaultPageSize or MaxPageSize.
+	List(ctx context.Context, userID string, filter domain.Filter) (*domain.Page, error)
+	// Get returns ErrEntryNotFound when the user has no entry with that ID.
+	Get(ctx context.Context, userID, entryID string) (*domain.Entry, error)
+	// Delete returns ErrEntryNotFound when the user has no entry with that ID.
+	Delete(ctx context.Context, userID, entryID string) error
+	// Clear deletes the whole history of the user and returns how many entries it had.
+	Clear(ctx context.Context, userID string) (int, error)
+}
+
+type service struct {
+	logger     *zap.Logger
+	repository storage.HistoryRepository
+}
+
+func NewHistoryService(logger *zap.Logger, repository storage.HistoryRepository) Service {
+	return &service{
+		logger:     logger,
+		repository: repository,
+	}
+}
+
+func (s *service) Record(ctx context.Context, userID string, entry domain.Entry) error {
+	_, err := s.repository.Insert(ctx, &entity.LookupHistory{
+		UserID:         userID,
+		Kind:           entry.Kind,
+		Query:          entry.Query,
+		NativeLanguage: entry.NativeLanguage,
+		Result:         entry.Result,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The lookup is recorded even when the old entries could not be deleted, the next lookup deletes them.
+	_, err = s.repository.Prune(ctx, userID, MaxEntries)
+	if err != nil {
+		s.logger.Warn("failed to prune lookup history", zap.String("userID", userID), zap.Error(err))
+	}
+
+	return nil
+}
+
+func (s *service) List(ctx context.Context, userID string, filter domain.Filter) (*domain.Page, error) {
+	filter.Page = max(filter.Page, 1)
+
+	switch {
+	case filter.PageSize < 1:
+		filter.PageSize = DefaultPageSize
+	case filter.PageSize > MaxPageSize:
+		filter.PageSize = MaxPageSize
+	}
+
+	entries, total, err := s.repository.List(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.Page{
+		Entries:  make([]domain.Entry, 0, len(entries)),
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+		Total:    total,
+	}
+
+	for _, entry := range entries {
+		page.Entries = append(page.Entries, toDomain(entry))
+	}
+
+	return page, nil
+}
+
+func (s *service) Get(ctx context.Context, userID, entryID string) (*domain.Entry, error) {
+	if _, err := uuid.Parse(entryID); err != nil {
+		return nil, ErrEntryNotFound
+	}
+
+	entry, err := s.repository.Get(ctx, userID, entryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrEntryNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history entry %s: %w", entryID, err)
+	}
+
+	result := toDomain(entry)
+
+	return &result, nil
+}
+
+func (s *service) Delete(ctx context.Context, userID, entryID string) error {
+	if _, err := uuid.Parse(entryID); err != nil {
+		return ErrEntryNotFound
+	}
+
+	deleted, err := s.repository.Delete(ctx, userID, entryID)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrEntryNotFound
+	}
+
+	return nil
+}
+
+func (s *service) Clear(ctx context.Context, userID string) (int, error) {
+	return s.repository.DeleteAll(ctx, userID)
+}
+
+func toDomain(entry *entity.LookupHistory) domain.Entry {
+	return domain.Entry{
+		ID:             entry.ID,
+		Kind:           entry.Kind,
+		Query:          entry.Query,
+		NativeLanguage: entry.NativeLanguage,
+		Result:         entry.Result,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
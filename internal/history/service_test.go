@@ -0,0 +1,119 @@
+package history_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/domain"
+	mockstorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/storage/mock"
+)
+
+const (
+	userID  = "user-1"
+	entryID = "1b4e28ba-2fa1-11d2-883f-0016d3cca427"
+)
+
+func TestList(t *testing.T) {
+	createdAt := time.Date(2025, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		filter         domain.Filter
+		expectedFilter domain.Filter
+	}{
+		{
+			name:           "defaults",
+			filter:         domain.Filter{Search: "gato"},
+			expectedFilter: domain.Filter{Search: "gato", Page: 1, PageSize: history.DefaultPageSize},
+		},
+		{
+			name:           "page size above the maximum",
+			filter:         domain.Filter{Kind: "word", Page: 3, PageSize: 1000},
+			expectedFilter: domain.Filter{Kind: "word", Page: 3, PageSize: history.MaxPageSize},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repository := mockstorage.NewMockHistoryRepository(ctrl)
+			service := history.NewHistoryService(zaptest.NewLogger(t), repository)
+
+			repository.EXPECT().List(gomock.Any(), userID, tc.expectedFilter).Return(entity.LookupHistorySlice{{
+				ID:             entryID,
+				UserID:         userID,
+				Kind:           history.KindWordDefinition,
+				Query:          "gato",
+				NativeLanguage: "English",
+				Result:         `"A cat."`,
+				CreatedAt:      createdAt,
+			}}, 41, nil)
+
+			page, err := service.List(context.Background(), userID, tc.filter)
+			require.NoError(t, err)
+
+			assert.Equal(t, &domain.Page{
+				Entries: []domain.Entry{{
+					ID:             entryID,
+					Kind:           history.KindWordDefinition,
+					Query:          "gato",
+					NativeLanguage: "English",
+					Result:         `"A cat."`,
+					CreatedAt:      createdAt,
+				}},
+				Page:     tc.expectedFilter.Page,
+				PageSize: tc.expectedFilter.PageSize,
+				Total:    41,
+			}, page)
+		})
+	}
+}
+
+func TestEntriesOfOtherUsersAreNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repository := mockstorage.NewMockHistoryRepository(ctrl)
+	service := history.NewHistoryService(zaptest.NewLogger(t), repository)
+
+	repository.EXPECT().Get(gomock.Any(), userID, entryID).Return(nil, sql.ErrNoRows)
+	repository.EXPECT().Delete(gomock.Any(), userID, entryID).Return(0, nil)
+
+	_, err := service.Get(context.Background(), userID, entryID)
+	assert.ErrorIs(t, err, history.ErrEntryNotFound)
+
+	assert.ErrorIs(t, service.Delete(context.Background(), userID, entryID), history.ErrEntryNotFound)
+
+	// Malformed IDs never reach the database.
+	assert.ErrorIs(t, service.Delete(context.Background(), userID, "not-an-id"), history.ErrEntryNotFound)
+}
+
+func TestRecordPrunesTheHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repository := mockstorage.NewMockHistoryRepository(ctrl)
+	service := history.NewHistoryService(zaptest.NewLogger(t), repository)
+
+	entry := domain.Entry{Kind: history.KindWordDefinition, Query: "gato", NativeLanguage: "English", Result: `"A cat."`}
+
+	gomock.InOrder(
+		repository.EXPECT().Insert(gomock.Any(), &entity.LookupHistory{
+			UserID:         userID,
+			Kind:           entry.Kind,
+			Query:          entry.Query,
+			NativeLanguage: entry.NativeLanguage,
+			Result:         entry.Result,
+		}).Return(&entity.LookupHistory{}, nil),
+		repository.EXPECT().Prune(gomock.Any(), userID, history.MaxEntries).Return(0, errors.New("connection refused")),
+	)
+
+	// A failed prune does not fail the recording.
+	require.NoError(t, service.Record(context.Background(), userID, entry))
+}
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history/domain"
+)
+
+//go:generate mockgen -source=repository.go -destination=mock/repository.go
+type HistoryRepository interface {
+	Insert(ctx context.Context, entry *entity.LookupHistory) (*entity.LookupHistory, error)
+	// List returns the entries of the user matching filter on the page, newest first, and how many match in total.
+	List(ctx context.Context, userID string, filter domain.Filter) (entity.LookupHistorySlice, int, error)
+	// Get returns sql.ErrNoRows when the user has no entry with that ID.
+	Get(ctx context.Context, userID, entryID string) (*entity.LookupHistory, error)
+	// Delete returns the number of entries deleted, 0 when the user has no entry with that ID.
+	Delete(ctx context.Context, userID, entryID string) (int, error)
+	// DeleteAll returns the number of entries deleted.
+	DeleteAll(ctx context.Context, userID string) (int, error)
+	// Prune deletes all but the newest keep entries of the user, and returns the number of entries deleted.
+	Prune(ctx context.Context, userID string, keep int) (int, error)
+}
+
+type historyRepository struct {
+	db *sqlx.DB
+}
+
+func NewHistoryRepository(db *sqlx.DB) HistoryRepository {
+	return &historyRepository{
+		db: db,
+	}
+}
+
+func (r *historyRepository) Insert(ctx context.Context, entry *entity.LookupHistory) (*entity.LookupHistory, error) {
+	err := entry.Insert(ctx, r.db, boil.Infer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert lookup history: %w", err)
+	}
+
+	return entry, nil
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *historyRepository) List(ctx context.Context, userID string, filter domain.Filter) (entity.LookupHistorySlice, int, error) {
+	mods := []qm.QueryMod{
+		entity.LookupHistoryWhere.UserID.EQ(userID),
+	}
+
+	if filter.Kind != "" {
+		mods = append(mods, qm.Where("(kind = ? OR kind LIKE ?)", filter.Kind, likeEscaper.Replace(filter.Kind)+`\_%`))
+	}
+
+	if filter.Search != "" {
+		mods = append(mods, qm.Where("query ILIKE ?", "%"+likeEscaper.Replace(filter.Search)+"%"))
+	}
+
+	total, err := entity.LookupHistories(mods...).Count(ctx, r.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count lookup history of user %s: %w", userID, err)
+	}
+
+	mods = append(mods,
+		qm.OrderBy(entity.LookupHistoryColumns.CreatedAt+" DESC, "+entity.LookupHistoryColumns.ID),
+		qm.Limit(filter.PageSize),
+		qm.Offset((filter.Page-1)*filter.PageSize),
+	)
+
+	entries, err := entity.LookupHistories(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list lookup history of user %s: %w", userID, err)
+	}
+
+	return entries, int(total), nil
+}
+
+func (r *historyRepository) Get(ctx context.Context, userID, entryID string) (*entity.LookupHistory, error) {
+	return entity.LookupHistories(
+		entity.LookupHistoryWhere.ID.EQ(entryID),
+		entity.LookupHistoryWhere.UserID.EQ(userID),
+	).One(ctx, r.db)
+}
+
+func (r *historyRepository) Delete(ctx context.Context, userID, entryID string) (int, error) {
+	deleted, err := entity.LookupHistories(
+		entity.LookupHistoryWhere.ID.EQ(entryID),
+		entity.LookupHistoryWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete lookup history %s: %w", entryID, err)
+	}
+
+	return int(deleted), nil
+}
+
+func (r *historyRepository) DeleteAll(ctx context.Context, userID string) (int, error) {
+	deleted, err := entity.LookupHistories(entity.LookupHistoryWhere.UserID.EQ(userID)).DeleteAll(ctx, r.db)
+	if err != nil {
+		return 0, fmt.Errorf("failed to clear lookup history of user %s: %w", userID, err)
+	}
+
+	return int(deleted), nil
+}
+
+func (r *historyRepository) Prune(ctx context.Context, userID string, keep int) (int, error) {
+	deleted, err := entity.LookupHistories(
+		entity.LookupHistoryWhere.UserID.EQ(userID),
+		qm.Where("id NOT IN (SELECT id FROM lookup_histories WHERE user_id = ? ORDER BY created_at DESC, id LIMIT ?)", userID, keep),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune lookup history of user %s: %w", userID, err)
+	}
+
+	return int(deleted), nil
+}
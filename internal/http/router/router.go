@@ -9,13 +9,31 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/auth"
+	deckshandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/decks"
+	experimenthandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/experiment"
+	exporthandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/export"
+	historyhandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/history"
+	importshandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/imports"
+	languageshandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/languages"
+	quizhandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/quiz"
+	reviewshandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/reviews"
 	sentencehandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/sentence"
 	subscriptions2 "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/subscriptions"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/webhook"
 	wordhandler "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/api/word"
 	auth2 "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/auth"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/export"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/history"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/ratelimit"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
 	commonMiddleware "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/middleware"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
@@ -27,9 +45,39 @@ func New(
 	sentenceService sentence.Service,
 	userService auth2.UserService,
 	subscriptionService subscriptions.SubscriptionService,
+	experimentService experiment.Service,
+	usageService usage.Service,
+	historyService history.Service,
+	deckService decks.Service,
+	reviewService reviews.Service,
+	quizService quiz.Service,
+	exportService export.Service,
+	importService imports.Service,
+	languageDetector langdetect.Detector,
+	freeTier func(http.Handler) http.Handler,
 	jwtSecret []byte,
 	stripeWebhookSecret string,
+	adminUserIDs []string,
 ) http.Handler {
+	// Headers the frontend may read.
+	exposedHeaders := []string{
+		"Link",
+		experiment.ResponseIDHeader,
+		usage.HeaderDailyLimit,
+		usage.HeaderDailyRemaining,
+		usage.HeaderMonthlyLimit,
+		usage.HeaderMonthlyRemaining,
+		ratelimit.HeaderLimit,
+		ratelimit.HeaderRemaining,
+		ratelimit.HeaderReset,
+		ratelimit.HeaderPolicy,
+		langdetect.HeaderSourceLanguage,
+		langdetect.HeaderSourceLanguageConfidence,
+		"Retry-After",
+		// The file name of exports.
+		"Content-Disposition",
+	}
+
 	// Create a new Chi router.
 	router := chi.NewRouter()
 	router.Use(cors.Handler(cors.Options{
@@ -41,8 +89,8 @@ func New(
 			"www.mylanguageaibou.co.uk",
 		}, // your frontend URLs
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", ratelimit.DeviceIDHeader},
+		ExposedHeaders:   exposedHeaders,
 		AllowCredentials: true,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	}))
@@ -50,18 +98,32 @@ func New(
 	// Add middleware.
 	router.Use(middleware.Logger)    // logs every request
 	router.Use(middleware.Recoverer) // recovers from panics
+	router.Use(experiment.Track(logger, experimentService))
 
 	// Define the /alive endpoint.
 	registerAliveEndpoint(router)
 
 	authHandler := auth.NewAuthHandler(logger, userService, subscriptionService)
-	wordHandler := wordhandler.NewWordHandler(logger, wordService)
-	sentenceHandler := sentencehandler.NewSentenceHandler(logger, sentenceService)
+	wordHandler := wordhandler.NewWordHandler(logger, wordService, languageDetector)
+	sentenceHandler := sentencehandler.NewSentenceHandler(logger, sentenceService, languageDetector)
 	subscriptionsHandler := subscriptions2.NewSubscriptionsHandler(logger, subscriptionService, userService)
 	webhookHandler := webhook.NewWebhookHandler(logger, stripeWebhookSecret, subscriptionService)
+	experimentHandler := experimenthandler.NewExperimentHandler(logger, experimentService)
+	languagesHandler := languageshandler.NewLanguagesHandler(logger)
+	historyHandler := historyhandler.NewHistoryHandler(logger, historyService)
+	decksHandler := deckshandler.NewDecksHandler(logger, deckService)
+	reviewsHandler := reviewshandler.NewReviewsHandler(logger, reviewService)
+	quizHandler := quizhandler.NewQuizHandler(logger, quizService)
+	exportHandler := exporthandler.NewExportHandler(logger, exportService)
+	importsHandler := importshandler.NewImportsHandler(logger, importService)
+
+	// The open AI endpoints are the free tier: signed-in callers are recognised, and everyone without an active
+	// subscription is rate limited.
+	freeTierMiddlewares := chi.Middlewares{commonMiddleware.OptionalAuthMiddlewareString(jwtSecret), freeTier}
 
 	router.Route(
 		"/api/v1", func(r chi.Router) {
+			r.Use(freeTierMiddlewares...)
 			r.Route(
 				"/search", func(r chi.Router) {
 					r.Post("/word", wordHandler.DefineWord())
@@ -75,6 +137,7 @@ func New(
 
 	router.Route( // todo: create a v4 route that simply has 3 endpoints.the 2 sentence endpoints and /word that returns all information
 		"/api/v2", func(r chi.Router) {
+			r.Use(freeTierMiddlewares...)
 			r.Route(
 				"/word", func(r chi.Router) {
 					r.Post("/definition", wordHandler.DefineWord())
@@ -110,17 +173,75 @@ func New(
 					r.Delete("/", authHandler.Delete())
 				})
 
+			// AI endpoints: each route is gated on the subscription statuses it is included in,
+			// metered against the user's token quota and recorded in their lookup history.
+			subscribed := subscriptions.RequireSubscription(logger, subscriptionService, subscriptions.PolicySubscribed)
+			gracePeriod := subscriptions.RequireSubscription(logger, subscriptionService, subscriptions.PolicyGracePeriod)
+			metered := usage.Enforce(logger, usageService)
+			recorded := func(kind string) func(http.Handler) http.Handler {
+				return history.Record(logger, historyService, kind)
+			}
+
 			r.Route(
 				"/word", func(r chi.Router) {
-					r.Post("/definition", wordHandler.DefineWord())
-					r.Post("/synonyms", wordHandler.GetSynonyms())
-					r.Post("/history", wordHandler.GetHistory())
+					r.With(gracePeriod, metered, recorded(history.KindWordDefinition)).Post("/definition", wordHandler.DefineWord())
+					r.With(subscribed, metered, recorded(history.KindWordSynonyms)).Post("/synonyms", wordHandler.GetSynonyms())
+					r.With(subscribed, metered, recorded(history.KindWordHistory)).Post("/history", wordHandler.GetHistory())
 				},
 			)
 			r.Route(
 				"/sentence", func(r chi.Router) {
-					r.Post("/explanation", sentenceHandler.ExplainSentence())
-					r.Post("/correction", sentenceHandler.CorrectSentence())
+					r.With(gracePeriod, metered, recorded(history.KindSentenceExplanation)).
+						Post("/explanation", sentenceHandler.ExplainSentence())
+					r.With(subscribed, metered, recorded(history.KindSentenceCorrection)).
+						Post("/correction", sentenceHandler.CorrectSentence())
+				},
+			)
+
+			r.Route(
+				"/history", func(r chi.Router) {
+					r.Get("/", historyHandler.List())
+					r.Delete("/", historyHandler.Clear())
+					r.Get("/{entryID}", historyHandler.Get())
+					r.Delete("/{entryID}", historyHandler.Delete())
+				},
+			)
+
+			// Saving a word looks it up, so it is gated and metered like the definition. Imports look every word of
+			// the list up after the response: they are gated the same way and record their own usage once done.
+			r.Route(
+				"/decks", func(r chi.Router) {
+					r.Get("/", decksHandler.ListDecks())
+					r.Post("/", decksHandler.CreateDeck())
+					r.Get("/export", exportHandler.Export())
+					r.Route("/{deckID}", func(r chi.Router) {
+						r.Get("/", decksHandler.GetDeck())
+						r.Put("/", decksHandler.RenameDeck())
+						r.Delete("/", decksHandler.DeleteDeck())
+						r.With(gracePeriod, metered).Post("/entries", decksHandler.AddEntry())
+						r.Put("/entries/order", decksHandler.ReorderEntries())
+						r.Delete("/entries/{entryID}", decksHandler.RemoveEntry())
+						r.Get("/export", exportHandler.Export())
+						r.With(gracePeriod, metered).Post("/imports", importsHandler.Start())
+						r.Get("/imports/{jobID}", importsHandler.Get())
+					})
+				},
+			)
+
+			r.Route(
+				"/reviews", func(r chi.Router) {
+					r.Get("/due", reviewsHandler.Due())
+					r.Post("/{entryID}/grade", reviewsHandler.Grade())
+				},
+			)
+
+			// Quizzes are generated by the AI, so starting one is gated and metered like the definition.
+			r.Route(
+				"/quizzes", func(r chi.Router) {
+					r.With(gracePeriod, metered).Post("/", quizHandler.Start())
+					r.Get("/{quizID}", quizHandler.Get())
+					r.Post("/{quizID}/answers", quizHandler.Submit())
+					r.Get("/{quizID}/score", quizHandler.Score())
 				},
 			)
 
@@ -137,16 +258,28 @@ func New(
 
 	router.Route(
 		"/api/v4", func(r chi.Router) {
+			r.Get("/languages", languagesHandler.List())
 			r.Route(
 				"/word", func(r chi.Router) {
+					r.Use(freeTierMiddlewares...)
 					r.Post("/lookup", wordHandler.Lookup())
+					r.Post("/definition/stream", wordHandler.StreamDefinition())
 				},
 			)
 			r.Route(
 				"/sentence", func(r chi.Router) {
+					r.Use(freeTierMiddlewares...)
 					r.Post("/explanation", sentenceHandler.ExplainSentence())
 					r.Post("/correction", sentenceHandler.CorrectSentence())
 					r.Post("/simplify", sentenceHandler.Simplify())
+					r.Post("/explanation/stream", sentenceHandler.StreamExplanation())
+				},
+			)
+			r.Route(
+				"/experiments", func(r chi.Router) {
+					r.With(freeTierMiddlewares...).Post("/responses/{responseID}/feedback", experimentHandler.Feedback())
+					r.With(commonMiddleware.AuthMiddlewareString(jwtSecret), commonMiddleware.AdminMiddlewareString(adminUserIDs)).
+						Get("/{experimentID}/results", experimentHandler.Results())
 				},
 			)
 		},
@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+// Statuses of jobs.
+const (
+	StatusRunning = "running"
+	StatusDone    = "done"
+	// StatusFailed is a job interrupted by a restart of the API before it was done.
+	StatusFailed = "failed"
+)
+
+// Codes of the words that failed for reasons other than the word itself.
+const (
+	// CodeLookupFailed is the code of words that could not be looked up.
+	CodeLookupFailed = "lookup_failed"
+	// CodeQuotaExceeded is the code of the words left when the user ran out of tokens, which stops the job.
+	CodeQuotaExceeded = "quota_exceeded"
+)
+
+// Job is a word list being added to a deck in the background.
+type Job struct {
+	ID             string
+	DeckID         string
+	NativeLanguage string
+	Status         string
+	// Total is the number of words in the list. The others count the words processed so far.
+	Total    int
+	Imported int
+	// Skipped are the words already in the deck.
+	Skipped    int
+	Failed     int
+	Failures   []Failure
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// Failure is a word of a job that was not added to the deck.
+type Failure struct {
+	Word string
+	// Code is that of the validation.Error the word was refused with, CodeLookupFailed or CodeQuotaExceeded.
+	Code    string
+	Message string
+}
@@ -0,0 +1,329 @@
+// Package imports adds word lists to decks in the background, looking every word up as it is added.
+package imports
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/validation"
+)
+
+// MaxWords is the longest word list a job imports.
+const MaxWords = 200
+
+const (
+	// workers is the number of words of a job looked up at once.
+	workers = 4
+	// progressInterval is the number of words between two saves of the progress of a job.
+	progressInterval = 10
+	// usageEndpoint is the endpoint the tokens of jobs are recorded under, that of the request starting them.
+	usageEndpoint = "/api/v3/decks/{deckID}/imports"
+)
+
+var (
+	ErrJobNotFound  = errors.New("import job not found")
+	ErrInvalidList  = errors.New("invalid word list")
+	ErrNoWords      = errors.New("no words to import")
+	ErrTooManyWords = fmt.Errorf("word lists have at most %d words", MaxWords)
+	ErrJobRunning   = errors.New("an import job is already running")
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+type Service interface {
+	// Start adds words to the deck in the background, like decks.Service.AddEntry, and returns the running job.
+	// It returns decks.ErrDeckNotFound when the user has no deck with that ID, and ErrJobRunning when they
+	// already have a running job. The job stops when the user runs out of tokens.
+	Start(ctx context.Context, userID, deckID, nativeLanguage string, words []string) (*domain.Job, error)
+	// Get returns ErrJobNotFound when the deck of the user has no job with that ID.
+	Get(ctx context.Context, userID, deckID, jobID string) (*domain.Job, error)
+	// FailInterrupted marks the jobs left running by a previous run of the API as failed, as jobs run in the API
+	// process. It is called at startup, before any job starts.
+	FailInterrupted(ctx context.Context) error
+}
+
+type service struct {
+	logger       *zap.Logger
+	repository   storage.ImportRepository
+	deckService  decks.Service
+	usageService usage.Service
+}
+
+// NewImportService returns the import Service. The tokens spent looking up the words of a job are recorded with
+// usageService as the job goes, and checked against the quota of the user before every word.
+func NewImportService(
+	logger *zap.Logger,
+	repository storage.ImportRepository,
+	deckService decks.Service,
+	usageService usage.Service,
+) Service {
+	return &service{
+		logger:       logger,
+		repository:   repository,
+		deckService:  deckService,
+		usageService: usageService,
+	}
+}
+
+func (s *service) Start(
+	ctx context.Context,
+	userID, deckID, nativeLanguage string,
+	words []string,
+) (*domain.Job, error) {
+	switch {
+	case len(words) == 0:
+		return nil, ErrNoWords
+	case len(words) > MaxWords:
+		return nil, ErrTooManyWords
+	}
+
+	if _, err := s.deckService.GetDeck(ctx, userID, deckID); err != nil {
+		return nil, err
+	}
+
+	job, err := s.repository.Insert(ctx, &entity.ImportJob{
+		UserID:         userID,
+		DeckID:         deckID,
+		NativeLanguage: languages.Normalize(nativeLanguage),
+		Status:         domain.StatusRunning,
+		Total:          len(words),
+		Failures:       "[]",
+	})
+	if errors.Is(err, storage.ErrJobRunning) {
+		return nil, ErrJobRunning
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Sugar().Infow("started import job", "userID", userID, "deckID", deckID, "jobID", job.ID,
+		"words", len(words))
+
+	// Converted before the job runs, which updates it.
+	result, err := toDomainJob(job)
+	if err != nil {
+		return nil, err
+	}
+
+	go s.run(context.WithoutCancel(ctx), job, words)
+
+	return result, nil
+}
+
+// result is the outcome of adding one word of a job.
+type result struct {
+	word string
+	err  error
+}
+
+// run adds the words of the job to its deck, a few at a time, saving its progress as it goes. It stops handing out
+// words once the user has run out of tokens, and the words left fail with CodeQuotaExceeded.
+func (s *service) run(ctx context.Context, job *entity.ImportJob, words []string) {
+	ctx, used := usage.WithMeter(ctx)
+
+	pending := make(chan string)
+	results := make(chan result)
+
+	// left are the words not handed out, set before results is closed.
+	var left []string
+
+	var wg sync.WaitGroup
+	for range min(workers, len(words)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for word := range pending {
+				_, err := s.deckService.AddEntry(ctx, job.UserID, job.DeckID, word, job.NativeLanguage)
+				results <- result{word: word, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		for i, word := range words {
+			if s.quotaExceeded(ctx, job.UserID) {
+				left = words[i:]
+				break
+			}
+
+			pending <- word
+		}
+
+		close(pending)
+		wg.Wait()
+		close(results)
+	}()
+
+	failures := []domain.Failure{}
+	processed := 0
+
+	var recorded openai.Usage
+
+	for result := range results {
+		var validationErr *validation.Error
+
+		switch {
+		case result.err == nil:
+			job.Imported++
+		case errors.Is(result.err, decks.ErrEntryExists):
+			job.Skipped++
+		case errors.As(result.err, &validationErr):
+			job.Failed++
+			failures = append(failures, domain.Failure{
+				Word: result.word, Code: validationErr.Code, Message: validationErr.Error(),
+			})
+		default:
+			s.logger.Sugar().Warnw("failed to import word", "jobID", job.ID, "word", result.word,
+				"error", result.err)
+
+			job.Failed++
+			failures = append(failures, domain.Failure{
+				Word: result.word, Code: domain.CodeLookupFailed, Message: "The word could not be looked up",
+			})
+		}
+
+		// Recorded as it is spent, for the quota to be checked against it.
+		s.recordUsage(ctx, job, used(), &recorded)
+
+		processed++
+		if processed%progressInterval == 0 && processed < len(words) {
+			s.saveProgress(ctx, job, failures)
+		}
+	}
+
+	if len(left) > 0 {
+		s.logger.Sugar().Infow("stopped import job, quota exceeded", "jobID", job.ID, "left", len(left))
+
+		for _, word := range left {
+			job.Failed++
+			failures = append(failures, domain.Failure{
+				Word: word, Code: domain.CodeQuotaExceeded, Message: "You have run out of tokens",
+			})
+		}
+	}
+
+	job.Status = domain.StatusDone
+	job.FinishedAt.SetValid(time.Now().UTC())
+	s.saveProgress(ctx, job, failures)
+
+	s.logger.Sugar().Infow("finished import job", "jobID", job.ID, "imported", job.Imported,
+		"skipped", job.Skipped, "failed", job.Failed)
+}
+
+// quotaExceeded reports whether the user has run out of tokens. A quota that cannot be checked is taken as not
+// exceeded, like usage.Enforce does.
+func (s *service) quotaExceeded(ctx context.Context, userID string) bool {
+	quota, err := s.usageService.GetQuota(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get token quota", zap.String("userID", userID), zap.Error(err))
+		return false
+	}
+
+	return quota.Exceeded()
+}
+
+// recordUsage records the tokens the job used since the previous call, which recorded the usage so far.
+func (s *service) recordUsage(ctx context.Context, job *entity.ImportJob, used openai.Usage, recorded *openai.Usage) {
+	spent := openai.Usage{
+		PromptTokens:     used.PromptTokens - recorded.PromptTokens,
+		CompletionTokens: used.CompletionTokens - recorded.CompletionTokens,
+		TotalTokens:      used.TotalTokens - recorded.TotalTokens,
+	}
+	if spent.TotalTokens == 0 {
+		return
+	}
+
+	if err := s.usageService.Record(ctx, job.UserID, usageEndpoint, spent); err != nil {
+		s.logger.Error("failed to record token usage of import job", zap.String("jobID", job.ID), zap.Error(err))
+		return
+	}
+
+	*recorded = used
+}
+
+// saveProgress saves the progress of the job. Failing to is only logged: the words are in the deck either way.
+func (s *service) saveProgress(ctx context.Context, job *entity.ImportJob, failures []domain.Failure) {
+	raw, err := json.Marshal(failures)
+	if err != nil {
+		s.logger.Error("failed to marshal import failures", zap.String("jobID", job.ID), zap.Error(err))
+		return
+	}
+
+	job.Failures = string(raw)
+
+	if err = s.repository.SaveProgress(ctx, job); err != nil {
+		s.logger.Error("failed to save progress of import job", zap.String("jobID", job.ID), zap.Error(err))
+	}
+}
+
+func (s *service) FailInterrupted(ctx context.Context) error {
+	failed, err := s.repository.FailRunning(ctx)
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		s.logger.Sugar().Warnw("failed import jobs interrupted by a restart", "jobs", failed)
+	}
+
+	return nil
+}
+
+func (s *service) Get(ctx context.Context, userID, deckID, jobID string) (*domain.Job, error) {
+	if _, err := uuid.Parse(deckID); err != nil {
+		return nil, ErrJobNotFound
+	}
+
+	if _, err := uuid.Parse(jobID); err != nil {
+		return nil, ErrJobNotFound
+	}
+
+	job, err := s.repository.Get(ctx, userID, deckID, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrJobNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get import job %s: %w", jobID, err)
+	}
+
+	return toDomainJob(job)
+}
+
+func toDomainJob(job *entity.ImportJob) (*domain.Job, error) {
+	result := &domain.Job{
+		ID:             job.ID,
+		DeckID:         job.DeckID,
+		NativeLanguage: job.NativeLanguage,
+		Status:         job.Status,
+		Total:          job.Total,
+		Imported:       job.Imported,
+		Skipped:        job.Skipped,
+		Failed:         job.Failed,
+		CreatedAt:      job.CreatedAt,
+		FinishedAt:     job.FinishedAt.Ptr(),
+	}
+
+	if err := json.Unmarshal([]byte(job.Failures), &result.Failures); err != nil {
+		return nil, fmt.Errorf("invalid failures of import job %s: %w", job.ID, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,217 @@
+package imports_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	decksdomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/domain"
+	decksmock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports/storage"
+	mockstorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports/storage/mock"
+	usagedomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage/domain"
+	usagemock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
+)
+
+const (
+	userID = "user-1"
+	deckID = "6f1c2d4e-0d1a-4a57-9b1e-3f6a2b7c8d90"
+	jobID  = "0b6f0a7e-8c1d-4f3e-9a2b-1c2d3e4f5a6b"
+)
+
+type mocks struct {
+	repository   *mockstorage.MockImportRepository
+	deckService  *decksmock.MockService
+	usageService *usagemock.MockService
+}
+
+func newService(t *testing.T) (imports.Service, mocks) {
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		repository:   mockstorage.NewMockImportRepository(ctrl),
+		deckService:  decksmock.NewMockService(ctrl),
+		usageService: usagemock.NewMockService(ctrl),
+	}
+
+	return imports.NewImportService(zaptest.NewLogger(t), m.repository, m.deckService, m.usageService), m
+}
+
+var (
+	quotaLeft     = &usagedomain.Quota{Limits: usagedomain.Limits{Daily: 1000, Monthly: 10000}}
+	quotaExceeded = &usagedomain.Quota{Limits: usagedomain.Limits{Daily: 1000, Monthly: 10000}, DailyUsed: 1000}
+)
+
+// expectJob expects the job to be inserted and returns a channel closed once it is done, with its last progress.
+func expectJob(t *testing.T, m mocks) <-chan *entity.ImportJob {
+	m.deckService.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(&decksdomain.Deck{ID: deckID}, nil)
+	m.repository.EXPECT().Insert(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, job *entity.ImportJob) (*entity.ImportJob, error) {
+			assert.Equal(t, "English", job.NativeLanguage)
+			assert.Equal(t, domain.StatusRunning, job.Status)
+			job.ID = jobID
+
+			return job, nil
+		})
+
+	done := make(chan *entity.ImportJob, 1)
+
+	m.repository.EXPECT().SaveProgress(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, job *entity.ImportJob) error {
+			if job.Status == domain.StatusDone {
+				done <- job
+			}
+
+			return nil
+		})
+
+	return done
+}
+
+func waitForJob(t *testing.T, done <-chan *entity.ImportJob) *entity.ImportJob {
+	select {
+	case job := <-done:
+		return job
+	case <-time.After(5 * time.Second):
+		t.Fatal("import job did not finish")
+		return nil
+	}
+}
+
+func failures(t *testing.T, job *entity.ImportJob) []domain.Failure {
+	var failures []domain.Failure
+	require.NoError(t, json.Unmarshal([]byte(job.Failures), &failures))
+
+	return failures
+}
+
+func TestReadWords(t *testing.T) {
+	testCases := map[string]struct {
+		list  string
+		comma rune
+		want  []string
+	}{
+		"one word per line": {"gato\n\n perro \ngato\n", ',', []string{"gato", "perro"}},
+		"first field":       {"gato,cat\npájaro,bird\n", ',', []string{"gato", "pájaro"}},
+		"export":            {"\ufeffdeck,word,reading\nAnimals,猫,ねこ\nAnimals,犬,いぬ\n", ',', []string{"猫", "犬"}},
+		"tsv":               {"Word\tdefinition\nchat\tcat\n", '\t', []string{"chat"}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			words, err := imports.ReadWords(strings.NewReader(tc.list), tc.comma)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, words)
+		})
+	}
+}
+
+func TestStartImportsInTheBackground(t *testing.T) {
+	service, m := newService(t)
+	done := expectJob(t, m)
+
+	m.usageService.EXPECT().GetQuota(gomock.Any(), userID).Return(quotaLeft, nil).Times(4)
+	m.deckService.EXPECT().AddEntry(gomock.Any(), userID, deckID, "gato", "English").Return(&decksdomain.Entry{}, nil)
+	m.deckService.EXPECT().AddEntry(gomock.Any(), userID, deckID, "perro", "English").Return(nil, decks.ErrEntryExists)
+	m.deckService.EXPECT().AddEntry(gomock.Any(), userID, deckID, "123", "English").Return(nil, word.ErrWordContainsDigits)
+	m.deckService.EXPECT().AddEntry(gomock.Any(), userID, deckID, "pez", "English").Return(nil, sql.ErrConnDone)
+
+	job, err := service.Start(context.Background(), userID, deckID, "en", []string{"gato", "perro", "123", "pez"})
+	require.NoError(t, err)
+
+	assert.Equal(t, jobID, job.ID)
+	assert.Equal(t, 4, job.Total)
+	assert.Equal(t, domain.StatusRunning, job.Status)
+	assert.Empty(t, job.Failures)
+
+	finished := waitForJob(t, done)
+	assert.True(t, finished.FinishedAt.Valid)
+	assert.Equal(t, []int{1, 1, 2}, []int{finished.Imported, finished.Skipped, finished.Failed})
+	assert.ElementsMatch(t, []domain.Failure{
+		{Word: "123", Code: word.ErrWordContainsDigits.Code, Message: word.ErrWordContainsDigits.Error()},
+		{Word: "pez", Code: domain.CodeLookupFailed, Message: "The word could not be looked up"},
+	}, failures(t, finished))
+}
+
+func TestStartStopsWhenTheQuotaIsExceeded(t *testing.T) {
+	service, m := newService(t)
+	done := expectJob(t, m)
+
+	m.usageService.EXPECT().GetQuota(gomock.Any(), userID).Return(quotaLeft, nil).Times(2)
+	m.usageService.EXPECT().GetQuota(gomock.Any(), userID).Return(quotaExceeded, nil)
+	m.deckService.EXPECT().AddEntry(gomock.Any(), userID, deckID, "gato", "English").Return(&decksdomain.Entry{}, nil)
+	m.deckService.EXPECT().AddEntry(gomock.Any(), userID, deckID, "perro", "English").Return(&decksdomain.Entry{}, nil)
+
+	_, err := service.Start(context.Background(), userID, deckID, "English", []string{"gato", "perro", "pez", "pájaro"})
+	require.NoError(t, err)
+
+	finished := waitForJob(t, done)
+	assert.Equal(t, []int{2, 0, 2}, []int{finished.Imported, finished.Skipped, finished.Failed})
+	assert.Equal(t, []domain.Failure{
+		{Word: "pez", Code: domain.CodeQuotaExceeded, Message: "You have run out of tokens"},
+		{Word: "pájaro", Code: domain.CodeQuotaExceeded, Message: "You have run out of tokens"},
+	}, failures(t, finished))
+}
+
+func TestStartRefusals(t *testing.T) {
+	t.Run("no words", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.Start(context.Background(), userID, deckID, "English", nil)
+		assert.ErrorIs(t, err, imports.ErrNoWords)
+	})
+
+	t.Run("too many words", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.Start(context.Background(), userID, deckID, "English", make([]string, imports.MaxWords+1))
+		assert.ErrorIs(t, err, imports.ErrTooManyWords)
+	})
+
+	t.Run("deck of another user", func(t *testing.T) {
+		service, m := newService(t)
+		m.deckService.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(nil, decks.ErrDeckNotFound)
+
+		_, err := service.Start(context.Background(), userID, deckID, "English", []string{"gato"})
+		assert.ErrorIs(t, err, decks.ErrDeckNotFound)
+	})
+
+	t.Run("job already running", func(t *testing.T) {
+		service, m := newService(t)
+		m.deckService.EXPECT().GetDeck(gomock.Any(), userID, deckID).Return(&decksdomain.Deck{ID: deckID}, nil)
+		m.repository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, storage.ErrJobRunning)
+
+		_, err := service.Start(context.Background(), userID, deckID, "English", []string{"gato"})
+		assert.ErrorIs(t, err, imports.ErrJobRunning)
+	})
+}
+
+func TestFailInterrupted(t *testing.T) {
+	service, m := newService(t)
+	m.repository.EXPECT().FailRunning(gomock.Any()).Return(int64(2), nil)
+
+	require.NoError(t, service.FailInterrupted(context.Background()))
+}
+
+func TestGetUnknownJob(t *testing.T) {
+	service, m := newService(t)
+	m.repository.EXPECT().Get(gomock.Any(), userID, deckID, jobID).Return(nil, sql.ErrNoRows)
+
+	_, err := service.Get(context.Background(), userID, deckID, jobID)
+	assert.ErrorIs(t, err, imports.ErrJobNotFound)
+
+	_, err = service.Get(context.Background(), userID, deckID, "not-a-uuid")
+	assert.ErrorIs(t, err, imports.ErrJobNotFound)
+}
@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/imports/domain"
+)
+
+// ErrJobRunning is returned when the user already has a running job.
+var ErrJobRunning = errors.New("import job already running")
+
+// uniqueViolation is the Postgres error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+//go:generate mockgen -source=repository.go -destination=mock/repository.go
+type ImportRepository interface {
+	// Insert returns ErrJobRunning when the job is running and the user already has a running job.
+	Insert(ctx context.Context, job *entity.ImportJob) (*entity.ImportJob, error)
+	// Get returns sql.ErrNoRows when the deck of the user has no job with that ID.
+	Get(ctx context.Context, userID, deckID, jobID string) (*entity.ImportJob, error)
+	// SaveProgress saves the status, counts and failures of the job.
+	SaveProgress(ctx context.Context, job *entity.ImportJob) error
+	// FailRunning marks every running job as failed, and returns how many there were.
+	FailRunning(ctx context.Context) (int64, error)
+}
+
+type importRepository struct {
+	db *sqlx.DB
+}
+
+func NewImportRepository(db *sqlx.DB) ImportRepository {
+	return &importRepository{
+		db: db,
+	}
+}
+
+func (r *importRepository) Insert(ctx context.Context, job *entity.ImportJob) (*entity.ImportJob, error) {
+	if err := job.Insert(ctx, r.db, boil.Infer()); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, ErrJobRunning
+		}
+
+		return nil, fmt.Errorf("failed to insert import job: %w", err)
+	}
+
+	return job, nil
+}
+
+func (r *importRepository) Get(ctx context.Context, userID, deckID, jobID string) (*entity.ImportJob, error) {
+	return entity.ImportJobs(
+		entity.ImportJobWhere.ID.EQ(jobID),
+		entity.ImportJobWhere.UserID.EQ(userID),
+		entity.ImportJobWhere.DeckID.EQ(deckID),
+	).One(ctx, r.db)
+}
+
+func (r *importRepository) SaveProgress(ctx context.Context, job *entity.ImportJob) error {
+	job.UpdatedAt = time.Now().UTC()
+
+	_, err := job.Update(ctx, r.db, boil.Whitelist(
+		entity.ImportJobColumns.Status,
+		entity.ImportJobColumns.Imported,
+		entity.ImportJobColumns.Skipped,
+		entity.ImportJobColumns.Failed,
+		entity.ImportJobColumns.Failures,
+		entity.ImportJobColumns.FinishedAt,
+		entity.ImportJobColumns.UpdatedAt,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to save progress of import job %s: %w", job.ID, err)
+	}
+
+	return nil
+}
+
+func (r *importRepository) FailRunning(ctx context.Context) (int64, error) {
+	now := time.Now().UTC()
+
+	failed, err := entity.ImportJobs(
+		entity.ImportJobWhere.Status.EQ(domain.StatusRunning),
+	).UpdateAll(ctx, r.db, entity.M{
+		entity.ImportJobColumns.Status:     domain.StatusFailed,
+		entity.ImportJobColumns.FinishedAt: now,
+		entity.ImportJobColumns.UpdatedAt:  now,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail running import jobs: %w", err)
+	}
+
+	return failed, nil
+}
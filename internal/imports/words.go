@@ -0,0 +1,61 @@
+package imports
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ReadWords returns the words of a CSV word list with fields separated by comma, in order and without repeats. The
+// words are the first field of every row, or the field under "word" when the first row is a header naming it, so
+// that CSV and TSV exports can be imported again. Blank words are ignored.
+func ReadWords(r io.Reader, comma rune) ([]string, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var words []string
+
+	seen := map[string]bool{}
+	column := 0
+
+	for row := 0; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return words, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidList, err)
+		}
+
+		if row == 0 {
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+
+			if header := slices.IndexFunc(record, isWordHeader); header >= 0 {
+				column = header
+				continue
+			}
+		}
+
+		if column >= len(record) {
+			continue
+		}
+
+		word := strings.TrimSpace(record[column])
+		if word == "" || seen[word] {
+			continue
+		}
+
+		seen[word] = true
+		words = append(words, word)
+	}
+}
+
+func isWordHeader(field string) bool {
+	return strings.EqualFold(strings.TrimSpace(field), "word")
+}
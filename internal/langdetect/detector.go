@@ -0,0 +1,193 @@
+// Package langdetect detects the language a word or sentence is written in: from its script or, for latin text,
+// its words when that is enough, and by asking the LLM provider otherwise.
+package langdetect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/canonical"
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+)
+
+// How a language was detected.
+const (
+	// MethodRequest is a language given by the client, which is trusted as is.
+	MethodRequest = "request"
+	MethodScript  = "script"
+	MethodWords   = "words"
+	MethodModel   = "model"
+)
+
+// minScriptConfidence is the confidence above which the script or words alone settle the language.
+const minScriptConfidence = 0.9
+
+type Detection struct {
+	// Language is the ISO 639-1 code of the language, or its ISO 639-3 code when it has none.
+	Language string
+	// Confidence goes from 0 to 1.
+	Confidence float64
+	Method     string
+}
+
+//go:generate mockgen -source=detector.go -destination=mock/detector.go
+type Detector interface {
+	// Detect returns the language text is written in. A non-empty targetLanguage, given by the client,
+	// skips the detection. The model is asked when Guess is unsure.
+	Detect(ctx context.Context, text, targetLanguage string) (*Detection, error)
+	// Guess is Detect without the model, for when the language is not worth a completion. It returns nil when
+	// neither the script nor the words of text tell its language.
+	Guess(text, targetLanguage string) *Detection
+}
+
+type detector struct {
+	logger       *zap.Logger
+	openAiClient openai.Client
+	store        content.Store
+	prompts      prompt.Registry
+}
+
+func NewDetector(
+	logger *zap.Logger,
+	openAiClient openai.Client,
+	store content.Store,
+	prompts prompt.Registry,
+) Detector {
+	return &detector{
+		logger:       logger,
+		openAiClient: openAiClient,
+		store:        store,
+		prompts:      prompts,
+	}
+}
+
+// detectionPayload mirrors detectionJSONSchema.
+type detectionPayload struct {
+	Language   string  `json:"language" validate:"required,alpha,lowercase,min=2,max=3"`
+	Confidence float64 `json:"confidence" validate:"gte=0,lte=1"`
+}
+
+// Normalize canonicalizes the language, as models sometimes answer with its name rather than its code.
+func (p *detectionPayload) Normalize() {
+	p.Language = canonical.Language(p.Language)
+}
+
+var detectionJSONSchema = json.RawMessage(`{
+	"type": "object",
+	"properties": {
+		"language": {
+			"type": "string",
+			"description": "The ISO 639-1 code of the language, or its ISO 639-3 code when it has none."
+		},
+		"confidence": {
+			"type": "number",
+			"description": "How sure you are of the language, from 0 to 1."
+		}
+	},
+	"required": ["confidence", "language"],
+	"additionalProperties": false
+}`)
+
+func (d *detector) Detect(ctx context.Context, text, targetLanguage string) (*Detection, error) {
+	guess := d.Guess(text, targetLanguage)
+	if guess != nil && guess.Confidence >= minScriptConfidence {
+		return guess, nil
+	}
+
+	detection, err := d.detectWithModel(ctx, text)
+	if err != nil {
+		// A guess is better than nothing.
+		if guess != nil {
+			d.logger.Warn("language detection failed, using the guess", zap.String("text", text), zap.Error(err))
+			return guess, nil
+		}
+
+		return nil, err
+	}
+
+	return detection, nil
+}
+
+func (d *detector) Guess(text, targetLanguage string) *Detection {
+	if targetLanguage = strings.TrimSpace(targetLanguage); targetLanguage != "" {
+		return &Detection{Language: canonical.Language(targetLanguage), Confidence: 1, Method: MethodRequest}
+	}
+
+	if guess := detectScript(text); guess != nil {
+		return guess
+	}
+
+	return detectLatin(text)
+}
+
+func (d *detector) detectWithModel(ctx context.Context, text string) (*Detection, error) {
+	p, err := d.prompts.Render(ctx, prompt.LanguageDetection, "", prompt.Vars{"Text": text})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render prompt: %w", err)
+	}
+
+	key := content.Key{
+		Kind:          "language_detection",
+		Input:         canonical.Sentence(text),
+		PromptVersion: p.Revision(),
+		Model:         p.Model,
+	}
+
+	var usage openai.Usage
+
+	result, err := d.store.GetOrGenerate(ctx, key, func(ctx context.Context) (string, error) {
+		request := p.Request()
+		request.ResponseFormat = openai.NewJSONSchemaFormat("language_detection", detectionJSONSchema)
+
+		completion, err := d.openAiClient.CreateChatCompletion(ctx, request)
+		if err != nil {
+			return "", fmt.Errorf("failed to make open ai request: %w", err)
+		}
+
+		d.logger.Info("Successfully detected language",
+			zap.String("text", text),
+			zap.String("prompt", p.ID),
+			zap.String("promptVersion", p.Version),
+			zap.Int("promptTokens", completion.Usage.PromptTokens),
+			zap.Int("completionTokens", completion.Usage.CompletionTokens),
+			zap.Int("totalTokens", completion.Usage.TotalTokens),
+		)
+
+		var payload detectionPayload
+
+		err = openai.ParseStructured(completion.Content(), &payload)
+		if err != nil {
+			return "", err
+		}
+
+		usage = completion.Usage
+
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal language detection: %w", err)
+		}
+
+		return string(raw), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var payload detectionPayload
+
+	err = openai.ParseStructured(*result, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	experiment.Observe(ctx, p, usage)
+
+	return &Detection{Language: payload.Language, Confidence: payload.Confidence, Method: MethodModel}, nil
+}
@@ -0,0 +1,180 @@
+package langdetect_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/coocood/freecache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache"
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+	mockopenai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+)
+
+func newDetector(t *testing.T, client openai.Client) langdetect.Detector {
+	t.Helper()
+
+	logger := zaptest.NewLogger(t)
+
+	prompts, err := prompt.NewRegistry()
+	require.NoError(t, err)
+
+	store := content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1*1024*1024)))
+
+	return langdetect.NewDetector(logger, client, store, prompts)
+}
+
+func completion(content string) *openai.ChatCompletion {
+	return &openai.ChatCompletion{
+		Choices: []openai.Choice{{Message: openai.Message{Role: "assistant", Content: content}}},
+	}
+}
+
+func TestDetectFromScript(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "hiragana", text: "ねこ", expected: "ja"},
+		{name: "kanji and kana", text: "猫が好きです。", expected: "ja"},
+		{name: "katakana", text: "ニャンコ", expected: "ja"},
+		{name: "hangul", text: "어떻게", expected: "ko"},
+		{name: "thai", text: "แมว", expected: "th"},
+		{name: "greek", text: "γάτα", expected: "el"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			detector := newDetector(t, mockopenai.NewMockClient(ctrl))
+
+			detection, err := detector.Detect(context.Background(), tc.text, "")
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, detection.Language)
+			assert.Equal(t, langdetect.MethodScript, detection.Method)
+			assert.GreaterOrEqual(t, detection.Confidence, 0.9)
+		})
+	}
+}
+
+func TestDetectWithModel(t *testing.T) {
+	t.Run("asks once per text", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mockopenai.NewMockClient(ctrl)
+		detector := newDetector(t, mockClient)
+
+		mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, request *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+				require.NotNil(t, request.ResponseFormat)
+				assert.Equal(t, "language_detection", request.ResponseFormat.JSONSchema.Name)
+				assert.Contains(t, request.Messages[len(request.Messages)-1].Content, "Ich mag Katzen.")
+
+				return completion(`{"language": "de", "confidence": 0.97}`), nil
+			}).Times(1)
+
+		for range 2 {
+			detection, err := detector.Detect(context.Background(), "Ich mag Katzen.", "")
+			require.NoError(t, err)
+
+			assert.Equal(t, &langdetect.Detection{Language: "de", Confidence: 0.97, Method: langdetect.MethodModel}, detection)
+		}
+	})
+
+	t.Run("accepts language names", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mockopenai.NewMockClient(ctrl)
+		detector := newDetector(t, mockClient)
+
+		mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).
+			Return(completion("```json\n{\"language\": \"Russian\", \"confidence\": 0.9}\n```"), nil)
+
+		detection, err := detector.Detect(context.Background(), "Привет", "")
+		require.NoError(t, err)
+		assert.Equal(t, "ru", detection.Language)
+	})
+
+	t.Run("falls back to the script", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mockopenai.NewMockClient(ctrl)
+		detector := newDetector(t, mockClient)
+
+		mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+
+		detection, err := detector.Detect(context.Background(), "猫", "")
+		require.NoError(t, err)
+		assert.Equal(t, &langdetect.Detection{Language: "zh", Confidence: 0.6, Method: langdetect.MethodScript}, detection)
+	})
+
+	t.Run("fails without a script guess", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mockopenai.NewMockClient(ctrl)
+		detector := newDetector(t, mockClient)
+
+		mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).
+			Return(completion(`{"language": "somewhere in Europe", "confidence": 0.2}`), nil)
+
+		_, err := detector.Detect(context.Background(), "cat", "")
+		assert.ErrorIs(t, err, openaierrors.ErrInvalidStructuredOutput)
+	})
+}
+
+func TestDetectTrustsTargetLanguage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	detector := newDetector(t, mockopenai.NewMockClient(ctrl))
+
+	detection, err := detector.Detect(context.Background(), "猫", " Japanese ")
+	require.NoError(t, err)
+	assert.Equal(t, &langdetect.Detection{Language: "ja", Confidence: 1, Method: langdetect.MethodRequest}, detection)
+}
+
+func TestGuessFromWords(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected *langdetect.Detection
+	}{
+		{name: "english", text: "What is the name of this cat?", expected: &langdetect.Detection{Language: "en", Confidence: 0.89, Method: langdetect.MethodWords}},
+		{name: "spanish", text: "El niño no está en la escuela.", expected: &langdetect.Detection{Language: "es", Confidence: 0.93, Method: langdetect.MethodWords}},
+		{name: "german", text: "Ich weiß nicht, was das ist.", expected: &langdetect.Detection{Language: "de", Confidence: 0.91, Method: langdetect.MethodWords}},
+		{name: "single word", text: "cat"},
+		{name: "shared words only", text: "de la"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			detector := newDetector(t, mockopenai.NewMockClient(ctrl))
+
+			detection := detector.Guess(tc.text, "")
+			if tc.expected == nil {
+				assert.Nil(t, detection)
+				return
+			}
+
+			assert.Equal(t, tc.expected.Language, detection.Language)
+			assert.Equal(t, tc.expected.Method, detection.Method)
+			assert.InDelta(t, tc.expected.Confidence, detection.Confidence, 0.01)
+		})
+	}
+}
+
+func TestDetectSkipsTheModelForConfidentGuesses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	detector := newDetector(t, mockopenai.NewMockClient(ctrl))
+
+	detection, err := detector.Detect(context.Background(), "El niño no está en la escuela.", "")
+	require.NoError(t, err)
+	assert.Equal(t, "es", detection.Language)
+	assert.Equal(t, langdetect.MethodWords, detection.Method)
+}
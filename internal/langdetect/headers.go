@@ -0,0 +1,22 @@
+package langdetect
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// Headers reporting the detected language, for the endpoints answering with a bare string.
+const (
+	HeaderSourceLanguage           = "X-Source-Language"
+	HeaderSourceLanguageConfidence = "X-Source-Language-Confidence"
+)
+
+// SetHeaders reports detection in the headers of w. A nil detection sets nothing.
+func SetHeaders(w http.ResponseWriter, detection *Detection) {
+	if detection == nil {
+		return
+	}
+
+	w.Header().Set(HeaderSourceLanguage, detection.Language)
+	w.Header().Set(HeaderSourceLanguageConfidence, strconv.FormatFloat(detection.Confidence, 'f', 2, 64))
+}
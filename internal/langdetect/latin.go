@@ -0,0 +1,141 @@
+package langdetect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// latinLanguages are the most looked up languages written in the latin script, with their commonest words and
+// the letters no other language of the list uses.
+var latinLanguages = []struct {
+	language  string
+	stopwords []string
+	letters   string
+}{
+	{
+		language:  "en",
+		stopwords: []string{"the", "and", "is", "are", "was", "of", "to", "in", "it", "you", "that", "this", "with", "for", "have", "what", "not", "my"},
+	},
+	{
+		language:  "es",
+		stopwords: []string{"el", "la", "los", "las", "de", "que", "y", "en", "un", "una", "es", "por", "para", "con", "no", "muy", "pero", "está"},
+		letters:   "ñ",
+	},
+	{
+		language:  "fr",
+		stopwords: []string{"le", "la", "les", "de", "des", "du", "et", "est", "un", "une", "je", "tu", "il", "nous", "vous", "pas", "que", "dans", "avec", "c'est"},
+		letters:   "œùûÿ",
+	},
+	{
+		language:  "de",
+		stopwords: []string{"der", "die", "das", "und", "ist", "nicht", "ich", "du", "ein", "eine", "zu", "mit", "auf", "für", "sie", "wir", "sehr", "aber", "was"},
+		letters:   "ß",
+	},
+	{
+		language:  "it",
+		stopwords: []string{"il", "lo", "la", "gli", "di", "che", "è", "e", "un", "una", "non", "per", "sono", "con", "mi", "molto", "ma"},
+	},
+	{
+		language:  "pt",
+		stopwords: []string{"o", "a", "os", "as", "de", "que", "e", "é", "um", "uma", "não", "para", "com", "eu", "você", "muito", "mas"},
+		letters:   "ãõ",
+	},
+	{
+		language:  "nl",
+		stopwords: []string{"de", "het", "een", "en", "is", "niet", "ik", "je", "van", "dat", "op", "zijn", "met", "voor", "wat"},
+	},
+	{
+		language:  "sv",
+		stopwords: []string{"och", "är", "det", "att", "jag", "en", "ett", "inte", "på", "med", "som", "för", "har", "vad"},
+	},
+	{
+		language:  "pl",
+		stopwords: []string{"i", "w", "nie", "się", "to", "jest", "na", "że", "z", "do", "jak", "co", "ale", "bardzo"},
+		letters:   "ąćęłńśźż",
+	},
+	{
+		language:  "tr",
+		stopwords: []string{"ve", "bir", "bu", "da", "de", "için", "ne", "çok", "ben", "sen", "mi", "değil", "var", "yok"},
+		letters:   "ğış",
+	},
+	{
+		language:  "id",
+		stopwords: []string{"yang", "dan", "di", "ini", "itu", "saya", "tidak", "ada", "dengan", "untuk", "ke", "apa", "kamu"},
+	},
+	{
+		language: "vi",
+		stopwords: []string{"và", "là", "của", "có", "không", "tôi", "bạn", "một", "những", "được", "này", "cho", "với",
+			"người"},
+		letters: "ăđơưạảấầẩẫậắằẳẵặẹẻẽếềểễệỉịọỏốồổỗộớờởỡợụủứừửữựỳỵỷỹ",
+	},
+}
+
+// latinStopwords maps every stopword of latinLanguages to the indexes of the languages using it.
+var latinStopwords = func() map[string][]int {
+	stopwords := map[string][]int{}
+
+	for i, l := range latinLanguages {
+		for _, word := range l.stopwords {
+			stopwords[word] = append(stopwords[word], i)
+		}
+	}
+
+	return stopwords
+}()
+
+// maxLatinConfidence keeps a guess from words alone below the confidence of the scripts used by a single language.
+const maxLatinConfidence = 0.95
+
+// detectLatin guesses the language of latin text from its commonest words and the letters only one language uses.
+// It returns nil when text has neither, e.g. for most single words, or when two languages are as likely.
+//
+// A language scores a point for every word of text that has one of its letters, and a stopword scores a point shared
+// between the languages using it. The confidence grows with the lead of the best language over the next, and with
+// the number of points it is based on.
+func detectLatin(text string) *Detection {
+	scores := make([]float64, len(latinLanguages))
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	})
+
+	for _, word := range words {
+		for _, i := range latinStopwords[word] {
+			scores[i] += 1 / float64(len(latinStopwords[word]))
+		}
+
+		for i, l := range latinLanguages {
+			if l.letters != "" && strings.ContainsAny(word, l.letters) {
+				scores[i]++
+			}
+		}
+	}
+
+	best, second := -1, 0.0
+
+	for i, score := range scores {
+		switch {
+		case best == -1 || score > scores[best]:
+			if best != -1 {
+				second = scores[best]
+			}
+
+			best = i
+		case score > second:
+			second = score
+		}
+	}
+
+	if scores[best] == second {
+		return nil
+	}
+
+	lead := (scores[best] - second) / scores[best]
+	support := min(scores[best]/3, 1)
+
+	return &Detection{
+		Language:   latinLanguages[best].language,
+		Confidence: min(lead*support, maxLatinConfidence),
+		Method:     MethodWords,
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: detector.go
+//
+// Generated by this command:
+//
+//	mockgen -source=detector.go -destination=mock/detector.go
+//
+
+// Package mock_langdetect is a generated GoMock package.
+package mock_langdetect
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	langdetect "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/langdetect"
+)
+
+// MockDetector is a mock of Detector interface.
+type MockDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MockDetectorMockRecorder
+}
+
+// MockDetectorMockRecorder is the mock recorder for MockDetector.
+type MockDetectorMockRecorder struct {
+	mock *MockDetector
+}
+
+// NewMockDetector creates a new mock instance.
+func NewMockDetector(ctrl *gomock.Controller) *MockDetector {
+	mock := &MockDetector{ctrl: ctrl}
+	mock.recorder = &MockDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDetector) EXPECT() *MockDetectorMockRecorder {
+	return m.recorder
+}
+
+// Detect mocks base method.
+func (m *MockDetector) Detect(ctx context.Context, text, targetLanguage string) (*langdetect.Detection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detect", ctx, text, targetLanguage)
+	ret0, _ := ret[0].(*langdetect.Detection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Detect indicates an expected call of Detect.
+func (mr *MockDetectorMockRecorder) Detect(ctx, text, targetLanguage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detect", reflect.TypeOf((*MockDetector)(nil).Detect), ctx, text, targetLanguage)
+}
+
+// Guess mocks base method.
+func (m *MockDetector) Guess(text, targetLanguage string) *langdetect.Detection {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Guess", text, targetLanguage)
+	ret0, _ := ret[0].(*langdetect.Detection)
+	return ret0
+}
+
+// Guess indicates an expected call of Guess.
+func (mr *MockDetectorMockRecorder) Guess(text, targetLanguage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Guess", reflect.TypeOf((*MockDetector)(nil).Guess), text, targetLanguage)
+}
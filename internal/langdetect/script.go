@@ -0,0 +1,85 @@
+package langdetect
+
+import "unicode"
+
+// scriptLanguages maps the scripts used by a single language, or by one language far more than any other,
+// to that language and how sure a text in that script alone is to be in it.
+var scriptLanguages = []struct {
+	script     *unicode.RangeTable
+	language   string
+	confidence float64
+}{
+	{script: unicode.Hiragana, language: "ja", confidence: 0.99},
+	{script: unicode.Katakana, language: "ja", confidence: 0.99},
+	{script: unicode.Hangul, language: "ko", confidence: 0.99},
+	{script: unicode.Thai, language: "th", confidence: 0.99},
+	{script: unicode.Greek, language: "el", confidence: 0.98},
+	{script: unicode.Georgian, language: "ka", confidence: 0.98},
+	{script: unicode.Armenian, language: "hy", confidence: 0.98},
+	{script: unicode.Khmer, language: "km", confidence: 0.98},
+	{script: unicode.Lao, language: "lo", confidence: 0.98},
+	{script: unicode.Myanmar, language: "my", confidence: 0.95},
+	{script: unicode.Sinhala, language: "si", confidence: 0.98},
+	{script: unicode.Tamil, language: "ta", confidence: 0.95},
+	{script: unicode.Telugu, language: "te", confidence: 0.98},
+	{script: unicode.Kannada, language: "kn", confidence: 0.98},
+	{script: unicode.Malayalam, language: "ml", confidence: 0.98},
+	{script: unicode.Gujarati, language: "gu", confidence: 0.98},
+	{script: unicode.Gurmukhi, language: "pa", confidence: 0.98},
+	{script: unicode.Hebrew, language: "he", confidence: 0.9},
+	{script: unicode.Bengali, language: "bn", confidence: 0.85},
+	{script: unicode.Ethiopic, language: "am", confidence: 0.8},
+	{script: unicode.Devanagari, language: "hi", confidence: 0.8},
+	// Kanji alone may be Japanese as well as Chinese.
+	{script: unicode.Han, language: "zh", confidence: 0.6},
+	{script: unicode.Arabic, language: "ar", confidence: 0.6},
+	{script: unicode.Cyrillic, language: "ru", confidence: 0.5},
+}
+
+// detectScript guesses the language of text from the scripts of its letters. It returns nil when no letter
+// is in a script of scriptLanguages, e.g. for latin text.
+//
+// Kana wins over kanji, as Japanese mixes both, and otherwise the script with the most letters wins.
+func detectScript(text string) *Detection {
+	counts := make([]int, len(scriptLanguages))
+
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+
+		for i, s := range scriptLanguages {
+			if unicode.Is(s.script, r) {
+				counts[i]++
+				break
+			}
+		}
+	}
+
+	best := -1
+
+	for i, count := range counts {
+		if count == 0 {
+			continue
+		}
+
+		if scriptLanguages[i].language == "ja" {
+			best = i
+			break
+		}
+
+		if best == -1 || count > counts[best] {
+			best = i
+		}
+	}
+
+	if best == -1 {
+		return nil
+	}
+
+	return &Detection{
+		Language:   scriptLanguages[best].language,
+		Confidence: scriptLanguages[best].confidence,
+		Method:     MethodScript,
+	}
+}
@@ -0,0 +1,128 @@
+// Package languages is the catalogue of the languages users can be answered in.
+package languages
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Reading systems, used to spell out words of scripts learners cannot read yet.
+const (
+	ReadingFurigana     = "furigana"
+	ReadingPinyin       = "pinyin"
+	ReadingRomanization = "romanization"
+)
+
+type Language struct {
+	// Code is the ISO 639-1 code.
+	Code    string
+	Name    string
+	Endonym string
+	// Script is the ISO 15924 code of the script the language is written in.
+	Script string
+	RTL    bool
+	// Reading is the reading system words of the language are given with, and empty for latin scripts.
+	Reading string
+	// aliases are the other folded names a user may send: alternative names, spellings without accents
+	// and ISO 639-2 codes.
+	aliases []string
+}
+
+var catalogue = []Language{
+	{Code: "ar", Name: "Arabic", Endonym: "العربية", Script: "Arab", RTL: true, Reading: ReadingRomanization, aliases: []string{"ara"}},
+	{Code: "bn", Name: "Bengali", Endonym: "বাংলা", Script: "Beng", Reading: ReadingRomanization, aliases: []string{"bangla", "ben"}},
+	{Code: "cs", Name: "Czech", Endonym: "čeština", Script: "Latn", aliases: []string{"cestina", "ces", "cze"}},
+	{Code: "da", Name: "Danish", Endonym: "dansk", Script: "Latn", aliases: []string{"dan"}},
+	{Code: "de", Name: "German", Endonym: "Deutsch", Script: "Latn", aliases: []string{"deu", "ger"}},
+	{Code: "el", Name: "Greek", Endonym: "ελληνικά", Script: "Grek", Reading: ReadingRomanization, aliases: []string{"ell", "gre"}},
+	{Code: "en", Name: "English", Endonym: "English", Script: "Latn", aliases: []string{"eng"}},
+	{Code: "es", Name: "Spanish", Endonym: "español", Script: "Latn", aliases: []string{"espanol", "castellano", "spa"}},
+	{Code: "fa", Name: "Persian", Endonym: "فارسی", Script: "Arab", RTL: true, Reading: ReadingRomanization, aliases: []string{"farsi", "fas", "per"}},
+	{Code: "fi", Name: "Finnish", Endonym: "suomi", Script: "Latn", aliases: []string{"fin"}},
+	{Code: "fr", Name: "French", Endonym: "français", Script: "Latn", aliases: []string{"francais", "fra", "fre"}},
+	{Code: "he", Name: "Hebrew", Endonym: "עברית", Script: "Hebr", RTL: true, Reading: ReadingRomanization, aliases: []string{"heb"}},
+	{Code: "hi", Name: "Hindi", Endonym: "हिन्दी", Script: "Deva", Reading: ReadingRomanization, aliases: []string{"हिंदी", "hin"}},
+	{Code: "hu", Name: "Hungarian", Endonym: "magyar", Script: "Latn", aliases: []string{"hun"}},
+	{Code: "id", Name: "Indonesian", Endonym: "Bahasa Indonesia", Script: "Latn", aliases: []string{"ind"}},
+	{Code: "it", Name: "Italian", Endonym: "italiano", Script: "Latn", aliases: []string{"ita"}},
+	{Code: "ja", Name: "Japanese", Endonym: "日本語", Script: "Jpan", Reading: ReadingFurigana, aliases: []string{"にほんご", "jpn"}},
+	{Code: "ko", Name: "Korean", Endonym: "한국어", Script: "Kore", Reading: ReadingRomanization, aliases: []string{"조선어", "kor"}},
+	{Code: "ms", Name: "Malay", Endonym: "Bahasa Melayu", Script: "Latn", aliases: []string{"msa", "may"}},
+	{Code: "nl", Name: "Dutch", Endonym: "Nederlands", Script: "Latn", aliases: []string{"nld", "dut"}},
+	{Code: "no", Name: "Norwegian", Endonym: "norsk", Script: "Latn", aliases: []string{"nor"}},
+	{Code: "pl", Name: "Polish", Endonym: "polski", Script: "Latn", aliases: []string{"pol"}},
+	{Code: "pt", Name: "Portuguese", Endonym: "português", Script: "Latn", aliases: []string{"portugues", "por"}},
+	{Code: "ro", Name: "Romanian", Endonym: "română", Script: "Latn", aliases: []string{"romana", "ron", "rum"}},
+	{Code: "ru", Name: "Russian", Endonym: "русский", Script: "Cyrl", Reading: ReadingRomanization, aliases: []string{"rus"}},
+	{Code: "sv", Name: "Swedish", Endonym: "svenska", Script: "Latn", aliases: []string{"swe"}},
+	{Code: "sw", Name: "Swahili", Endonym: "Kiswahili", Script: "Latn", aliases: []string{"swa"}},
+	{Code: "th", Name: "Thai", Endonym: "ไทย", Script: "Thai", Reading: ReadingRomanization, aliases: []string{"tha"}},
+	{Code: "tl", Name: "Tagalog", Endonym: "Tagalog", Script: "Latn", aliases: []string{"filipino", "tgl", "fil"}},
+	{Code: "tr", Name: "Turkish", Endonym: "Türkçe", Script: "Latn", aliases: []string{"turkce", "tur"}},
+	{Code: "uk", Name: "Ukrainian", Endonym: "українська", Script: "Cyrl", Reading: ReadingRomanization, aliases: []string{"ukr"}},
+	{Code: "ur", Name: "Urdu", Endonym: "اردو", Script: "Arab", RTL: true, Reading: ReadingRomanization, aliases: []string{"urd"}},
+	{Code: "vi", Name: "Vietnamese", Endonym: "Tiếng Việt", Script: "Latn", aliases: []string{"tieng viet", "vie"}},
+	{Code: "zh", Name: "Chinese", Endonym: "中文", Script: "Hans", Reading: ReadingPinyin, aliases: []string{"mandarin", "汉语", "漢語", "普通话", "zho", "chi"}},
+}
+
+// byAlias maps the code, the folded name and endonym and every alias of a language to its index in catalogue.
+var byAlias = func() map[string]int {
+	indexes := make(map[string]int)
+
+	for i, language := range catalogue {
+		for _, alias := range append([]string{language.Code, language.Name, language.Endonym}, language.aliases...) {
+			indexes[Fold(alias)] = i
+		}
+	}
+
+	return indexes
+}()
+
+var folder = cases.Fold()
+
+// All returns every supported language, by code.
+func All() []Language {
+	return append([]Language(nil), catalogue...)
+}
+
+// Find returns the language given by name, endonym, or ISO 639-1/639-2 code, optionally with a region
+// ("en-GB", "pt_BR") or a script ("zh-Hant"), in any case.
+func Find(name string) (Language, bool) {
+	name = Fold(name)
+
+	if i, ok := byAlias[name]; ok {
+		return catalogue[i], true
+	}
+
+	// Drop a region or script subtag, e.g. "en-gb" or "zh_hant".
+	if i := strings.IndexAny(name, "-_"); i > 0 {
+		if j, ok := byAlias[name[:i]]; ok {
+			return catalogue[j], true
+		}
+	}
+
+	return Language{}, false
+}
+
+// Supported reports whether name is a language of the catalogue.
+func Supported(name string) bool {
+	_, ok := Find(name)
+	return ok
+}
+
+// Normalize returns the English name of a supported language, which is how prompts name it, and name trimmed otherwise.
+func Normalize(name string) string {
+	language, ok := Find(name)
+	if !ok {
+		return strings.TrimSpace(name)
+	}
+
+	return language.Name
+}
+
+// Fold is the form names are matched in: NFKC normalized, case folded, with whitespace collapsed.
+func Fold(name string) string {
+	return strings.Join(strings.Fields(folder.String(norm.NFKC.String(name))), " ")
+}
@@ -0,0 +1,94 @@
+package languages_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+)
+
+func TestFind(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "english name", input: "Japanese", expected: "ja"},
+		{name: "code", input: "ja", expected: "ja"},
+		{name: "iso 639-2 code", input: "jpn", expected: "ja"},
+		{name: "endonym", input: "日本語", expected: "ja"},
+		{name: "alias", input: "Farsi", expected: "fa"},
+		{name: "region", input: "pt-BR", expected: "pt"},
+		{name: "script", input: "zh_Hant", expected: "zh"},
+		{name: "case and whitespace", input: "  bahasa   INDONESIA ", expected: "id"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			language, ok := languages.Find(tc.input)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, language.Code)
+		})
+	}
+
+	for _, unsupported := range []string{"", "Klingon", "xx-YY"} {
+		_, ok := languages.Find(unsupported)
+		assert.False(t, ok, unsupported)
+	}
+}
+
+func TestCatalogue(t *testing.T) {
+	codes := map[string]bool{}
+
+	for _, language := range languages.All() {
+		assert.False(t, codes[language.Code], "duplicate code %s", language.Code)
+		codes[language.Code] = true
+
+		// Every name finds its own language, so no alias is shared.
+		for _, name := range []string{language.Code, language.Name, language.Endonym} {
+			found, ok := languages.Find(name)
+			require.True(t, ok, name)
+			assert.Equal(t, language.Code, found.Code, name)
+		}
+
+		assert.Len(t, language.Script, 4, language.Code)
+
+		if language.Script == "Latn" {
+			assert.Empty(t, language.Reading, language.Code)
+		} else {
+			assert.NotEmpty(t, language.Reading, language.Code)
+		}
+	}
+
+	japanese, _ := languages.Find("ja")
+	assert.Equal(t, languages.ReadingFurigana, japanese.Reading)
+
+	arabic, _ := languages.Find("ar")
+	assert.True(t, arabic.RTL)
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "English", languages.Normalize("en-GB"))
+	assert.Equal(t, "Chinese", languages.Normalize("普通话"))
+	assert.Equal(t, "Klingon", languages.Normalize(" Klingon "))
+}
+
+func TestValidateStruct(t *testing.T) {
+	type request struct {
+		NativeLanguage string `validate:"language"`
+		TargetLanguage string `validate:"omitempty,language"`
+	}
+
+	assert.NoError(t, languages.ValidateStruct(request{NativeLanguage: "français"}))
+
+	for _, invalid := range []request{
+		{NativeLanguage: ""},
+		{NativeLanguage: "Klingon"},
+		{NativeLanguage: "French", TargetLanguage: "Klingon"},
+	} {
+		err := languages.ValidateStruct(invalid)
+		assert.True(t, languages.IsUnsupported(err), "%+v", invalid)
+	}
+}
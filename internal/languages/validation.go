@@ -0,0 +1,49 @@
+package languages
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/validation"
+)
+
+// ErrUnsupported is the error to return for a language outside the catalogue.
+var ErrUnsupported = validation.New(validation.CodeUnsupportedLanguage, "Please choose one of the supported languages.")
+
+// ValidationTag validates that a string field names a supported language, e.g. `validate:"language"`.
+const ValidationTag = "language"
+
+var validate = func() *validator.Validate {
+	v := validator.New()
+
+	err := v.RegisterValidation(ValidationTag, func(fl validator.FieldLevel) bool {
+		return Supported(fl.Field().String())
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}()
+
+// ValidateStruct validates s like validator.Validate.Struct, with ValidationTag available.
+func ValidateStruct(s any) error {
+	return validate.Struct(s)
+}
+
+// IsUnsupported reports whether err failed ValidationTag.
+func IsUnsupported(err error) bool {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return false
+	}
+
+	for _, fieldErr := range errs {
+		if fieldErr.Tag() == ValidationTag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,162 @@
+package prompt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+)
+
+type experimentFile struct {
+	ID string `yaml:"id"`
+	// Template is the template whose renders are split between the variants.
+	Template string `yaml:"template"`
+	Enabled  bool   `yaml:"enabled"`
+	Variants []struct {
+		Name     string `yaml:"name"`
+		Template string `yaml:"template"`
+		Weight   int    `yaml:"weight"`
+	} `yaml:"variants"`
+}
+
+type experimentVariant struct {
+	name     string
+	template string
+	weight   int
+}
+
+type experiment struct {
+	id          string
+	variants    []experimentVariant
+	totalWeight int
+}
+
+func (r *registry) loadExperiments(fsys fs.FS) error {
+	experimentFiles, err := fs.Glob(fsys, "experiments/*.yaml")
+	if err != nil {
+		return fmt.Errorf("failed to list experiments: %w", err)
+	}
+
+	for _, file := range experimentFiles {
+		raw, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return fmt.Errorf("failed to read experiment %s: %w", file, err)
+		}
+
+		x, template, enabled, err := r.parseExperiment(strings.TrimSuffix(path.Base(file), ".yaml"), raw)
+		if err != nil {
+			return fmt.Errorf("experiment %s: %w", file, err)
+		}
+
+		if !enabled {
+			continue
+		}
+
+		if running, ok := r.experiments[template]; ok {
+			return fmt.Errorf("experiment %s: %w: template %s is already under experiment %s",
+				file, ErrInvalidTemplate, template, running.id)
+		}
+
+		r.experiments[template] = x
+	}
+
+	return nil
+}
+
+func (r *registry) parseExperiment(name string, raw []byte) (*experiment, string, bool, error) {
+	var file experimentFile
+
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(&file)
+	if err != nil {
+		return nil, "", false, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	if file.ID != name {
+		return nil, "", false, fmt.Errorf("%w: id %q does not match the file name", ErrInvalidTemplate, file.ID)
+	}
+
+	if _, ok := r.entries[file.Template]; !ok {
+		return nil, "", false, fmt.Errorf("%w: %s", ErrUnknownTemplate, file.Template)
+	}
+
+	if len(file.Variants) < 2 {
+		return nil, "", false, fmt.Errorf("%w: an experiment needs at least 2 variants", ErrInvalidTemplate)
+	}
+
+	x := &experiment{id: file.ID}
+	names := map[string]bool{}
+
+	for _, v := range file.Variants {
+		if _, ok := r.entries[v.Template]; !ok {
+			return nil, "", false, fmt.Errorf("variant %s: %w: %s", v.Name, ErrUnknownTemplate, v.Template)
+		}
+
+		if v.Name == "" || names[v.Name] || v.Weight <= 0 {
+			return nil, "", false, fmt.Errorf("%w: variants need a unique name and a positive weight", ErrInvalidTemplate)
+		}
+
+		names[v.Name] = true
+		x.variants = append(x.variants, experimentVariant{name: v.Name, template: v.Template, weight: v.Weight})
+		x.totalWeight += v.Weight
+	}
+
+	return x, file.Template, file.Enabled, nil
+}
+
+// assign deterministically picks the variant of subject, in proportion to the variant weights.
+func (x *experiment) assign(subject string) experimentVariant {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(x.id + "|" + subject))
+
+	bucket := int(h.Sum64() % uint64(x.totalWeight))
+
+	for _, v := range x.variants {
+		if bucket < v.weight {
+			return v
+		}
+
+		bucket -= v.weight
+	}
+
+	return x.variants[len(x.variants)-1]
+}
+
+// subject is who an experiment assigns a variant to: the signed-in user, or for anonymous requests
+// the rendered vars, so that the same question always gets the same variant.
+func subject(ctx context.Context, vars Vars) string {
+	if userID, err := commonContext.GetUserIDString(ctx); err == nil {
+		return "user:" + userID
+	}
+
+	if userID, err := commonContext.GetUserIDInt(ctx); err == nil {
+		return fmt.Sprintf("user:%d", userID)
+	}
+
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var b strings.Builder
+
+	b.WriteString("anonymous")
+
+	for _, key := range keys {
+		b.WriteString("|" + key + "=" + vars[key])
+	}
+
+	return b.String()
+}
@@ -0,0 +1,54 @@
+package prompt
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+)
+
+// funcs are available to every template.
+var funcs = template.FuncMap{
+	"readings": readings,
+}
+
+// readingArticles is how each reading system is introduced, in the order they are listed.
+var readingArticles = []struct {
+	reading string
+	article string
+}{
+	{reading: languages.ReadingFurigana},
+	{reading: languages.ReadingPinyin},
+	{reading: languages.ReadingRomanization, article: "a "},
+}
+
+// readings tells which reading system to give for which languages of the catalogue, e.g.
+// "furigana for Japanese, pinyin for Chinese and a romanization for Arabic, ...".
+func readings() string {
+	var parts []string
+
+	for _, r := range readingArticles {
+		var names []string
+
+		for _, language := range languages.All() {
+			if language.Reading == r.reading {
+				names = append(names, language.Name)
+			}
+		}
+
+		if len(names) > 0 {
+			parts = append(parts, r.article+r.reading+" for "+join(names))
+		}
+	}
+
+	return join(parts)
+}
+
+// join lists items as in a sentence: "a, b and c".
+func join(items []string) string {
+	if len(items) < 2 {
+		return strings.Join(items, "")
+	}
+
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
@@ -0,0 +1,308 @@
+// Package prompt holds the prompts sent to the LLM provider. Every prompt is a template file under templates/
+// with an ID, a version and its own model settings, and services render them by name.
+package prompt
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/canonical"
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+)
+
+// Names of the embedded templates.
+const (
+	WordLookup             = "word_lookup"
+	WordLookupDefinition   = "word_lookup_definition"
+	WordLookupSynonyms     = "word_lookup_synonyms"
+	WordLookupHistory      = "word_lookup_history"
+	WordLookupDifficulty   = "word_lookup_difficulty"
+	SentenceTranslation    = "sentence_translation"
+	SentenceExplanation    = "sentence_explanation"
+	SentenceCorrection     = "sentence_correction"
+	SentenceSimplification = "sentence_simplification"
+	SentenceDifficulty     = "sentence_difficulty"
+	LanguageDetection      = "language_detection"
+	QuizGeneration         = "quiz_generation"
+)
+
+var (
+	ErrUnknownTemplate = errors.New("unknown prompt template")
+	ErrInvalidTemplate = errors.New("invalid prompt template")
+)
+
+//go:embed templates/*.yaml experiments/*.yaml
+var files embed.FS
+
+// Vars are the values a template is rendered with, e.g. {{.Word}}. Referencing a missing var is an error.
+type Vars map[string]string
+
+// Prompt is a rendered template, ready to be sent.
+type Prompt struct {
+	ID      string
+	Version string
+	// Experiment and Variant are set when the prompt was chosen by an experiment.
+	Experiment  string
+	Variant     string
+	Model       string
+	Temperature float32
+	MaxTokens   int
+	System      string
+	User        string
+}
+
+// Revision identifies the template and version the prompt was rendered from, e.g. "word_definition@v1".
+// It is part of the content keys, so that each variant of an experiment and each version of a template
+// has its own answers.
+func (p *Prompt) Revision() string {
+	return p.ID + "@" + p.Version
+}
+
+// Request returns the chat completion request for the prompt.
+func (p *Prompt) Request() *openai.OpenAIRequest {
+	request := &openai.OpenAIRequest{
+		Model:       p.Model,
+		Temperature: p.Temperature,
+		MaxTokens:   p.MaxTokens,
+	}
+
+	if p.System != "" {
+		request.Messages = append(request.Messages, openai.Message{Role: "system", Content: p.System})
+	}
+
+	request.Messages = append(request.Messages, openai.Message{Role: "user", Content: p.User})
+
+	return request
+}
+
+//go:generate mockgen -source=registry.go -destination=mock/registry.go
+type Registry interface {
+	// Render renders the template called name for a user whose native language is language,
+	// using the override for that language when the template has one.
+	// When name is under an experiment, the user found in ctx is assigned one of its variants.
+	Render(ctx context.Context, name, language string, vars Vars) (*Prompt, error)
+}
+
+type settings struct {
+	Model       string   `yaml:"model"`
+	Temperature *float32 `yaml:"temperature"`
+	MaxTokens   int      `yaml:"maxTokens"`
+	System      string   `yaml:"system"`
+	User        string   `yaml:"user"`
+}
+
+type templateFile struct {
+	ID       string `yaml:"id"`
+	Version  string `yaml:"version"`
+	settings `yaml:",inline"`
+	// Overrides are keyed by ISO 639-1 code.
+	Overrides map[string]settings `yaml:"overrides"`
+}
+
+type variant struct {
+	model       string
+	temperature float32
+	maxTokens   int
+	system      *template.Template
+	user        *template.Template
+}
+
+type entry struct {
+	id        string
+	version   string
+	base      variant
+	overrides map[string]variant
+}
+
+type registry struct {
+	entries map[string]*entry
+	// experiments are keyed by the name of the template they replace.
+	experiments map[string]*experiment
+}
+
+// NewRegistry returns the Registry of the embedded templates and experiments.
+func NewRegistry() (Registry, error) {
+	return NewRegistryFromFS(files)
+}
+
+// NewRegistryFromFS returns a Registry of every templates/*.yaml template and experiments/*.yaml experiment of fsys.
+func NewRegistryFromFS(fsys fs.FS) (Registry, error) {
+	templateFiles, err := fs.Glob(fsys, "templates/*.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+
+	r := &registry{
+		entries:     make(map[string]*entry, len(templateFiles)),
+		experiments: map[string]*experiment{},
+	}
+
+	for _, file := range templateFiles {
+		raw, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template %s: %w", file, err)
+		}
+
+		e, err := parseTemplate(strings.TrimSuffix(path.Base(file), ".yaml"), raw)
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", file, err)
+		}
+
+		r.entries[e.id] = e
+	}
+
+	err = r.loadExperiments(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func parseTemplate(name string, raw []byte) (*entry, error) {
+	var file templateFile
+
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(&file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	if file.ID != name {
+		return nil, fmt.Errorf("%w: id %q does not match the file name", ErrInvalidTemplate, file.ID)
+	}
+
+	if file.Version == "" {
+		return nil, fmt.Errorf("%w: missing version", ErrInvalidTemplate)
+	}
+
+	if file.Model == "" || file.MaxTokens <= 0 || file.Temperature == nil || file.User == "" {
+		return nil, fmt.Errorf("%w: model, temperature, maxTokens and user are required", ErrInvalidTemplate)
+	}
+
+	base, err := newVariant(file.settings, variant{})
+	if err != nil {
+		return nil, err
+	}
+
+	e := &entry{
+		id:        file.ID,
+		version:   file.Version,
+		base:      base,
+		overrides: make(map[string]variant, len(file.Overrides)),
+	}
+
+	for language, override := range file.Overrides {
+		code := canonical.Language(language)
+
+		e.overrides[code], err = newVariant(override, base)
+		if err != nil {
+			return nil, fmt.Errorf("override %s: %w", language, err)
+		}
+	}
+
+	return e, nil
+}
+
+// newVariant parses s, taking every field s leaves empty from parent.
+func newVariant(s settings, parent variant) (variant, error) {
+	v := parent
+
+	if s.Model != "" {
+		v.model = s.Model
+	}
+
+	if s.Temperature != nil {
+		v.temperature = *s.Temperature
+	}
+
+	if s.MaxTokens > 0 {
+		v.maxTokens = s.MaxTokens
+	}
+
+	var err error
+
+	if s.System != "" {
+		v.system, err = template.New("system").Funcs(funcs).Option("missingkey=error").Parse(s.System)
+		if err != nil {
+			return variant{}, fmt.Errorf("%w: system: %w", ErrInvalidTemplate, err)
+		}
+	}
+
+	if s.User != "" {
+		v.user, err = template.New("user").Funcs(funcs).Option("missingkey=error").Parse(s.User)
+		if err != nil {
+			return variant{}, fmt.Errorf("%w: user: %w", ErrInvalidTemplate, err)
+		}
+	}
+
+	return v, nil
+}
+
+func (r *registry) Render(ctx context.Context, name, language string, vars Vars) (*Prompt, error) {
+	e, ok := r.entries[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, name)
+	}
+
+	var experimentID, variantName string
+
+	if x, ok := r.experiments[name]; ok {
+		v := x.assign(subject(ctx, vars))
+		experimentID, variantName = x.id, v.name
+		e = r.entries[v.template]
+	}
+
+	v, ok := e.overrides[canonical.Language(language)]
+	if !ok {
+		v = e.base
+	}
+
+	system, err := execute(v.system, vars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render %s system prompt: %w", name, err)
+	}
+
+	user, err := execute(v.user, vars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render %s user prompt: %w", name, err)
+	}
+
+	return &Prompt{
+		ID:          e.id,
+		Version:     e.version,
+		Experiment:  experimentID,
+		Variant:     variantName,
+		Model:       v.model,
+		Temperature: v.temperature,
+		MaxTokens:   v.maxTokens,
+		System:      system,
+		User:        user,
+	}, nil
+}
+
+func execute(t *template.Template, vars Vars) (string, error) {
+	if t == nil {
+		return "", nil
+	}
+
+	var b strings.Builder
+
+	err := t.Execute(&b, vars)
+	if err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,338 @@
+package prompt_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+)
+
+func TestEmbeddedTemplatesRender(t *testing.T) {
+	registry, err := prompt.NewRegistry()
+	require.NoError(t, err)
+
+	wordVars := prompt.Vars{"Word": "猫", "Language": "English"}
+	sentenceVars := prompt.Vars{"Sentence": "猫が好きです。", "Language": "French"}
+
+	testCases := []struct {
+		name string
+		vars prompt.Vars
+	}{
+		{name: prompt.WordLookup, vars: wordVars},
+		{name: prompt.WordLookupDefinition, vars: wordVars},
+		{name: prompt.WordLookupSynonyms, vars: wordVars},
+		{name: prompt.WordLookupHistory, vars: wordVars},
+		{name: prompt.WordLookupDifficulty, vars: wordVars},
+		{name: prompt.SentenceTranslation, vars: sentenceVars},
+		{name: prompt.SentenceExplanation, vars: sentenceVars},
+		{name: prompt.SentenceCorrection, vars: sentenceVars},
+		{name: prompt.SentenceDifficulty, vars: sentenceVars},
+		{name: prompt.SentenceSimplification, vars: prompt.Vars{"Sentence": "猫が好きです。", "Language": "French", "Level": "A2"}},
+		{name: prompt.LanguageDetection, vars: prompt.Vars{"Text": "Привет"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := registry.Render(context.Background(), tc.name, tc.vars["Language"], tc.vars)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.name, p.ID)
+			assert.NotEmpty(t, p.Version)
+			assert.NotEmpty(t, p.Model)
+			assert.Positive(t, p.MaxTokens)
+			assert.Contains(t, p.User, tc.vars["Language"])
+
+			request := p.Request()
+			assert.Equal(t, p.Model, request.Model)
+			assert.Equal(t, p.User, request.Messages[len(request.Messages)-1].Content)
+		})
+	}
+}
+
+func TestReadingsComeFromTheLanguagesCatalogue(t *testing.T) {
+	registry, err := prompt.NewRegistry()
+	require.NoError(t, err)
+
+	p, err := registry.Render(context.Background(), prompt.WordLookupHistory, "English", prompt.Vars{"Word": "猫", "Language": "English"})
+	require.NoError(t, err)
+
+	assert.Contains(t, p.User, "include furigana for Japanese, pinyin for Chinese and a romanization for Arabic, Bengali,")
+	assert.Contains(t, p.User, " and Urdu,")
+}
+
+func TestRenderUsesLanguageOverride(t *testing.T) {
+	registry, err := prompt.NewRegistry()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		language        string
+		expectsOverride bool
+	}{
+		{language: "English", expectsOverride: true},
+		{language: "en", expectsOverride: true},
+		{language: "en-GB", expectsOverride: true},
+		{language: "French", expectsOverride: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.language, func(t *testing.T) {
+			p, err := registry.Render(context.Background(), prompt.SentenceCorrection, tc.language, prompt.Vars{"Sentence": "I has a cat.", "Language": tc.language})
+			require.NoError(t, err)
+
+			assert.Equal(t, !tc.expectsOverride, strings.Contains(p.User, "language teacher"))
+			assert.Contains(t, p.User, "I has a cat.")
+		})
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	registry, err := prompt.NewRegistry()
+	require.NoError(t, err)
+
+	_, err = registry.Render(context.Background(), "unknown", "en", prompt.Vars{})
+	assert.ErrorIs(t, err, prompt.ErrUnknownTemplate)
+
+	_, err = registry.Render(context.Background(), prompt.WordLookupDefinition, "en", prompt.Vars{"Language": "English"})
+	assert.Error(t, err)
+}
+
+func TestNewRegistryFromFS(t *testing.T) {
+	const valid = `
+id: greeting
+version: v3
+model: gpt-4o
+temperature: 0.3
+maxTokens: 100
+system: Be brief.
+user: Say hello in {{.Language}}.
+overrides:
+  Japanese:
+    model: gpt-4o-mini
+    user: 日本語で挨拶してください。
+`
+
+	testCases := []struct {
+		name        string
+		file        string
+		content     string
+		expectedErr error
+	}{
+		{name: "valid", file: "greeting.yaml", content: valid},
+		{name: "id does not match the file name", file: "hello.yaml", content: valid, expectedErr: prompt.ErrInvalidTemplate},
+		{
+			name:        "missing version",
+			file:        "greeting.yaml",
+			content:     "id: greeting\nmodel: gpt-4o\ntemperature: 0.3\nmaxTokens: 100\nuser: Hi\n",
+			expectedErr: prompt.ErrInvalidTemplate,
+		},
+		{
+			name:        "missing model settings",
+			file:        "greeting.yaml",
+			content:     "id: greeting\nversion: v1\nuser: Hi\n",
+			expectedErr: prompt.ErrInvalidTemplate,
+		},
+		{
+			name:        "unknown field",
+			file:        "greeting.yaml",
+			content:     "id: greeting\nversion: v1\nmodel: gpt-4o\ntemperature: 0.3\nmaxTokens: 100\nuser: Hi\ntemprature: 1\n",
+			expectedErr: prompt.ErrInvalidTemplate,
+		},
+		{
+			name:        "invalid template",
+			file:        "greeting.yaml",
+			content:     "id: greeting\nversion: v1\nmodel: gpt-4o\ntemperature: 0.3\nmaxTokens: 100\nuser: Hi {{.Language\n",
+			expectedErr: prompt.ErrInvalidTemplate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			registry, err := prompt.NewRegistryFromFS(fstest.MapFS{"templates/" + tc.file: {Data: []byte(tc.content)}})
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			p, err := registry.Render(context.Background(), "greeting", "French", prompt.Vars{"Language": "French"})
+			require.NoError(t, err)
+			assert.Equal(t, &prompt.Prompt{
+				ID:          "greeting",
+				Version:     "v3",
+				Model:       "gpt-4o",
+				Temperature: 0.3,
+				MaxTokens:   100,
+				System:      "Be brief.",
+				User:        "Say hello in French.",
+			}, p)
+
+			// The override is keyed by language name in the file and matched by ISO code.
+			p, err = registry.Render(context.Background(), "greeting", "ja", prompt.Vars{"Language": "Japanese"})
+			require.NoError(t, err)
+			assert.Equal(t, &prompt.Prompt{
+				ID:          "greeting",
+				Version:     "v3",
+				Model:       "gpt-4o-mini",
+				Temperature: 0.3,
+				MaxTokens:   100,
+				System:      "Be brief.",
+				User:        "日本語で挨拶してください。",
+			}, p)
+		})
+	}
+}
+
+func TestRenderAssignsExperimentVariants(t *testing.T) {
+	const template = `
+id: %s
+version: v2
+model: gpt-4o
+temperature: 0.3
+maxTokens: 100
+user: %s {{.Word}}
+`
+
+	const experiment = `
+id: greeting_test
+template: greeting
+enabled: %t
+variants:
+  - name: control
+    template: greeting
+    weight: 80
+  - name: casual
+    template: greeting_casual
+    weight: 20
+`
+
+	newRegistry := func(enabled bool) prompt.Registry {
+		registry, err := prompt.NewRegistryFromFS(fstest.MapFS{
+			"templates/greeting.yaml":        {Data: []byte(fmt.Sprintf(template, "greeting", "Hello"))},
+			"templates/greeting_casual.yaml": {Data: []byte(fmt.Sprintf(template, "greeting_casual", "Hi"))},
+			"experiments/greeting_test.yaml": {Data: []byte(fmt.Sprintf(experiment, enabled))},
+		})
+		require.NoError(t, err)
+
+		return registry
+	}
+
+	t.Run("disabled experiment", func(t *testing.T) {
+		p, err := newRegistry(false).Render(context.Background(), "greeting", "en", prompt.Vars{"Word": "cat"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "greeting", p.ID)
+		assert.Empty(t, p.Experiment)
+		assert.Empty(t, p.Variant)
+		assert.Equal(t, "greeting@v2", p.Revision())
+	})
+
+	t.Run("users are assigned deterministically in proportion to the weights", func(t *testing.T) {
+		registry := newRegistry(true)
+		served := map[string]int{}
+
+		for i := 0; i < 1000; i++ {
+			ctx := commonContext.SetUserIDString(context.Background(), fmt.Sprintf("user-%d", i))
+
+			p, err := registry.Render(ctx, "greeting", "en", prompt.Vars{"Word": "cat"})
+			require.NoError(t, err)
+			assert.Equal(t, "greeting_test", p.Experiment)
+
+			again, err := registry.Render(ctx, "greeting", "en", prompt.Vars{"Word": "dog"})
+			require.NoError(t, err)
+			assert.Equal(t, p.Variant, again.Variant, "a user keeps their variant")
+
+			served[p.Variant]++
+
+			if p.Variant == "casual" {
+				assert.Equal(t, "greeting_casual", p.ID)
+				assert.Equal(t, "Hi cat", p.User)
+			} else {
+				assert.Equal(t, "greeting", p.ID)
+				assert.Equal(t, "Hello cat", p.User)
+			}
+		}
+
+		assert.InDelta(t, 800, served["control"], 60)
+		assert.InDelta(t, 200, served["casual"], 60)
+	})
+
+	t.Run("anonymous requests are assigned by their input", func(t *testing.T) {
+		registry := newRegistry(true)
+		variants := map[string]bool{}
+
+		for i := 0; i < 50; i++ {
+			vars := prompt.Vars{"Word": fmt.Sprintf("word-%d", i)}
+
+			p, err := registry.Render(context.Background(), "greeting", "en", vars)
+			require.NoError(t, err)
+
+			again, err := registry.Render(context.Background(), "greeting", "en", vars)
+			require.NoError(t, err)
+			assert.Equal(t, p.Variant, again.Variant)
+
+			variants[p.Variant] = true
+		}
+
+		assert.Len(t, variants, 2)
+	})
+}
+
+func TestNewRegistryFromFSRejectsInvalidExperiments(t *testing.T) {
+	const template = "id: greeting\nversion: v1\nmodel: gpt-4o\ntemperature: 0.3\nmaxTokens: 100\nuser: Hi\n"
+
+	testCases := []struct {
+		name        string
+		experiments map[string]string
+		expectedErr error
+	}{
+		{
+			name: "unknown variant template",
+			experiments: map[string]string{
+				"a": "id: a\ntemplate: greeting\nenabled: true\nvariants:\n  - {name: control, template: greeting, weight: 1}\n  - {name: b, template: missing, weight: 1}\n",
+			},
+			expectedErr: prompt.ErrUnknownTemplate,
+		},
+		{
+			name: "single variant",
+			experiments: map[string]string{
+				"a": "id: a\ntemplate: greeting\nenabled: true\nvariants:\n  - {name: control, template: greeting, weight: 1}\n",
+			},
+			expectedErr: prompt.ErrInvalidTemplate,
+		},
+		{
+			name: "zero weight",
+			experiments: map[string]string{
+				"a": "id: a\ntemplate: greeting\nenabled: true\nvariants:\n  - {name: control, template: greeting, weight: 1}\n  - {name: b, template: greeting, weight: 0}\n",
+			},
+			expectedErr: prompt.ErrInvalidTemplate,
+		},
+		{
+			name: "two experiments on one template",
+			experiments: map[string]string{
+				"a": "id: a\ntemplate: greeting\nenabled: true\nvariants:\n  - {name: control, template: greeting, weight: 1}\n  - {name: b, template: greeting, weight: 1}\n",
+				"b": "id: b\ntemplate: greeting\nenabled: true\nvariants:\n  - {name: control, template: greeting, weight: 1}\n  - {name: b, template: greeting, weight: 1}\n",
+			},
+			expectedErr: prompt.ErrInvalidTemplate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fsys := fstest.MapFS{"templates/greeting.yaml": {Data: []byte(template)}}
+			for name, content := range tc.experiments {
+				fsys["experiments/"+name+".yaml"] = &fstest.MapFile{Data: []byte(content)}
+			}
+
+			_, err := prompt.NewRegistryFromFS(fsys)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import "time"
+
+// Types of questions.
+const (
+	// TypeMultipleChoice asks what a word means.
+	TypeMultipleChoice = "multiple_choice"
+	// TypeCloze asks which word fills the blank of a sentence.
+	TypeCloze = "cloze"
+	// TypeTranslation asks for the translation of a sentence using a word.
+	TypeTranslation = "translation"
+)
+
+// Types are the types of questions.
+var Types = []string{TypeMultipleChoice, TypeCloze, TypeTranslation}
+
+// Question is a multiple choice question on a saved word.
+type Question struct {
+	// EntryIDs are the deck entries of the word, which are graded by the answer.
+	EntryIDs []string
+	Word     string
+	Type     string
+	// Prompt is the question, in the user's native language.
+	Prompt string
+	// Sentence is the sentence with a blank of cloze questions and the sentence to translate of translation
+	// questions, and empty for multiple choice questions.
+	Sentence string
+	Options  []string
+	// Answer is the index of the correct option.
+	Answer int
+	// Explanation explains the correct option, in the user's native language.
+	Explanation string
+}
+
+// Quiz is a quiz with one question per word.
+type Quiz struct {
+	ID             string
+	NativeLanguage string
+	Questions      []Question
+	// Answers are the indexes of the options chosen for each question, -1 when unanswered, and nil until the quiz
+	// is submitted.
+	Answers     []int
+	CreatedAt   time.Time
+	SubmittedAt *time.Time
+}
+
+// Request selects the words of a new quiz.
+type Request struct {
+	// DeckID restricts the quiz to the words of one deck when not empty.
+	DeckID         string
+	NativeLanguage string
+	// Size is the number of questions.
+	Size int
+	// Types restricts the questions to some types when not empty.
+	Types []string
+}
+
+// Tally counts the correct answers of a set of questions.
+type Tally struct {
+	Correct int
+	Total   int
+}
+
+// Result is the score of a submitted quiz.
+type Result struct {
+	Quiz  Quiz
+	Total Tally
+	// ByType is the score of each type of question in the quiz.
+	ByType map[string]Tally
+}
@@ -0,0 +1,399 @@
+// Package quiz generates vocabulary quizzes on the words users saved, scores them, and grades the reviews of the
+// words by the answers.
+package quiz
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"go.uber.org/zap"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/srs"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
+	worddomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
+)
+
+// Sizes of a quiz, in questions.
+const (
+	DefaultSize = 10
+	MaxSize     = 20
+)
+
+var (
+	ErrQuizNotFound = errors.New("quiz not found")
+	// ErrNoWords is returned when the user has no saved word due for review to quiz on.
+	ErrNoWords          = errors.New("no words to quiz on")
+	ErrInvalidType      = errors.New("invalid question type")
+	ErrInvalidAnswer    = errors.New("invalid answer")
+	ErrAlreadySubmitted = errors.New("quiz already submitted")
+	ErrNotSubmitted     = errors.New("quiz not submitted")
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+type Service interface {
+	// Start generates a quiz with one question on each of the words of the user most due for review. Sizes outside
+	// 1 to MaxSize are DefaultSize or MaxSize.
+	Start(ctx context.Context, userID string, request domain.Request) (*domain.Quiz, error)
+	// Get returns ErrQuizNotFound when the user has no quiz with that ID.
+	Get(ctx context.Context, userID, quizID string) (*domain.Quiz, error)
+	// Submit scores the options chosen for each question, by question index, and grades the review of each word:
+	// good when it was answered right, again otherwise. A quiz can only be submitted once.
+	Submit(ctx context.Context, userID, quizID string, answers map[int]int) (*domain.Result, error)
+	// Score returns the result of a submitted quiz, and ErrNotSubmitted before it is.
+	Score(ctx context.Context, userID, quizID string) (*domain.Result, error)
+}
+
+type service struct {
+	logger        *zap.Logger
+	repository    storage.QuizRepository
+	openAiClient  openai.Client
+	prompts       prompt.Registry
+	wordService   word.Service
+	reviewService reviews.Service
+}
+
+func NewQuizService(
+	logger *zap.Logger,
+	repository storage.QuizRepository,
+	openAiClient openai.Client,
+	prompts prompt.Registry,
+	wordService word.Service,
+	reviewService reviews.Service,
+) Service {
+	return &service{
+		logger:        logger,
+		repository:    repository,
+		openAiClient:  openAiClient,
+		prompts:       prompts,
+		wordService:   wordService,
+		reviewService: reviewService,
+	}
+}
+
+func (s *service) Start(ctx context.Context, userID string, request domain.Request) (*domain.Quiz, error) {
+	types := request.Types
+	if len(types) == 0 {
+		types = domain.Types
+	}
+
+	for _, questionType := range types {
+		if !slices.Contains(domain.Types, questionType) {
+			return nil, fmt.Errorf("%w %q", ErrInvalidType, questionType)
+		}
+	}
+
+	size := request.Size
+	if size < 1 {
+		size = DefaultSize
+	}
+
+	items, err := s.reviewService.Due(ctx, userID, request.DeckID, min(size, MaxSize))
+	if err != nil {
+		return nil, err
+	}
+
+	// A word saved in several decks is asked once, and its answer grades all of them.
+	var words []string
+
+	entryIDs := map[string][]string{}
+	meanings := map[string]string{}
+
+	for _, item := range items {
+		entry := item.Entry
+		if _, ok := entryIDs[entry.Word]; !ok {
+			words = append(words, entry.Word)
+			meanings[entry.Word] = s.meaning(ctx, entry.Word, entry.NativeLanguage, entry.Details.Definition)
+		}
+
+		entryIDs[entry.Word] = append(entryIDs[entry.Word], entry.ID)
+	}
+
+	if len(words) == 0 {
+		return nil, ErrNoWords
+	}
+
+	questions, err := s.generate(ctx, words, meanings, types, request.NativeLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range questions {
+		questions[i].EntryIDs = entryIDs[questions[i].Word]
+	}
+
+	raw, err := json.Marshal(questions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal quiz questions: %w", err)
+	}
+
+	quiz, err := s.repository.Insert(ctx, &entity.Quiz{
+		UserID:         userID,
+		NativeLanguage: languages.Normalize(request.NativeLanguage),
+		Questions:      string(raw),
+		QuestionCount:  len(questions),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomain(quiz)
+}
+
+// meaning returns the first sense of the word, looking it up when its saved entry has no definition. It is empty
+// when the lookup fails, as the quiz can do without it.
+func (s *service) meaning(ctx context.Context, word, nativeLanguage string, definition *worddomain.Definition) string {
+	if definition == nil {
+		details, err := s.wordService.Lookup(ctx, word, nativeLanguage)
+		if err != nil {
+			s.logger.Warn("failed to look up quiz word", zap.String("word", word), zap.Error(err))
+			return ""
+		}
+
+		definition = details.Definition
+	}
+
+	if definition == nil || len(definition.Senses) == 0 {
+		return ""
+	}
+
+	return definition.Senses[0].Gloss
+}
+
+// generate asks for one question on each word and checks the answer.
+func (s *service) generate(
+	ctx context.Context,
+	words []string,
+	meanings map[string]string,
+	types []string,
+	nativeLanguage string,
+) ([]domain.Question, error) {
+	lines := make([]string, 0, len(words))
+	for _, word := range words {
+		lines = append(lines, fmt.Sprintf("- %s: %s", word, meanings[word]))
+	}
+
+	p, err := s.prompts.Render(ctx, prompt.QuizGeneration, nativeLanguage, prompt.Vars{
+		"Words":    strings.Join(lines, "\n"),
+		"Types":    strings.Join(types, ", "),
+		"Language": languages.Normalize(nativeLanguage),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render prompt: %w", err)
+	}
+
+	request := p.Request()
+	request.ResponseFormat = openai.NewJSONSchemaFormat("quiz", quizSchema(words, types))
+
+	completion, err := s.openAiClient.CreateChatCompletion(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make open ai request: %w", err)
+	}
+
+	s.logger.Info("Successfully generated quiz",
+		zap.Int("words", len(words)),
+		zap.String("nativeLanguage", nativeLanguage),
+		zap.String("prompt", p.ID),
+		zap.String("promptVersion", p.Version),
+		zap.Int("promptTokens", completion.Usage.PromptTokens),
+		zap.Int("completionTokens", completion.Usage.CompletionTokens),
+		zap.Int("totalTokens", completion.Usage.TotalTokens),
+	)
+
+	experiment.Observe(ctx, p, completion.Usage)
+
+	var payload quizPayload
+
+	if err := openai.ParseStructured(completion.Content(), &payload); err != nil {
+		return nil, err
+	}
+
+	if err := payload.check(words, types); err != nil {
+		return nil, err
+	}
+
+	questions := make([]domain.Question, 0, len(payload.Questions))
+	for _, question := range payload.Questions {
+		questions = append(questions, question.toDomain())
+	}
+
+	return questions, nil
+}
+
+func (s *service) Get(ctx context.Context, userID, quizID string) (*domain.Quiz, error) {
+	quiz, err := s.get(ctx, userID, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomain(quiz)
+}
+
+func (s *service) Submit(ctx context.Context, userID, quizID string, answers map[int]int) (*domain.Result, error) {
+	quiz, err := s.get(ctx, userID, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	if quiz.SubmittedAt.Valid {
+		return nil, ErrAlreadySubmitted
+	}
+
+	result, err := toDomain(quiz)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Answers = make([]int, len(result.Questions))
+	for i := range result.Answers {
+		result.Answers[i] = -1
+	}
+
+	for question, option := range answers {
+		if question < 0 || question >= len(result.Questions) || option < 0 || option >= len(result.Questions[question].Options) {
+			return nil, fmt.Errorf("%w: option %d of question %d", ErrInvalidAnswer, option, question)
+		}
+
+		result.Answers[question] = option
+	}
+
+	score := toResult(*result)
+
+	raw, err := json.Marshal(result.Answers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal answers of quiz %s: %w", quizID, err)
+	}
+
+	quiz.Answers = null.StringFrom(string(raw))
+	quiz.CorrectCount = null.IntFrom(score.Total.Correct)
+	quiz.SubmittedAt = null.TimeFrom(time.Now().UTC())
+
+	submitted, err := s.repository.Submit(ctx, quiz)
+	if err != nil {
+		return nil, err
+	}
+
+	if submitted == 0 {
+		return nil, ErrAlreadySubmitted
+	}
+
+	score.Quiz.SubmittedAt = &quiz.SubmittedAt.Time
+
+	s.gradeReviews(ctx, userID, score.Quiz)
+
+	return &score, nil
+}
+
+// gradeReviews grades the review of the entries of each question by its answer. The quiz is scored already, so
+// failures are only logged.
+func (s *service) gradeReviews(ctx context.Context, userID string, quiz domain.Quiz) {
+	for i, question := range quiz.Questions {
+		rating := srs.Again
+		if quiz.Answers[i] == question.Answer {
+			rating = srs.Good
+		}
+
+		for _, entryID := range question.EntryIDs {
+			_, err := s.reviewService.Grade(ctx, userID, entryID, rating)
+			if err != nil && !errors.Is(err, reviews.ErrEntryNotFound) {
+				s.logger.Warn("failed to grade review from quiz",
+					zap.String("quizID", quiz.ID),
+					zap.String("entryID", entryID),
+					zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *service) Score(ctx context.Context, userID, quizID string) (*domain.Result, error) {
+	quiz, err := s.Get(ctx, userID, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	if quiz.SubmittedAt == nil {
+		return nil, ErrNotSubmitted
+	}
+
+	result := toResult(*quiz)
+
+	return &result, nil
+}
+
+// get returns ErrQuizNotFound when the user has no quiz with that ID.
+func (s *service) get(ctx context.Context, userID, quizID string) (*entity.Quiz, error) {
+	if _, err := uuid.Parse(quizID); err != nil {
+		return nil, ErrQuizNotFound
+	}
+
+	quiz, err := s.repository.Get(ctx, userID, quizID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrQuizNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiz %s: %w", quizID, err)
+	}
+
+	return quiz, nil
+}
+
+// toResult scores a quiz with its answers.
+func toResult(quiz domain.Quiz) domain.Result {
+	result := domain.Result{
+		Quiz:   quiz,
+		ByType: map[string]domain.Tally{},
+	}
+
+	for i, question := range quiz.Questions {
+		tally := result.ByType[question.Type]
+		tally.Total++
+		result.Total.Total++
+
+		if quiz.Answers[i] == question.Answer {
+			tally.Correct++
+			result.Total.Correct++
+		}
+
+		result.ByType[question.Type] = tally
+	}
+
+	return result
+}
+
+func toDomain(quiz *entity.Quiz) (*domain.Quiz, error) {
+	result := &domain.Quiz{
+		ID:             quiz.ID,
+		NativeLanguage: quiz.NativeLanguage,
+		CreatedAt:      quiz.CreatedAt,
+	}
+
+	if err := json.Unmarshal([]byte(quiz.Questions), &result.Questions); err != nil {
+		return nil, fmt.Errorf("invalid questions of quiz %s: %w", quiz.ID, err)
+	}
+
+	if quiz.SubmittedAt.Valid {
+		result.SubmittedAt = &quiz.SubmittedAt.Time
+
+		if err := json.Unmarshal([]byte(quiz.Answers.String), &result.Answers); err != nil {
+			return nil, fmt.Errorf("invalid answers of quiz %s: %w", quiz.ID, err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,233 @@
+package quiz_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+	mockopenai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/mock"
+	decksdomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz/domain"
+	mockstorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz/storage/mock"
+	reviewsdomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews/domain"
+	reviewsmock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/srs"
+	worddomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
+	wordmock "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/mock"
+)
+
+const (
+	userID = "user-1"
+	quizID = "6f1c2d4e-0d1a-4a57-9b1e-3f6a2b7c8d90"
+)
+
+type mocks struct {
+	repository    *mockstorage.MockQuizRepository
+	client        *mockopenai.MockClient
+	wordService   *wordmock.MockService
+	reviewService *reviewsmock.MockService
+}
+
+func newService(t *testing.T) (quiz.Service, mocks) {
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		repository:    mockstorage.NewMockQuizRepository(ctrl),
+		client:        mockopenai.NewMockClient(ctrl),
+		wordService:   wordmock.NewMockService(ctrl),
+		reviewService: reviewsmock.NewMockService(ctrl),
+	}
+
+	prompts, err := prompt.NewRegistry()
+	require.NoError(t, err)
+
+	return quiz.NewQuizService(zaptest.NewLogger(t), m.repository, m.client, prompts, m.wordService, m.reviewService), m
+}
+
+func completion(content string) *openai.ChatCompletion {
+	return &openai.ChatCompletion{
+		Choices: []openai.Choice{{Message: openai.Message{Role: "assistant", Content: content}}},
+	}
+}
+
+func dueItem(entryID, word string, definition *worddomain.Definition) reviewsdomain.Item {
+	return reviewsdomain.Item{Entry: decksdomain.Entry{
+		ID:             entryID,
+		Word:           word,
+		NativeLanguage: "English",
+		Details:        worddomain.LookupDetails{Definition: definition},
+	}}
+}
+
+const (
+	catQuestion = `{"word": "gato", "type": "multiple_choice", "prompt": "What does gato mean?", "sentence": "",
+		"options": [{"text": "dog", "correct": false}, {"text": "cat", "correct": true}, {"text": "bird", "correct": false}],
+		"explanation": "Gato is a cat."}`
+	dogQuestion = `{"word": "perro", "type": "cloze", "prompt": "Fill in the blank.", "sentence": "El ___ ladra.",
+		"options": [{"text": "perro", "correct": true}, {"text": "gato", "correct": false}, {"text": "pez", "correct": false}],
+		"explanation": "Dogs bark."}`
+)
+
+func TestStartAsksOneQuestionPerWord(t *testing.T) {
+	service, m := newService(t)
+
+	cat := &worddomain.Definition{Senses: []worddomain.Sense{{Gloss: "cat"}}}
+
+	m.reviewService.EXPECT().Due(gomock.Any(), userID, "", quiz.DefaultSize).Return([]reviewsdomain.Item{
+		dueItem("entry-1", "gato", cat),
+		dueItem("entry-2", "perro", nil),
+		dueItem("entry-3", "gato", cat),
+	}, nil)
+	m.wordService.EXPECT().Lookup(gomock.Any(), "perro", "English").Return(&worddomain.LookupDetails{
+		Definition: &worddomain.Definition{Senses: []worddomain.Sense{{Gloss: "dog"}}},
+	}, nil)
+
+	m.client.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+			require.NotNil(t, request.ResponseFormat)
+			assert.Contains(t, string(request.ResponseFormat.JSONSchema.Schema), `"enum":["gato","perro"]`)
+			assert.Contains(t, request.Messages[len(request.Messages)-1].Content, "- perro: dog")
+
+			return completion(`{"questions": [` + catQuestion + `,` + dogQuestion + `]}`), nil
+		})
+
+	m.repository.EXPECT().Insert(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, quiz *entity.Quiz) (*entity.Quiz, error) {
+			assert.Equal(t, 2, quiz.QuestionCount)
+			quiz.ID = quizID
+
+			return quiz, nil
+		})
+
+	result, err := service.Start(context.Background(), userID, domain.Request{NativeLanguage: "en"})
+	require.NoError(t, err)
+
+	require.Len(t, result.Questions, 2)
+	assert.Equal(t, quizID, result.ID)
+	assert.Equal(t, []string{"entry-1", "entry-3"}, result.Questions[0].EntryIDs)
+	assert.Equal(t, 1, result.Questions[0].Answer)
+	assert.Equal(t, []string{"entry-2"}, result.Questions[1].EntryIDs)
+	assert.Equal(t, 0, result.Questions[1].Answer)
+}
+
+func TestStartRefusesInvalidQuestions(t *testing.T) {
+	testCases := map[string]string{
+		"two correct options": `{"word": "gato", "type": "multiple_choice", "prompt": "?", "sentence": "",
+			"options": [{"text": "cat", "correct": true}, {"text": "kitty", "correct": true}, {"text": "dog", "correct": false}],
+			"explanation": "."}`,
+		"no correct option": `{"word": "gato", "type": "multiple_choice", "prompt": "?", "sentence": "",
+			"options": [{"text": "dog", "correct": false}, {"text": "bird", "correct": false}], "explanation": "."}`,
+		"repeated option": `{"word": "gato", "type": "multiple_choice", "prompt": "?", "sentence": "",
+			"options": [{"text": "cat", "correct": true}, {"text": "Cat ", "correct": false}], "explanation": "."}`,
+		"cloze without blank": `{"word": "gato", "type": "cloze", "prompt": "?", "sentence": "El gato duerme.",
+			"options": [{"text": "gato", "correct": true}, {"text": "perro", "correct": false}], "explanation": "."}`,
+		"unknown word": `{"word": "pez", "type": "multiple_choice", "prompt": "?", "sentence": "",
+			"options": [{"text": "fish", "correct": true}, {"text": "dog", "correct": false}], "explanation": "."}`,
+		"type not asked for": `{"word": "gato", "type": "translation", "prompt": "?", "sentence": "El gato duerme.",
+			"options": [{"text": "The cat sleeps.", "correct": true}, {"text": "The dog runs.", "correct": false}],
+			"explanation": "."}`,
+	}
+
+	for name, question := range testCases {
+		t.Run(name, func(t *testing.T) {
+			service, m := newService(t)
+
+			m.reviewService.EXPECT().Due(gomock.Any(), userID, "", quiz.DefaultSize).Return([]reviewsdomain.Item{
+				dueItem("entry-1", "gato", &worddomain.Definition{Senses: []worddomain.Sense{{Gloss: "cat"}}}),
+			}, nil)
+			m.client.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).
+				Return(completion(`{"questions": [`+question+`]}`), nil)
+
+			_, err := service.Start(context.Background(), userID, domain.Request{
+				NativeLanguage: "English",
+				Types:          []string{domain.TypeMultipleChoice, domain.TypeCloze},
+			})
+			assert.ErrorIs(t, err, openaierrors.ErrInvalidStructuredOutput)
+		})
+	}
+}
+
+func TestStartWithoutDueWords(t *testing.T) {
+	service, m := newService(t)
+
+	m.reviewService.EXPECT().Due(gomock.Any(), userID, "", quiz.MaxSize).Return(nil, nil)
+
+	_, err := service.Start(context.Background(), userID, domain.Request{NativeLanguage: "English", Size: 50})
+	assert.ErrorIs(t, err, quiz.ErrNoWords)
+}
+
+func storedQuiz(t *testing.T) *entity.Quiz {
+	questions, err := json.Marshal([]domain.Question{
+		{EntryIDs: []string{"entry-1", "entry-3"}, Word: "gato", Type: domain.TypeMultipleChoice, Options: []string{"dog", "cat", "bird"}, Answer: 1},
+		{EntryIDs: []string{"entry-2"}, Word: "perro", Type: domain.TypeCloze, Options: []string{"perro", "gato", "pez"}, Answer: 0},
+		{EntryIDs: []string{"entry-4"}, Word: "pez", Type: domain.TypeCloze, Options: []string{"pez", "gato"}, Answer: 0},
+	})
+	require.NoError(t, err)
+
+	return &entity.Quiz{ID: quizID, UserID: userID, Questions: string(questions), QuestionCount: 3}
+}
+
+func TestSubmitScoresAndGradesReviews(t *testing.T) {
+	service, m := newService(t)
+
+	m.repository.EXPECT().Get(gomock.Any(), userID, quizID).Return(storedQuiz(t), nil)
+	m.repository.EXPECT().Submit(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, quiz *entity.Quiz) (int, error) {
+			assert.JSONEq(t, `[1, 2, -1]`, quiz.Answers.String)
+			assert.Equal(t, null.IntFrom(1), quiz.CorrectCount)
+			assert.True(t, quiz.SubmittedAt.Valid)
+
+			return 1, nil
+		})
+
+	m.reviewService.EXPECT().Grade(gomock.Any(), userID, "entry-1", srs.Good)
+	m.reviewService.EXPECT().Grade(gomock.Any(), userID, "entry-3", srs.Good)
+	m.reviewService.EXPECT().Grade(gomock.Any(), userID, "entry-2", srs.Again)
+	m.reviewService.EXPECT().Grade(gomock.Any(), userID, "entry-4", srs.Again)
+
+	result, err := service.Submit(context.Background(), userID, quizID, map[int]int{0: 1, 1: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.Tally{Correct: 1, Total: 3}, result.Total)
+	assert.Equal(t, domain.Tally{Correct: 1, Total: 1}, result.ByType[domain.TypeMultipleChoice])
+	assert.Equal(t, domain.Tally{Correct: 0, Total: 2}, result.ByType[domain.TypeCloze])
+	assert.NotNil(t, result.Quiz.SubmittedAt)
+}
+
+func TestSubmitRefusals(t *testing.T) {
+	t.Run("option out of range", func(t *testing.T) {
+		service, m := newService(t)
+		m.repository.EXPECT().Get(gomock.Any(), userID, quizID).Return(storedQuiz(t), nil)
+
+		_, err := service.Submit(context.Background(), userID, quizID, map[int]int{2: 2})
+		assert.ErrorIs(t, err, quiz.ErrInvalidAnswer)
+	})
+
+	t.Run("submitted already", func(t *testing.T) {
+		service, m := newService(t)
+		m.repository.EXPECT().Get(gomock.Any(), userID, quizID).Return(storedQuiz(t), nil)
+		m.repository.EXPECT().Submit(gomock.Any(), gomock.Any()).Return(0, nil)
+
+		_, err := service.Submit(context.Background(), userID, quizID, nil)
+		assert.ErrorIs(t, err, quiz.ErrAlreadySubmitted)
+	})
+
+	t.Run("quiz of another user", func(t *testing.T) {
+		service, m := newService(t)
+		m.repository.EXPECT().Get(gomock.Any(), userID, quizID).Return(nil, sql.ErrNoRows)
+
+		_, err := service.Submit(context.Background(), userID, quizID, nil)
+		assert.ErrorIs(t, err, quiz.ErrQuizNotFound)
+	})
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=mock/repository.go
+//
+
+// Package mock_storage is a generated GoMock package.
+package mock_storage
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+// MockQuizRepository is a mock of QuizRepository interface.
+type MockQuizRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuizRepositoryMockRecorder
+}
+
+// MockQuizRepositoryMockRecorder is the mock recorder for MockQuizRepository.
+type MockQuizRepositoryMockRecorder struct {
+	mock *MockQuizRepository
+}
+
+// NewMockQuizRepository creates a new mock instance.
+func NewMockQuizRepository(ctrl *gomock.Controller) *MockQuizRepository {
+	mock := &MockQuizRepository{ctrl: ctrl}
+	mock.recorder = &MockQuizRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuizRepository) EXPECT() *MockQuizRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockQuizRepository) Get(ctx context.Context, userID, quizID string) (*entity.Quiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, quizID)
+	ret0, _ := ret[0].(*entity.Quiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockQuizRepositoryMockRecorder) Get(ctx, userID, quizID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockQuizRepository)(nil).Get), ctx, userID, quizID)
+}
+
+// Insert mocks base method.
+func (m *MockQuizRepository) Insert(ctx context.Context, quiz *entity.Quiz) (*entity.Quiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, quiz)
+	ret0, _ := ret[0].(*entity.Quiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockQuizRepositoryMockRecorder) Insert(ctx, quiz any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockQuizRepository)(nil).Insert), ctx, quiz)
+}
+
+// Submit mocks base method.
+func (m *MockQuizRepository) Submit(ctx context.Context, quiz *entity.Quiz) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, quiz)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockQuizRepositoryMockRecorder) Submit(ctx, quiz any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockQuizRepository)(nil).Submit), ctx, quiz)
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+//go:generate mockgen -source=repository.go -destination=mock/repository.go
+type QuizRepository interface {
+	Insert(ctx context.Context, quiz *entity.Quiz) (*entity.Quiz, error)
+	// Get returns sql.ErrNoRows when the user has no quiz with that ID.
+	Get(ctx context.Context, userID, quizID string) (*entity.Quiz, error)
+	// Submit saves the answers and score of the quiz unless it was submitted already, and returns the number of
+	// quizzes updated.
+	Submit(ctx context.Context, quiz *entity.Quiz) (int, error)
+}
+
+type quizRepository struct {
+	db *sqlx.DB
+}
+
+func NewQuizRepository(db *sqlx.DB) QuizRepository {
+	return &quizRepository{
+		db: db,
+	}
+}
+
+func (r *quizRepository) Insert(ctx context.Context, quiz *entity.Quiz) (*entity.Quiz, error) {
+	if err := quiz.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to insert quiz: %w", err)
+	}
+
+	return quiz, nil
+}
+
+func (r *quizRepository) Get(ctx context.Context, userID, quizID string) (*entity.Quiz, error) {
+	return entity.Quizzes(
+		entity.QuizWhere.ID.EQ(quizID),
+		entity.QuizWhere.UserID.EQ(userID),
+	).One(ctx, r.db)
+}
+
+func (r *quizRepository) Submit(ctx context.Context, quiz *entity.Quiz) (int, error) {
+	// The check on submitted_at makes concurrent submissions of the same quiz count once.
+	updated, err := entity.Quizzes(
+		entity.QuizWhere.ID.EQ(quiz.ID),
+		entity.QuizWhere.UserID.EQ(quiz.UserID),
+		entity.QuizWhere.SubmittedAt.IsNull(),
+	).UpdateAll(ctx, r.db, entity.M{
+		entity.QuizColumns.Answers:      quiz.Answers,
+		entity.QuizColumns.CorrectCount: quiz.CorrectCount,
+		entity.QuizColumns.SubmittedAt:  quiz.SubmittedAt,
+		entity.QuizColumns.UpdatedAt:    quiz.SubmittedAt,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to submit quiz %s: %w", quiz.ID, err)
+	}
+
+	return int(updated), nil
+}
@@ -0,0 +1,169 @@
+package quiz
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/quiz/domain"
+)
+
+// Blank marks the missing word in the sentence of cloze questions.
+const Blank = "___"
+
+// quizPayload mirrors quizSchema.
+type quizPayload struct {
+	Questions []questionPayload `json:"questions" validate:"min=1,dive"`
+}
+
+type questionPayload struct {
+	Word        string          `json:"word" validate:"required"`
+	Type        string          `json:"type" validate:"oneof=multiple_choice cloze translation"`
+	Prompt      string          `json:"prompt" validate:"required"`
+	Sentence    string          `json:"sentence"`
+	Options     []optionPayload `json:"options" validate:"min=2,max=6,dive"`
+	Explanation string          `json:"explanation" validate:"required"`
+}
+
+type optionPayload struct {
+	Text    string `json:"text" validate:"required"`
+	Correct bool   `json:"correct"`
+}
+
+// quizSchema returns the structured output schema of a quiz on words, with questions of the given types.
+func quizSchema(words, types []string) json.RawMessage {
+	option := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"text":    map[string]any{"type": "string", "description": "The option."},
+			"correct": map[string]any{"type": "boolean", "description": "Whether the option is the correct answer."},
+		},
+		"required":             []string{"correct", "text"},
+		"additionalProperties": false,
+	}
+
+	question := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"word": map[string]any{"type": "string", "enum": words, "description": "The word the question is on."},
+			"type": map[string]any{"type": "string", "enum": types, "description": "The type of question."},
+			"prompt": map[string]any{
+				"type":        "string",
+				"description": "The question, written in the user's language.",
+			},
+			"sentence": map[string]any{
+				"type": "string",
+				"description": "For cloze questions, a sentence in the language of the word with " + Blank +
+					" in place of the word. For translation questions, the sentence to translate. Empty otherwise.",
+			},
+			"options": map[string]any{
+				"type":        "array",
+				"items":       option,
+				"minItems":    3,
+				"maxItems":    5,
+				"description": "The options, exactly one of them correct, in random order.",
+			},
+			"explanation": map[string]any{
+				"type":        "string",
+				"description": "Why the correct option is correct, written in the user's language.",
+			},
+		},
+		"required":             []string{"explanation", "options", "prompt", "sentence", "type", "word"},
+		"additionalProperties": false,
+	}
+
+	raw, err := json.Marshal(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"questions": map[string]any{
+				"type":        "array",
+				"items":       question,
+				"minItems":    len(words),
+				"maxItems":    len(words),
+				"description": "One question for each word.",
+			},
+		},
+		"required":             []string{"questions"},
+		"additionalProperties": false,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("invalid json schema: %v", err))
+	}
+
+	return raw
+}
+
+// check returns ErrInvalidStructuredOutput unless p has one question of the given types on each word, and every
+// question exactly one correct option among distinct ones.
+func (p quizPayload) check(words, types []string) error {
+	if len(p.Questions) != len(words) {
+		return fmt.Errorf("%w: %d questions on %d words", openaierrors.ErrInvalidStructuredOutput, len(p.Questions), len(words))
+	}
+
+	asked := map[string]bool{}
+
+	for i, question := range p.Questions {
+		if !slices.Contains(words, question.Word) || asked[question.Word] {
+			return fmt.Errorf("%w: question %d is on %q", openaierrors.ErrInvalidStructuredOutput, i, question.Word)
+		}
+
+		asked[question.Word] = true
+
+		if !slices.Contains(types, question.Type) {
+			return fmt.Errorf("%w: question %d is a %s question", openaierrors.ErrInvalidStructuredOutput, i, question.Type)
+		}
+
+		switch {
+		case question.Type == domain.TypeCloze && strings.Count(question.Sentence, Blank) != 1:
+			return fmt.Errorf("%w: cloze question %d needs one blank", openaierrors.ErrInvalidStructuredOutput, i)
+		case question.Type == domain.TypeTranslation && strings.TrimSpace(question.Sentence) == "":
+			return fmt.Errorf("%w: translation question %d has no sentence", openaierrors.ErrInvalidStructuredOutput, i)
+		}
+
+		correct := 0
+		seen := map[string]bool{}
+
+		for _, option := range question.Options {
+			text := strings.ToLower(strings.TrimSpace(option.Text))
+			if seen[text] {
+				return fmt.Errorf("%w: question %d repeats the option %q", openaierrors.ErrInvalidStructuredOutput, i, option.Text)
+			}
+
+			seen[text] = true
+
+			if option.Correct {
+				correct++
+			}
+		}
+
+		if correct != 1 {
+			return fmt.Errorf("%w: question %d has %d correct options", openaierrors.ErrInvalidStructuredOutput, i, correct)
+		}
+	}
+
+	return nil
+}
+
+// toDomain returns the question, whose options are in the order they were generated.
+func (p questionPayload) toDomain() domain.Question {
+	question := domain.Question{
+		Word:        p.Word,
+		Type:        p.Type,
+		Prompt:      p.Prompt,
+		Sentence:    p.Sentence,
+		Options:     make([]string, 0, len(p.Options)),
+		Explanation: p.Explanation,
+	}
+
+	for i, option := range p.Options {
+		question.Options = append(question.Options, option.Text)
+
+		if option.Correct {
+			question.Answer = i
+		}
+	}
+
+	return question
+}
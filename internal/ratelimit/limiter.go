@@ -0,0 +1,77 @@
+// Package ratelimit limits how often callers of the free tier may use the AI endpoints, with token buckets kept
+// in memory or in a store shared by every instance.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Limit is a token bucket holding up to Burst requests, refilled evenly so that it is full again after Period.
+type Limit struct {
+	Burst  int
+	Period time.Duration
+}
+
+// PerDay returns a Limit allowing n requests a day.
+func PerDay(n int) Limit {
+	return Limit{Burst: n, Period: 24 * time.Hour}
+}
+
+// Result is the state of a bucket after taking a request from it.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is how long to wait for the next request to be allowed. It is 0 when Remaining is not.
+	RetryAfter time.Duration
+	// ResetAfter is how long the bucket takes to be full again.
+	ResetAfter time.Duration
+}
+
+// Store keeps the token buckets.
+//
+//go:generate mockgen -source=limiter.go -destination=mock/limiter.go
+type Store interface {
+	// Take takes a request from the bucket of key, creating a full bucket when there is none.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+	// Refund gives back a request taken from the bucket of key, as when another bucket denied it.
+	Refund(ctx context.Context, key string, limit Limit) error
+}
+
+// refill returns the tokens a bucket holding tokens at updated holds at now.
+func (l Limit) refill(tokens float64, updated, now time.Time) float64 {
+	elapsed := now.Sub(updated)
+	if elapsed <= 0 {
+		return tokens
+	}
+
+	return math.Min(float64(l.Burst), tokens+float64(elapsed)/float64(l.interval()))
+}
+
+// interval is the time it takes to refill one token.
+func (l Limit) interval() time.Duration {
+	return l.Period / time.Duration(max(l.Burst, 1))
+}
+
+// take takes a token from a bucket holding tokens, and returns the tokens left and the result.
+func (l Limit) take(tokens float64) (float64, Result) {
+	allowed := tokens >= 1
+	if allowed {
+		tokens--
+	}
+
+	result := Result{
+		Allowed:    allowed,
+		Limit:      l.Burst,
+		Remaining:  int(tokens),
+		ResetAfter: time.Duration((float64(l.Burst) - tokens) * float64(l.interval())),
+	}
+
+	if result.Remaining == 0 {
+		result.RetryAfter = time.Duration((1 - tokens) * float64(l.interval()))
+	}
+
+	return tokens, result
+}
@@ -0,0 +1,96 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache/cachetest"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/ratelimit"
+)
+
+func TestStores(t *testing.T) {
+	testCases := []struct {
+		name     string
+		newStore func(t *testing.T) ratelimit.Store
+	}{
+		{
+			name: "memory",
+			newStore: func(t *testing.T) ratelimit.Store {
+				return ratelimit.NewMemoryStore()
+			},
+		},
+		{
+			name: "redis",
+			newStore: func(t *testing.T) ratelimit.Store {
+				_, server := cachetest.NewRedis(t)
+				return ratelimit.NewRedisStore(cachetest.NewRedisClient(t, server), "test:")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := tc.newStore(t)
+			limit := ratelimit.Limit{Burst: 2, Period: 400 * time.Millisecond}
+
+			result, err := store.Take(ctx, "device:a", limit)
+			require.NoError(t, err)
+			assert.True(t, result.Allowed)
+			assert.Equal(t, 2, result.Limit)
+			assert.Equal(t, 1, result.Remaining)
+			assert.Zero(t, result.RetryAfter)
+
+			result, err = store.Take(ctx, "device:a", limit)
+			require.NoError(t, err)
+			assert.True(t, result.Allowed)
+			assert.Equal(t, 0, result.Remaining)
+
+			result, err = store.Take(ctx, "device:a", limit)
+			require.NoError(t, err)
+			assert.False(t, result.Allowed)
+			assert.Positive(t, result.RetryAfter)
+			assert.LessOrEqual(t, result.RetryAfter, 200*time.Millisecond)
+			assert.LessOrEqual(t, result.ResetAfter, limit.Period)
+
+			// Other keys have their own bucket.
+			result, err = store.Take(ctx, "device:b", limit)
+			require.NoError(t, err)
+			assert.True(t, result.Allowed)
+
+			// A refund gives a token back, up to Burst.
+			require.NoError(t, store.Refund(ctx, "device:a", limit))
+
+			result, err = store.Take(ctx, "device:a", limit)
+			require.NoError(t, err)
+			assert.True(t, result.Allowed)
+			assert.Equal(t, 0, result.Remaining)
+
+			require.NoError(t, store.Refund(ctx, "device:b", limit))
+			require.NoError(t, store.Refund(ctx, "device:b", limit))
+
+			result, err = store.Take(ctx, "device:b", limit)
+			require.NoError(t, err)
+			assert.Equal(t, 1, result.Remaining)
+
+			// A missing bucket is full already.
+			require.NoError(t, store.Refund(ctx, "device:c", limit))
+
+			result, err = store.Take(ctx, "device:c", limit)
+			require.NoError(t, err)
+			assert.Equal(t, 1, result.Remaining)
+
+			// A token is back after Period / Burst.
+			time.Sleep(250 * time.Millisecond)
+
+			result, err = store.Take(ctx, "device:a", limit)
+			require.NoError(t, err)
+			assert.True(t, result.Allowed)
+			assert.Equal(t, 0, result.Remaining)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepEvery is how many takes the memory store waits between two sweeps of its full buckets.
+const sweepEvery = 1024
+
+type bucket struct {
+	limit   Limit
+	tokens  float64
+	updated time.Time
+}
+
+type memoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	takes   int
+}
+
+// NewMemoryStore returns a Store kept in process. Buckets are lost on restart and are not shared between instances.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		buckets: map[string]*bucket{},
+	}
+}
+
+func (s *memoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.takes++
+	if s.takes%sweepEvery == 0 {
+		s.sweep(now)
+	}
+
+	b, ok := s.buckets[key]
+	if !ok || b.limit != limit {
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), updated: now}
+		s.buckets[key] = b
+	}
+
+	var result Result
+
+	b.tokens, result = limit.take(limit.refill(b.tokens, b.updated, now))
+	b.updated = now
+
+	return result, nil
+}
+
+func (s *memoryStore) Refund(_ context.Context, key string, limit Limit) error {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// A missing bucket is full already.
+	b, ok := s.buckets[key]
+	if !ok || b.limit != limit {
+		return nil
+	}
+
+	b.tokens = min(float64(limit.Burst), limit.refill(b.tokens, b.updated, now)+1)
+	b.updated = now
+
+	return nil
+}
+
+// sweep forgets the buckets that are full again, since a missing bucket starts full.
+func (s *memoryStore) sweep(now time.Time) {
+	for key, b := range s.buckets {
+		if b.limit.refill(b.tokens, b.updated, now) >= float64(b.limit.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
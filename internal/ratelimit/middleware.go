@@ -0,0 +1,216 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+// DeviceIDHeader identifies the app install or browser of an anonymous caller.
+const DeviceIDHeader = "X-Device-Id"
+
+// Rate limit headers, sent with every limited response. They describe the most restrictive bucket the request
+// was taken from.
+const (
+	HeaderLimit     = "RateLimit-Limit"
+	HeaderRemaining = "RateLimit-Remaining"
+	HeaderReset     = "RateLimit-Reset"
+	HeaderPolicy    = "RateLimit-Policy"
+)
+
+// Tiers are the free tier allowances.
+type Tiers struct {
+	// Anonymous is the allowance of each anonymous device, or IP address when no device ID is sent.
+	// Signed-in users without a trial or an active subscription get it too.
+	Anonymous Limit
+	// Network caps every anonymous request coming from one IP address, whatever device it is from.
+	Network Limit
+	// Trial is the allowance of each trialing user.
+	Trial Limit
+}
+
+// RateLimitedResponse is the body of a 429 answered to a caller who has used up their allowance.
+type RateLimitedResponse struct {
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	RetryAfter int    `json:"retryAfterSeconds"`
+}
+
+type limiter struct {
+	logger              *zap.Logger
+	store               Store
+	subscriptionService subscriptions.SubscriptionService
+	tiers               Tiers
+	ipHeader            string
+}
+
+// bucketRef is a bucket a request is taken from.
+type bucketRef struct {
+	key   string
+	limit Limit
+}
+
+// FreeTier answers 429 to callers who have used up their allowance, taken from the buckets of store. Users with an
+// active subscription are not limited. It must run after OptionalAuthMiddlewareString so that signed-in users are
+// recognised. ipHeader is the header holding the client IP address set by the proxy in front of the API; when empty
+// the address of the connection is used. Requests are let through when the store fails.
+func FreeTier(
+	logger *zap.Logger,
+	store Store,
+	subscriptionService subscriptions.SubscriptionService,
+	tiers Tiers,
+	ipHeader string,
+) func(http.Handler) http.Handler {
+	l := &limiter{
+		logger:              logger,
+		store:               store,
+		subscriptionService: subscriptionService,
+		tiers:               tiers,
+		ipHeader:            ipHeader,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			l.serve(w, r, next)
+		})
+	}
+}
+
+func (l *limiter) serve(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	buckets := l.buckets(r)
+	if len(buckets) == 0 {
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	var (
+		tightest Result
+		policy   Limit
+	)
+
+	// A request denied by one bucket is not taken from the next ones, and is given back to the previous ones.
+	for i, b := range buckets {
+		result, err := l.store.Take(r.Context(), b.key, b.limit)
+		if err != nil {
+			l.logger.Error("failed to take from rate limit bucket", zap.String("key", b.key), zap.Error(err))
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		if i == 0 || !result.Allowed || result.Remaining < tightest.Remaining {
+			tightest, policy = result, b.limit
+		}
+
+		if !result.Allowed {
+			l.refund(r, buckets[:i])
+			break
+		}
+	}
+
+	setHeaders(w.Header(), tightest, policy)
+
+	if !tightest.Allowed {
+		retryAfter := seconds(tightest.RetryAfter)
+
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		render.Json(w, http.StatusTooManyRequests, RateLimitedResponse{
+			Code:       "rate_limited",
+			Message:    "You have used all of your free answers for now. Sign up or subscribe to keep going.",
+			RetryAfter: retryAfter,
+		})
+
+		return
+	}
+
+	next.ServeHTTP(w, r)
+}
+
+// refund gives the request back to buckets. A failed refund only costs the caller a request, so it is only logged.
+func (l *limiter) refund(r *http.Request, buckets []bucketRef) {
+	for _, b := range buckets {
+		err := l.store.Refund(r.Context(), b.key, b.limit)
+		if err != nil {
+			l.logger.Warn("failed to refund rate limit bucket", zap.String("key", b.key), zap.Error(err))
+		}
+	}
+}
+
+// buckets returns the buckets the request is taken from, none for subscribers.
+func (l *limiter) buckets(r *http.Request) []bucketRef {
+	userID, err := commonContext.GetUserIDString(r.Context())
+	if err != nil {
+		ip := l.clientIP(r)
+		client := "ip:" + ip
+
+		if device := strings.TrimSpace(r.Header.Get(DeviceIDHeader)); device != "" {
+			client = "device:" + device
+		}
+
+		return []bucketRef{
+			{key: client, limit: l.tiers.Anonymous},
+			{key: "network:" + ip, limit: l.tiers.Network},
+		}
+	}
+
+	var status string
+
+	sub, err := l.subscriptionService.GetUserSubscription(r.Context(), &userID)
+
+	switch {
+	case errors.Is(err, subscriptions.ErrSubscriptionNotFound):
+	case err != nil:
+		l.logger.Warn("failed to get subscription, applying the free tier", zap.String("userID", userID), zap.Error(err))
+	default:
+		status = sub.Status
+	}
+
+	switch status {
+	case "active":
+		return nil
+	case "trialing":
+		return []bucketRef{{key: "user:" + userID, limit: l.tiers.Trial}}
+	default:
+		return []bucketRef{{key: "user:" + userID, limit: l.tiers.Anonymous}}
+	}
+}
+
+func (l *limiter) clientIP(r *http.Request) string {
+	if l.ipHeader != "" {
+		// Proxies append the address they saw to X-Forwarded-For style headers, so the last address is the only
+		// one the client could not make up.
+		addresses := strings.Split(r.Header.Get(l.ipHeader), ",")
+		if ip := net.ParseIP(strings.TrimSpace(addresses[len(addresses)-1])); ip != nil {
+			return ip.String()
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+func setHeaders(header http.Header, result Result, limit Limit) {
+	header.Set(HeaderLimit, strconv.Itoa(result.Limit))
+	header.Set(HeaderRemaining, strconv.Itoa(result.Remaining))
+	header.Set(HeaderReset, strconv.Itoa(seconds(result.ResetAfter)))
+	header.Set(HeaderPolicy, fmt.Sprintf("%d;w=%d", limit.Burst, seconds(limit.Period)))
+}
+
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
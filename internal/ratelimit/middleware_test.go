@@ -0,0 +1,133 @@
+package ratelimit_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/ratelimit"
+	mockratelimit "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/ratelimit/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions"
+	mocksubscriptions "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions/mock"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+)
+
+var tiers = ratelimit.Tiers{
+	Anonymous: ratelimit.PerDay(2),
+	Network:   ratelimit.PerDay(3),
+	Trial:     ratelimit.PerDay(5),
+}
+
+func newHandler(t *testing.T, store ratelimit.Store, subscriptionService subscriptions.SubscriptionService) http.Handler {
+	return ratelimit.FreeTier(zaptest.NewLogger(t), store, subscriptionService, tiers, "X-Forwarded-For")(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+}
+
+func anonymousRequest(ip, device string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v4/word/lookup", nil)
+	req.Header.Set("X-Forwarded-For", "203.0.113.7, "+ip)
+
+	if device != "" {
+		req.Header.Set(ratelimit.DeviceIDHeader, device)
+	}
+
+	return req
+}
+
+func TestFreeTierLimitsAnonymousCallers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := newHandler(t, ratelimit.NewMemoryStore(), mocksubscriptions.NewMockSubscriptionService(ctrl))
+
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := serve(anonymousRequest("198.51.100.1", "phone"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get(ratelimit.HeaderLimit))
+	assert.Equal(t, "1", rec.Header().Get(ratelimit.HeaderRemaining))
+	assert.Equal(t, "43200", rec.Header().Get(ratelimit.HeaderReset))
+	assert.Equal(t, "2;w=86400", rec.Header().Get(ratelimit.HeaderPolicy))
+
+	assert.Equal(t, http.StatusOK, serve(anonymousRequest("198.51.100.1", "phone")).Code)
+
+	rec = serve(anonymousRequest("198.51.100.1", "phone"))
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "0", rec.Header().Get(ratelimit.HeaderRemaining))
+	assert.Equal(t, "43200", rec.Header().Get("Retry-After"))
+	assert.Contains(t, rec.Body.String(), `"code":"rate_limited"`)
+
+	// Another device on the same network has its own allowance, until the network one runs out.
+	rec = serve(anonymousRequest("198.51.100.1", "laptop"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "3", rec.Header().Get(ratelimit.HeaderLimit), "the network bucket is the tightest")
+	assert.Equal(t, "0", rec.Header().Get(ratelimit.HeaderRemaining))
+
+	assert.Equal(t, http.StatusTooManyRequests, serve(anonymousRequest("198.51.100.1", "tablet")).Code)
+
+	// The request the network bucket denied is not taken from the allowance of the device.
+	assert.Equal(t, http.StatusOK, serve(anonymousRequest("198.51.100.3", "tablet")).Code)
+	assert.Equal(t, http.StatusOK, serve(anonymousRequest("198.51.100.3", "tablet")).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(anonymousRequest("198.51.100.3", "tablet")).Code)
+
+	// Callers without a device ID are limited by IP address.
+	assert.Equal(t, http.StatusOK, serve(anonymousRequest("198.51.100.2", "")).Code)
+	assert.Equal(t, http.StatusOK, serve(anonymousRequest("198.51.100.2", "")).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(anonymousRequest("198.51.100.2", "")).Code)
+}
+
+func TestFreeTierBySubscription(t *testing.T) {
+	testCases := []struct {
+		name          string
+		subscription  *entity.Subscription
+		err           error
+		expectedLimit string
+	}{
+		{name: "trialing", subscription: &entity.Subscription{Status: "trialing"}, expectedLimit: "5"},
+		{name: "active", subscription: &entity.Subscription{Status: "active"}, expectedLimit: ""},
+		{name: "canceled", subscription: &entity.Subscription{Status: "canceled"}, expectedLimit: "2"},
+		{name: "never subscribed", err: subscriptions.ErrSubscriptionNotFound, expectedLimit: "2"},
+		{name: "subscription lookup fails", err: errors.New("connection refused"), expectedLimit: "2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			subscriptionService := mocksubscriptions.NewMockSubscriptionService(ctrl)
+			subscriptionService.EXPECT().GetUserSubscription(gomock.Any(), gomock.Any()).Return(tc.subscription, tc.err)
+
+			req := anonymousRequest("198.51.100.1", "phone")
+			req = req.WithContext(commonContext.SetUserIDString(req.Context(), "user-1"))
+			rec := httptest.NewRecorder()
+
+			newHandler(t, ratelimit.NewMemoryStore(), subscriptionService).ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tc.expectedLimit, rec.Header().Get(ratelimit.HeaderLimit))
+		})
+	}
+}
+
+func TestFreeTierLetsRequestsThroughWhenTheStoreFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockratelimit.NewMockStore(ctrl)
+	store.EXPECT().Take(gomock.Any(), "device:phone", tiers.Anonymous).Return(ratelimit.Result{}, errors.New("connection refused"))
+
+	rec := httptest.NewRecorder()
+	newHandler(t, store, mocksubscriptions.NewMockSubscriptionService(ctrl)).ServeHTTP(rec, anonymousRequest("198.51.100.1", "phone"))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(ratelimit.HeaderLimit))
+}
@@ -0,0 +1,42 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/config"
+)
+
+// Supported values for the RATE_LIMIT_BACKEND setting.
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
+const keyPrefix = "aibou:ratelimit:"
+
+// NewProvider builds the Store selected by cfg.RateLimitBackend.
+func NewProvider(cfg *config.Config) (Store, error) {
+	switch cfg.RateLimitBackend {
+	case BackendMemory, "":
+		return NewMemoryStore(), nil
+	case BackendRedis:
+		client, err := cache.NewRedisClient(cfg.RedisURL)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewRedisStore(client, keyPrefix), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend %q", cfg.RateLimitBackend)
+	}
+}
+
+// NewTiers returns the free tier allowances configured in cfg.
+func NewTiers(cfg *config.Config) Tiers {
+	return Tiers{
+		Anonymous: PerDay(cfg.FreeTierDailyRequests),
+		Network:   PerDay(cfg.FreeTierNetworkDailyRequests),
+		Trial:     PerDay(cfg.TrialDailyRequests),
+	}
+}
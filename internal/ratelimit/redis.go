@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeScript refills and takes from a bucket atomically. The bucket expires once it would be full again.
+//
+// KEYS[1] is the bucket, ARGV is the burst, the period in ms and the current time in ms.
+// It returns the tokens left before taking.
+var takeScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+local tokens = tonumber(state[1])
+local updated = tonumber(state[2])
+
+if tokens == nil or updated == nil then
+	tokens = burst
+	updated = now
+end
+
+if now > updated then
+	tokens = math.min(burst, tokens + (now - updated) * burst / period)
+end
+
+local left = tokens
+if left >= 1 then
+	left = left - 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(left), 'updated', tostring(now))
+redis.call('PEXPIRE', KEYS[1], period)
+
+return tostring(tokens)
+`)
+
+// refundScript refills a bucket and gives a token back to it. A missing bucket is full already.
+//
+// KEYS[1] is the bucket, ARGV is the burst, the period in ms and the current time in ms.
+var refundScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+local tokens = tonumber(state[1])
+local updated = tonumber(state[2])
+
+if tokens == nil or updated == nil then
+	return 0
+end
+
+if now > updated then
+	tokens = tokens + (now - updated) * burst / period
+end
+
+tokens = math.min(burst, tokens + 1)
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated', tostring(now))
+redis.call('PEXPIRE', KEYS[1], period)
+
+return 1
+`)
+
+type redisStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisStore returns a Store on any server speaking the Redis protocol and supporting Lua scripts, shared by
+// every instance of the API. Keys are namespaced with prefix.
+func NewRedisStore(client redis.UniversalClient, prefix string) Store {
+	return &redisStore{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (s *redisStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	raw, err := takeScript.Run(
+		ctx,
+		s.client,
+		[]string{s.prefix + key},
+		limit.Burst,
+		limit.Period.Milliseconds(),
+		time.Now().UnixMilli(),
+	).Text()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to take from redis bucket: %w", err)
+	}
+
+	tokens, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("invalid redis bucket %q: %w", raw, err)
+	}
+
+	_, result := limit.take(tokens)
+
+	return result, nil
+}
+
+func (s *redisStore) Refund(ctx context.Context, key string, limit Limit) error {
+	err := refundScript.Run(
+		ctx,
+		s.client,
+		[]string{s.prefix + key},
+		limit.Burst,
+		limit.Period.Milliseconds(),
+		time.Now().UnixMilli(),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("failed to refund redis bucket: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package domain
+
+import (
+	decksdomain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/srs"
+)
+
+// Item is a saved word with its review schedule.
+type Item struct {
+	Entry    decksdomain.Entry
+	DeckName string
+	Card     srs.Card
+}
@@ -0,0 +1,184 @@
+// Package reviews schedules daily reviews of the words users saved in their decks, and logs every review.
+package reviews
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/decks"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/srs"
+)
+
+// Limits of Due.
+const (
+	DefaultDueLimit = 20
+	MaxDueLimit     = 100
+)
+
+var ErrEntryNotFound = errors.New("deck entry not found")
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+type Service interface {
+	// Due returns the words the user should review now, the most overdue first and then those never reviewed.
+	// deckID restricts them to one deck when not empty. Limits outside 1 to MaxDueLimit are DefaultDueLimit or
+	// MaxDueLimit.
+	Due(ctx context.Context, userID, deckID string, limit int) ([]domain.Item, error)
+	// Grade records how well the user remembered the entry and schedules its next review. It returns
+	// ErrEntryNotFound when the user has no entry with that ID.
+	Grade(ctx context.Context, userID, entryID string, rating srs.Rating) (*domain.Item, error)
+}
+
+type service struct {
+	logger     *zap.Logger
+	repository storage.ReviewRepository
+	scheduler  srs.Scheduler
+	now        func() time.Time
+}
+
+// NewReviewService returns the review Service. Reviews are scheduled by scheduler at the times now returns, which
+// is time.Now outside tests.
+func NewReviewService(
+	logger *zap.Logger,
+	repository storage.ReviewRepository,
+	scheduler srs.Scheduler,
+	now func() time.Time,
+) Service {
+	return &service{
+		logger:     logger,
+		repository: repository,
+		scheduler:  scheduler,
+		now:        now,
+	}
+}
+
+func (s *service) Due(ctx context.Context, userID, deckID string, limit int) ([]domain.Item, error) {
+	if deckID != "" {
+		if _, err := uuid.Parse(deckID); err != nil {
+			// No such deck, so nothing in it is due.
+			return []domain.Item{}, nil
+		}
+	}
+
+	switch {
+	case limit < 1:
+		limit = DefaultDueLimit
+	case limit > MaxDueLimit:
+		limit = MaxDueLimit
+	}
+
+	entries, err := s.repository.ListDue(ctx, userID, deckID, s.now().UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.Item, 0, len(entries))
+
+	for _, entry := range entries {
+		item, err := toItem(entry, toCard(entry.R.GetReviewCard()))
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, *item)
+	}
+
+	return items, nil
+}
+
+func (s *service) Grade(ctx context.Context, userID, entryID string, rating srs.Rating) (*domain.Item, error) {
+	if !rating.Valid() {
+		return nil, srs.ErrInvalidRating
+	}
+
+	if _, err := uuid.Parse(entryID); err != nil {
+		return nil, ErrEntryNotFound
+	}
+
+	entry, err := s.repository.GetEntry(ctx, userID, entryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrEntryNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deck entry %s: %w", entryID, err)
+	}
+
+	now := s.now().UTC()
+	before := toCard(entry.R.GetReviewCard())
+	after := s.scheduler.Review(before, rating, now)
+
+	card := &entity.ReviewCard{
+		DeckEntryID:    entry.ID,
+		Scheduler:      s.scheduler.Name(),
+		Repetitions:    after.Repetitions,
+		Lapses:         after.Lapses,
+		EaseFactor:     after.EaseFactor,
+		Stability:      after.Stability,
+		Difficulty:     after.Difficulty,
+		IntervalDays:   after.IntervalDays,
+		DueAt:          after.Due,
+		LastReviewedAt: after.LastReviewed,
+	}
+
+	log := &entity.ReviewLog{
+		DeckEntryID:  entry.ID,
+		UserID:       userID,
+		Scheduler:    s.scheduler.Name(),
+		Rating:       int16(rating),
+		ElapsedDays:  srs.ElapsedDays(before, now),
+		IntervalDays: after.IntervalDays,
+		DueAt:        after.Due,
+		ReviewedAt:   now,
+	}
+
+	if err := s.repository.SaveReview(ctx, card, log); err != nil {
+		return nil, err
+	}
+
+	return toItem(entry, after)
+}
+
+// toCard returns the zero srs.Card, never reviewed, when card is nil.
+func toCard(card *entity.ReviewCard) srs.Card {
+	if card == nil {
+		return srs.Card{}
+	}
+
+	return srs.Card{
+		Repetitions:  card.Repetitions,
+		Lapses:       card.Lapses,
+		EaseFactor:   card.EaseFactor,
+		Stability:    card.Stability,
+		Difficulty:   card.Difficulty,
+		IntervalDays: card.IntervalDays,
+		Due:          card.DueAt,
+		LastReviewed: card.LastReviewedAt,
+	}
+}
+
+func toItem(entry *entity.DeckEntry, card srs.Card) (*domain.Item, error) {
+	domainEntry, err := decks.ToDomainEntry(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	item := &domain.Item{
+		Entry: *domainEntry,
+		Card:  card,
+	}
+
+	if deck := entry.R.GetDeck(); deck != nil {
+		item.DeckName = deck.Name
+	}
+
+	return item, nil
+}
@@ -0,0 +1,127 @@
+package reviews_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews"
+	mockstorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/reviews/storage/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/srs"
+)
+
+const (
+	userID  = "user-1"
+	entryID = "0b6f0a7e-8c1d-4f3e-9a2b-1c2d3e4f5a6b"
+)
+
+var now = time.Date(2025, 10, 17, 8, 30, 0, 0, time.UTC)
+
+func newService(t *testing.T) (reviews.Service, *mockstorage.MockReviewRepository) {
+	repository := mockstorage.NewMockReviewRepository(gomock.NewController(t))
+	clock := func() time.Time { return now }
+
+	return reviews.NewReviewService(zaptest.NewLogger(t), repository, srs.NewSM2(), clock), repository
+}
+
+func newEntry(card *entity.ReviewCard) *entity.DeckEntry {
+	entry := &entity.DeckEntry{ID: entryID, Word: "gato", Details: "{}"}
+	entry.R = entry.R.NewStruct()
+	entry.R.Deck = &entity.Deck{Name: "Animals"}
+	entry.R.ReviewCard = card
+
+	return entry
+}
+
+func TestGradeSchedulesTheNextReview(t *testing.T) {
+	t.Run("first review", func(t *testing.T) {
+		service, repository := newService(t)
+
+		repository.EXPECT().GetEntry(gomock.Any(), userID, entryID).Return(newEntry(nil), nil)
+		repository.EXPECT().SaveReview(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, card *entity.ReviewCard, log *entity.ReviewLog) error {
+				assert.Equal(t, srs.SchedulerSM2, card.Scheduler)
+				assert.Equal(t, now.AddDate(0, 0, 1), card.DueAt)
+				assert.Equal(t, now, card.LastReviewedAt)
+
+				assert.Equal(t, userID, log.UserID)
+				assert.Equal(t, int16(srs.Good), log.Rating)
+				assert.Equal(t, 0, log.ElapsedDays)
+				assert.Equal(t, now, log.ReviewedAt)
+
+				return nil
+			})
+
+		item, err := service.Grade(context.Background(), userID, entryID, srs.Good)
+		require.NoError(t, err)
+
+		assert.Equal(t, "gato", item.Entry.Word)
+		assert.Equal(t, "Animals", item.DeckName)
+		assert.Equal(t, 1, item.Card.Repetitions)
+	})
+
+	t.Run("overdue review", func(t *testing.T) {
+		service, repository := newService(t)
+
+		card := &entity.ReviewCard{
+			DeckEntryID:    entryID,
+			Scheduler:      srs.SchedulerSM2,
+			Repetitions:    2,
+			EaseFactor:     srs.DefaultEaseFactor,
+			IntervalDays:   6,
+			DueAt:          now.AddDate(0, 0, -2),
+			LastReviewedAt: now.AddDate(0, 0, -8),
+		}
+
+		repository.EXPECT().GetEntry(gomock.Any(), userID, entryID).Return(newEntry(card), nil)
+		repository.EXPECT().SaveReview(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, card *entity.ReviewCard, log *entity.ReviewLog) error {
+				assert.Equal(t, 15, card.IntervalDays)
+				assert.Equal(t, now.AddDate(0, 0, 15), card.DueAt)
+				assert.Equal(t, 8, log.ElapsedDays)
+
+				return nil
+			})
+
+		_, err := service.Grade(context.Background(), userID, entryID, srs.Good)
+		require.NoError(t, err)
+	})
+}
+
+func TestGradeRefusals(t *testing.T) {
+	service, repository := newService(t)
+
+	_, err := service.Grade(context.Background(), userID, entryID, srs.Rating(7))
+	assert.ErrorIs(t, err, srs.ErrInvalidRating)
+
+	_, err = service.Grade(context.Background(), userID, "not-an-id", srs.Good)
+	assert.ErrorIs(t, err, reviews.ErrEntryNotFound)
+
+	repository.EXPECT().GetEntry(gomock.Any(), userID, entryID).Return(nil, sql.ErrNoRows)
+
+	_, err = service.Grade(context.Background(), userID, entryID, srs.Good)
+	assert.ErrorIs(t, err, reviews.ErrEntryNotFound)
+}
+
+func TestDue(t *testing.T) {
+	service, repository := newService(t)
+
+	repository.EXPECT().ListDue(gomock.Any(), userID, "", now, reviews.MaxDueLimit).
+		Return(entity.DeckEntrySlice{newEntry(nil)}, nil)
+
+	items, err := service.Due(context.Background(), userID, "", 1000)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.True(t, items[0].Card.New())
+
+	items, err = service.Due(context.Background(), userID, "not-a-deck", 0)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+//go:generate mockgen -source=repository.go -destination=mock/repository.go
+type ReviewRepository interface {
+	// ListDue returns up to limit entries of the user due at now, with their deck and card loaded: the reviewed ones
+	// by due date, then those never reviewed in the order they were saved. deckID restricts them to one deck when not
+	// empty.
+	ListDue(ctx context.Context, userID, deckID string, now time.Time, limit int) (entity.DeckEntrySlice, error)
+	// GetEntry returns the entry with its deck and card loaded, or sql.ErrNoRows when the user has no entry with
+	// that ID.
+	GetEntry(ctx context.Context, userID, entryID string) (*entity.DeckEntry, error)
+	// SaveReview inserts or updates the card and inserts its log in a single transaction.
+	SaveReview(ctx context.Context, card *entity.ReviewCard, log *entity.ReviewLog) error
+}
+
+type reviewRepository struct {
+	db *sqlx.DB
+}
+
+func NewReviewRepository(db *sqlx.DB) ReviewRepository {
+	return &reviewRepository{
+		db: db,
+	}
+}
+
+func (r *reviewRepository) ListDue(
+	ctx context.Context,
+	userID, deckID string,
+	now time.Time,
+	limit int,
+) (entity.DeckEntrySlice, error) {
+	mods := []qm.QueryMod{
+		qm.Select(entity.TableNames.DeckEntries + ".*"),
+		qm.InnerJoin("decks ON decks.id = deck_entries.deck_id"),
+		qm.LeftOuterJoin("review_cards ON review_cards.deck_entry_id = deck_entries.id"),
+		qm.Where("decks.user_id = ?", userID),
+		qm.Where("(review_cards.due_at IS NULL OR review_cards.due_at <= ?)", now),
+		qm.OrderBy("review_cards.due_at NULLS LAST, deck_entries.created_at, deck_entries.id"),
+		qm.Limit(limit),
+		qm.Load(entity.DeckEntryRels.Deck),
+		qm.Load(entity.DeckEntryRels.ReviewCard),
+	}
+
+	if deckID != "" {
+		mods = append(mods, entity.DeckEntryWhere.DeckID.EQ(deckID))
+	}
+
+	entries, err := entity.DeckEntries(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due reviews of user %s: %w", userID, err)
+	}
+
+	return entries, nil
+}
+
+func (r *reviewRepository) GetEntry(ctx context.Context, userID, entryID string) (*entity.DeckEntry, error) {
+	return entity.DeckEntries(
+		qm.Select(entity.TableNames.DeckEntries+".*"),
+		qm.InnerJoin("decks ON decks.id = deck_entries.deck_id"),
+		qm.Where("deck_entries.id = ? AND decks.user_id = ?", entryID, userID),
+		qm.Load(entity.DeckEntryRels.Deck),
+		qm.Load(entity.DeckEntryRels.ReviewCard),
+	).One(ctx, r.db)
+}
+
+func (r *reviewRepository) SaveReview(ctx context.Context, card *entity.ReviewCard, log *entity.ReviewLog) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin review of entry %s: %w", card.DeckEntryID, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	err = card.Upsert(
+		ctx,
+		tx,
+		true,
+		[]string{entity.ReviewCardColumns.DeckEntryID},
+		boil.Blacklist(entity.ReviewCardColumns.CreatedAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save review card of entry %s: %w", card.DeckEntryID, err)
+	}
+
+	if err := log.Insert(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to log review of entry %s: %w", card.DeckEntryID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to save review of entry %s: %w", card.DeckEntryID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+package domain
+
+// Simplification is a sentence rewritten for learners of a CEFR level.
+type Simplification struct {
+	Level string
+	// Simplified is in the language of the original sentence.
+	Simplified string
+	// Explanation says what was simplified, in the user's native language.
+	Explanation string
+}
@@ -14,6 +14,9 @@ import (
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
+
+	difficulty "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	domain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence/domain"
 )
 
 // MockService is a mock of Service interface.
@@ -54,6 +57,21 @@ func (mr *MockServiceMockRecorder) GetSentenceCorrection(ctx, sentence, nativeLa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentenceCorrection", reflect.TypeOf((*MockService)(nil).GetSentenceCorrection), ctx, sentence, nativeLanguage)
 }
 
+// GetSentenceDifficulty mocks base method.
+func (m *MockService) GetSentenceDifficulty(ctx context.Context, sentence, nativeLanguage string) (*difficulty.Assessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSentenceDifficulty", ctx, sentence, nativeLanguage)
+	ret0, _ := ret[0].(*difficulty.Assessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSentenceDifficulty indicates an expected call of GetSentenceDifficulty.
+func (mr *MockServiceMockRecorder) GetSentenceDifficulty(ctx, sentence, nativeLanguage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentenceDifficulty", reflect.TypeOf((*MockService)(nil).GetSentenceDifficulty), ctx, sentence, nativeLanguage)
+}
+
 // GetSentenceExplanation mocks base method.
 func (m *MockService) GetSentenceExplanation(ctx context.Context, sentence, nativeLanguage string, isDetailed bool) (*string, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +87,50 @@ func (mr *MockServiceMockRecorder) GetSentenceExplanation(ctx, sentence, nativeL
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentenceExplanation", reflect.TypeOf((*MockService)(nil).GetSentenceExplanation), ctx, sentence, nativeLanguage, isDetailed)
 }
 
+// GetSentenceSimplification mocks base method.
+func (m *MockService) GetSentenceSimplification(ctx context.Context, sentence, nativeLanguage, level string) (*domain.Simplification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSentenceSimplification", ctx, sentence, nativeLanguage, level)
+	ret0, _ := ret[0].(*domain.Simplification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSentenceSimplification indicates an expected call of GetSentenceSimplification.
+func (mr *MockServiceMockRecorder) GetSentenceSimplification(ctx, sentence, nativeLanguage, level any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentenceSimplification", reflect.TypeOf((*MockService)(nil).GetSentenceSimplification), ctx, sentence, nativeLanguage, level)
+}
+
+// StreamSentenceExplanation mocks base method.
+func (m *MockService) StreamSentenceExplanation(ctx context.Context, sentence, nativeLanguage string, isDetailed bool, onDelta func(string) error) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamSentenceExplanation", ctx, sentence, nativeLanguage, isDetailed, onDelta)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamSentenceExplanation indicates an expected call of StreamSentenceExplanation.
+func (mr *MockServiceMockRecorder) StreamSentenceExplanation(ctx, sentence, nativeLanguage, isDetailed, onDelta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamSentenceExplanation", reflect.TypeOf((*MockService)(nil).StreamSentenceExplanation), ctx, sentence, nativeLanguage, isDetailed, onDelta)
+}
+
+// ValidateLevel mocks base method.
+func (m *MockService) ValidateLevel(level string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateLevel", level)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateLevel indicates an expected call of ValidateLevel.
+func (mr *MockServiceMockRecorder) ValidateLevel(level any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateLevel", reflect.TypeOf((*MockService)(nil).ValidateLevel), level)
+}
+
 // ValidateSentence mocks base method.
 func (m *MockService) ValidateSentence(sentence string) error {
 	m.ctrl.T.Helper()
@@ -1,242 +1,406 @@
 package sentence
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"io"
-	"net/http"
-	"time"
+	"slices"
+	"strings"
 	"unicode/utf8"
 
-	"github.com/coocood/freecache"
 	"go.uber.org/zap"
 
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/canonical"
 	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
-	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/request"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/validation"
 )
 
-var ErrOpenAiNoChoices = errors.New("OpenAI API response contains no choices")
+// Levels are the CEFR levels a sentence can be simplified to, from easiest to hardest.
+var Levels = difficulty.CEFRLevels
+
+// Errors for sentences and levels the service refuses.
+var (
+	ErrEmptySentence   = validation.New(validation.CodeEmptySentence, "Please provide a sentence.")
+	ErrSentenceTooLong = validation.New(validation.CodeSentenceTooLong, "The sentence must be less than 120 characters.")
+	ErrInvalidLevel    = validation.New(validation.CodeInvalidLevel, "Please choose a level between A1 and C2.")
+)
 
 //go:generate mockgen -source=service.go -destination=mock/service.go
 type Service interface {
 	GetSentenceExplanation(ctx context.Context, sentence string, nativeLanguage string, isDetailed bool) (*string, error)
 	GetSentenceCorrection(ctx context.Context, sentence string, nativeLanguage string) (*string, error)
+	// GetSentenceSimplification rewrites sentence, in its own language, for learners of the CEFR level,
+	// and explains what was simplified in nativeLanguage. level is one of Levels, in any case.
+	GetSentenceSimplification(ctx context.Context, sentence, nativeLanguage, level string) (*domain.Simplification, error)
+	// GetSentenceDifficulty assesses how hard sentence is for learners, with a rationale in nativeLanguage.
+	GetSentenceDifficulty(ctx context.Context, sentence, nativeLanguage string) (*difficulty.Assessment, error)
 	ValidateSentence(sentence string) error
+	// ValidateLevel returns ErrInvalidLevel unless level is one of Levels, in any case.
+	ValidateLevel(level string) error
+	// StreamSentenceExplanation behaves like GetSentenceExplanation but calls onDelta with the explanation as it is generated.
+	StreamSentenceExplanation(
+		ctx context.Context,
+		sentence string,
+		nativeLanguage string,
+		isDetailed bool,
+		onDelta func(delta string) error,
+	) (*string, error)
 }
 
 type service struct {
 	logger       *zap.Logger
 	openAiClient openai.Client
-	cache        *freecache.Cache
+	store        content.Store
+	prompts      prompt.Registry
 }
 
 func NewSentenceService(
 	logger *zap.Logger,
 	openAiClient openai.Client,
-	cache *freecache.Cache,
+	store content.Store,
+	prompts prompt.Registry,
 ) Service {
 	return &service{
 		logger:       logger,
 		openAiClient: openAiClient,
-		cache:        cache,
+		store:        store,
+		prompts:      prompts,
 	}
 }
 
-var sentenceCacheExpiration = int(time.Hour * 24 * 30) // 30 days
-
 func (s *service) GetSentenceCorrection(ctx context.Context, sentence string, nativeLanguage string) (*string, error) {
-	cacheKey := []byte(fmt.Sprintf("%s sentence correction in %s", sentence, nativeLanguage))
-
-	cached, err := s.cache.Get(cacheKey)
-	if err == nil {
-		cachedResponse := string(cached)
-		return &cachedResponse, nil
-	}
-
-	jsonBody, err := s.sentenceToOpenAiSentenceCorrectionRequestBody(sentence, nativeLanguage)
+	p, err := s.sentencePrompt(ctx, prompt.SentenceCorrection, sentence, nativeLanguage)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal openai request: %w", err)
+		return nil, err
 	}
 
-	resp, responseBody, err := s.openAiClient.MakeRequest(ctx, jsonBody)
+	key := contentKey("sentence_correction", sentence, nativeLanguage, p)
+
+	var usage openai.Usage
+
+	result, err := s.store.GetOrGenerate(ctx, key, func(ctx context.Context) (string, error) {
+		completion, err := s.openAiClient.CreateChatCompletion(ctx, p.Request())
+		if err != nil {
+			return "", fmt.Errorf("failed to make open ai request: %w", err)
+		}
+
+		s.logger.Info("Successfully got sentence correction",
+			zap.String("sentence", sentence),
+			zap.String("nativeLanguage", nativeLanguage),
+			zap.String("prompt", p.ID),
+			zap.String("promptVersion", p.Version),
+			zap.Int("promptTokens", completion.Usage.PromptTokens),
+			zap.Int("completionTokens", completion.Usage.CompletionTokens),
+			zap.Int("totalTokens", completion.Usage.TotalTokens),
+		)
+
+		usage = completion.Usage
+
+		return completion.Content(), nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to make open ai request: %w", err)
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("openAI API returned non-OK status(%v): %s", resp.StatusCode, responseBody)
+	experiment.Observe(ctx, p, usage)
+
+	return result, nil
+}
+
+func (s *service) GetSentenceSimplification(ctx context.Context, sentence, nativeLanguage, level string) (*domain.Simplification, error) {
+	if err := s.ValidateLevel(level); err != nil {
+		return nil, err
 	}
 
-	var OpenAIApiResponse openai.ChatCompletion
+	level = strings.ToUpper(strings.TrimSpace(level))
 
-	err = json.Unmarshal(responseBody, &OpenAIApiResponse)
+	p, err := s.prompts.Render(ctx, prompt.SentenceSimplification, nativeLanguage, prompt.Vars{
+		"Sentence": sentence,
+		"Language": languages.Normalize(nativeLanguage),
+		"Level":    level,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarhsal json body: %w", err)
+		return nil, fmt.Errorf("failed to render prompt: %w", err)
 	}
 
-	if len(OpenAIApiResponse.Choices) == 0 {
-		return nil, ErrOpenAiNoChoices
-	}
+	key := contentKey("sentence_simplification_"+strings.ToLower(level), sentence, nativeLanguage, p)
 
-	s.logger.Info("Successfully got sentence correction",
-		zap.String("sentence", sentence),
-		zap.String("nativeLanguage", nativeLanguage),
-		zap.Int("promptTokens", OpenAIApiResponse.Usage.PromptTokens),
-		zap.Int("completionTokens", OpenAIApiResponse.Usage.CompletionTokens),
-		zap.Int("totalTokens", OpenAIApiResponse.Usage.TotalTokens),
-	)
+	var usage openai.Usage
 
-	result := &OpenAIApiResponse.Choices[0].Message.Content
+	result, err := s.store.GetOrGenerate(ctx, key, func(ctx context.Context) (string, error) {
+		request := p.Request()
+		request.ResponseFormat = openai.NewJSONSchemaFormat("sentence_simplification", simplificationJSONSchema)
+
+		completion, err := s.openAiClient.CreateChatCompletion(ctx, request)
+		if err != nil {
+			return "", fmt.Errorf("failed to make open ai request: %w", err)
+		}
+
+		s.logger.Info("Successfully got sentence simplification",
+			zap.String("sentence", sentence),
+			zap.String("nativeLanguage", nativeLanguage),
+			zap.String("level", level),
+			zap.String("prompt", p.ID),
+			zap.String("promptVersion", p.Version),
+			zap.Int("promptTokens", completion.Usage.PromptTokens),
+			zap.Int("completionTokens", completion.Usage.CompletionTokens),
+			zap.Int("totalTokens", completion.Usage.TotalTokens),
+		)
 
-	cacheValue := []byte(*result)
+		var payload simplificationPayload
 
-	err = s.cache.Set(cacheKey, cacheValue, sentenceCacheExpiration)
+		err = openai.ParseStructured(completion.Content(), &payload)
+		if err != nil {
+			return "", err
+		}
+
+		usage = completion.Usage
+
+		// Store the validated payload rather than the raw completion, which may be wrapped in a code block.
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal sentence simplification: %w", err)
+		}
+
+		return string(raw), nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to cache sentence correction: %w", err)
+		return nil, err
 	}
 
-	return result, nil
-}
+	var payload simplificationPayload
 
-func (s *service) GetSentenceExplanation(ctx context.Context, sentence string, nativeLanguage string, isDetailed bool) (*string, error) {
-	cacheKey := []byte(fmt.Sprintf("%s sentence explanation in %s(%v)", sentence, nativeLanguage, isDetailed))
+	err = openai.ParseStructured(*result, &payload)
+	if err != nil {
+		return nil, err
+	}
 
-	cached, err := s.cache.Get(cacheKey)
-	if err == nil {
-		cachedResponse := string(cached)
-		return &cachedResponse, err
+	experiment.Observe(ctx, p, usage)
+
+	return &domain.Simplification{
+		Level:       level,
+		Simplified:  payload.Simplified,
+		Explanation: payload.Explanation,
+	}, nil
+}
+
+func (s *service) GetSentenceDifficulty(ctx context.Context, sentence, nativeLanguage string) (*difficulty.Assessment, error) {
+	p, err := s.sentencePrompt(ctx, prompt.SentenceDifficulty, sentence, nativeLanguage)
+	if err != nil {
+		return nil, err
 	}
 
-	var jsonBody io.Reader
-	if isDetailed {
-		jsonBody, err = s.sentenceToOpenAiExplanationRequestBody(sentence, nativeLanguage)
+	key := contentKey("sentence_difficulty", sentence, nativeLanguage, p)
+
+	var usage openai.Usage
+
+	result, err := s.store.GetOrGenerate(ctx, key, func(ctx context.Context) (string, error) {
+		request := p.Request()
+		request.ResponseFormat = openai.NewJSONSchemaFormat("sentence_difficulty", difficulty.JSONSchema)
+
+		completion, err := s.openAiClient.CreateChatCompletion(ctx, request)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal openai request: %w", err)
+			return "", fmt.Errorf("failed to make open ai request: %w", err)
 		}
-	} else {
-		jsonBody, err = s.sentenceToOpenAiSimpleTranslationRequestBody(sentence, nativeLanguage)
+
+		s.logger.Info("Successfully got sentence difficulty",
+			zap.String("sentence", sentence),
+			zap.String("nativeLanguage", nativeLanguage),
+			zap.String("prompt", p.ID),
+			zap.String("promptVersion", p.Version),
+			zap.Int("promptTokens", completion.Usage.PromptTokens),
+			zap.Int("completionTokens", completion.Usage.CompletionTokens),
+			zap.Int("totalTokens", completion.Usage.TotalTokens),
+		)
+
+		var payload difficulty.Payload
+
+		err = openai.ParseStructured(completion.Content(), &payload)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal openai request: %w", err)
+			return "", err
 		}
+
+		usage = completion.Usage
+
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal sentence difficulty: %w", err)
+		}
+
+		return string(raw), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	resp, responseBody, err := s.openAiClient.MakeRequest(ctx, jsonBody)
+	var payload difficulty.Payload
+
+	err = openai.ParseStructured(*result, &payload)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("openai api returned non-OK statusCode=%v responseBody=%s", resp.StatusCode, responseBody)
+	experiment.Observe(ctx, p, usage)
+
+	return payload.ToDomain(), nil
+}
+
+func (s *service) GetSentenceExplanation(ctx context.Context, sentence string, nativeLanguage string, isDetailed bool) (*string, error) {
+	p, err := s.sentencePrompt(ctx, explanationTemplate(isDetailed), sentence, nativeLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	key := explanationContentKey(sentence, nativeLanguage, isDetailed, p)
+
+	var usage openai.Usage
+
+	result, err := s.store.GetOrGenerate(ctx, key, func(ctx context.Context) (string, error) {
+		completion, err := s.openAiClient.CreateChatCompletion(ctx, p.Request())
+		if err != nil {
+			return "", err
+		}
+
+		s.logger.Info("Successfully got sentence explanation",
+			zap.String("sentence", sentence),
+			zap.String("nativeLanguage", nativeLanguage),
+			zap.String("prompt", p.ID),
+			zap.String("promptVersion", p.Version),
+			zap.Int("promptTokens", completion.Usage.PromptTokens),
+			zap.Int("completionTokens", completion.Usage.CompletionTokens),
+			zap.Int("totalTokens", completion.Usage.TotalTokens),
+		)
+
+		usage = completion.Usage
+
+		return completion.Content(), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	var OpenAIApiResponse openai.ChatCompletion
+	experiment.Observe(ctx, p, usage)
+
+	return result, nil
+}
 
-	err = json.Unmarshal(responseBody, &OpenAIApiResponse)
+func (s *service) StreamSentenceExplanation(
+	ctx context.Context,
+	sentence string,
+	nativeLanguage string,
+	isDetailed bool,
+	onDelta func(delta string) error,
+) (*string, error) {
+	p, err := s.sentencePrompt(ctx, explanationTemplate(isDetailed), sentence, nativeLanguage)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarhsal json body: %w", err)
+		return nil, err
+	}
+
+	key := explanationContentKey(sentence, nativeLanguage, isDetailed, p)
+
+	cached, err := s.store.Get(ctx, key)
+	if err == nil {
+		err = onDelta(*cached)
+		if err != nil {
+			return nil, fmt.Errorf("failed to relay cached sentence explanation: %w", err)
+		}
+
+		experiment.Observe(ctx, p, openai.Usage{})
+
+		return cached, nil
 	}
 
-	if len(OpenAIApiResponse.Choices) == 0 {
-		return nil, ErrOpenAiNoChoices
+	completion, err := s.openAiClient.CreateChatCompletionStream(ctx, p.Request(), onDelta)
+	if err != nil {
+		return nil, err
 	}
 
-	s.logger.Info("Successfully got sentence explanation",
+	s.logger.Info("Successfully streamed sentence explanation",
 		zap.String("sentence", sentence),
 		zap.String("nativeLanguage", nativeLanguage),
-		zap.Int("promptTokens", OpenAIApiResponse.Usage.PromptTokens),
-		zap.Int("completionTokens", OpenAIApiResponse.Usage.CompletionTokens),
-		zap.Int("totalTokens", OpenAIApiResponse.Usage.TotalTokens),
+		zap.String("prompt", p.ID),
+		zap.String("promptVersion", p.Version),
+		zap.Int("promptTokens", completion.Usage.PromptTokens),
+		zap.Int("completionTokens", completion.Usage.CompletionTokens),
+		zap.Int("totalTokens", completion.Usage.TotalTokens),
 	)
 
-	result := &OpenAIApiResponse.Choices[0].Message.Content
+	result := completion.Content()
 
-	cacheValue := []byte(*result)
+	experiment.Observe(ctx, p, completion.Usage)
 
-	err = s.cache.Set(cacheKey, cacheValue, sentenceCacheExpiration)
+	err = s.store.Set(ctx, key, result)
 	if err != nil {
-		return nil, fmt.Errorf("failed to cache sentence explanation: %w", err)
+		s.logger.Warn("sentence explanation store set failed", zap.Error(err))
 	}
 
-	return result, nil
+	return &result, nil
 }
 
-//todo:return actual errors and convert to user friendlt message in handler
-func (s *service) ValidateSentence(sentence string) error {
-	if sentence == "" {
-		return errors.New("Please provide a sentence")
+// contentKey canonicalizes the sentence and language so that equivalent requests share one answer.
+// The prompt version is part of the key so that a prompt change stops stale answers being served.
+func contentKey(kind, sentence, nativeLanguage string, p *prompt.Prompt) content.Key {
+	return content.Key{
+		Kind:          kind,
+		Input:         canonical.Sentence(sentence),
+		Language:      canonical.Language(nativeLanguage),
+		PromptVersion: p.Revision(),
+		Model:         p.Model,
 	}
+}
 
-	if utf8.RuneCountInString(sentence) > 120 {
-		return errors.New("The sentence must be less than 120 characters.")
+// explanationContentKey keeps detailed explanations apart from simple translations.
+func explanationContentKey(sentence, nativeLanguage string, isDetailed bool, p *prompt.Prompt) content.Key {
+	kind := "sentence_translation"
+	if isDetailed {
+		kind = "sentence_explanation"
 	}
 
-	return nil
+	return contentKey(kind, sentence, nativeLanguage, p)
 }
 
-func (s *service) sentenceToOpenAiSimpleTranslationRequestBody(sentence, userNativeLanguage string) (*bytes.Reader, error) {
-	content := fmt.Sprintf(
-		"Translate the following sentence into %s. Do not provide any explanation or context—only the translated sentence.\n\nSentence: %s",
-		userNativeLanguage, sentence,
-	)
-
-	req := openai.OpenAIRequest{
-		Model:       "gpt-4o",
-		Temperature: 0.2,
-		MaxTokens:   300,
-		Messages: []openai.Message{
-			{Role: "system", Content: "You are a precise translator. Only return the translation without explanations."},
-			{Role: "user", Content: content},
-		},
+func explanationTemplate(isDetailed bool) string {
+	if isDetailed {
+		return prompt.SentenceExplanation
 	}
 
-	return request.JsonReader(&req)
+	return prompt.SentenceTranslation
 }
 
-func (s *service) sentenceToOpenAiExplanationRequestBody(sentence, userNativeLanguage string) (*bytes.Reader, error) {
-	content := fmt.Sprintf(
-		"Explain the meaning & grammar used in this sentence - '%s'. Respond in %s",
-		sentence, userNativeLanguage,
-	)
+// sentencePrompt renders the sentence prompt called name.
+func (s *service) sentencePrompt(ctx context.Context, name, sentence, nativeLanguage string) (*prompt.Prompt, error) {
+	p, err := s.prompts.Render(ctx, name, nativeLanguage, prompt.Vars{"Sentence": sentence, "Language": languages.Normalize(nativeLanguage)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render prompt: %w", err)
+	}
+
+	return p, nil
+}
 
-	req := openai.OpenAIRequest{
-		Model:       "gpt-4o",
-		Temperature: 0.4,
-		MaxTokens:   800,
-		Messages: []openai.Message{
-			{Role: "system", Content: "You are a helpful assistant."},
-			{Role: "user", Content: content},
-		},
+// ValidateLevel returns ErrInvalidLevel unless level is one of Levels, in any case.
+func (s *service) ValidateLevel(level string) error {
+	if !slices.Contains(Levels, strings.ToUpper(strings.TrimSpace(level))) {
+		return fmt.Errorf("%w: %q", ErrInvalidLevel, level)
 	}
 
-	return request.JsonReader(&req)
+	return nil
 }
 
-func (s *service) sentenceToOpenAiSentenceCorrectionRequestBody(sentence, userNativeLanguage string) (*bytes.Reader, error) {
-	var content string
-	if userNativeLanguage == "English" {
-		content = fmt.Sprintf(
-			"Is this sentence correct? If not, correct it and briefly explain why. Do not ask follow-up questions or encourage further conversation. Just provide the correction and explanation in a single, complete answer.\n\nSentence: %s",
-			sentence,
-		)
-	} else {
-		content = fmt.Sprintf(
-			"Is this sentence correct? If not, correct it and briefly explain why. Do not ask follow-up questions or encourage further conversation. Just provide the correction and explanation in a single, complete answer. Respond in %s as if you're a language teacher teaching a native %s speaker.\n\nSentence: %s",
-			userNativeLanguage, userNativeLanguage, sentence,
-		)
+// ValidateSentence returns ErrEmptySentence or ErrSentenceTooLong when sentence cannot be processed.
+func (s *service) ValidateSentence(sentence string) error {
+	if sentence == "" {
+		return ErrEmptySentence
 	}
 
-	req := openai.OpenAIRequest{
-		Model:       "gpt-4o",
-		Temperature: 0.4,
-		MaxTokens:   800,
-		Messages: []openai.Message{
-			{Role: "system", Content: "You are a concise language assistant that explains sentence corrections in a clear and brief manner without engaging in back-and-forth conversation."},
-			{Role: "user", Content: content},
-		},
+	if utf8.RuneCountInString(sentence) > 120 {
+		return ErrSentenceTooLong
 	}
 
-	return request.JsonReader(&req)
+	return nil
 }
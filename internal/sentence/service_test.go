@@ -0,0 +1,151 @@
+package sentence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/coocood/freecache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache"
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+	mockopenai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/sentence/domain"
+)
+
+func newSentenceService(t *testing.T, client openai.Client) sentence.Service {
+	t.Helper()
+
+	logger := zaptest.NewLogger(t)
+
+	prompts, err := prompt.NewRegistry()
+	require.NoError(t, err)
+
+	store := content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1*1024*1024)))
+
+	return sentence.NewSentenceService(logger, client, store, prompts)
+}
+
+func completion(content string) *openai.ChatCompletion {
+	return &openai.ChatCompletion{
+		Choices: []openai.Choice{{Message: openai.Message{Role: "assistant", Content: content}}},
+	}
+}
+
+func TestGetSentenceSimplification(t *testing.T) {
+	const (
+		sentenceText = "Notwithstanding the inclement weather, we proceeded with the excursion."
+		answer       = `{"simplified": "The weather was bad, but we still went on the trip.", "explanation": "Mots difficiles remplacés."}`
+	)
+
+	t.Run("simplifies once per sentence and level", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mockopenai.NewMockClient(ctrl)
+		service := newSentenceService(t, mockClient)
+
+		mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, request *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+				require.NotNil(t, request.ResponseFormat)
+				assert.Equal(t, "sentence_simplification", request.ResponseFormat.JSONSchema.Name)
+				assert.Contains(t, request.Messages[len(request.Messages)-1].Content, "CEFR level A2")
+				assert.Contains(t, request.Messages[len(request.Messages)-1].Content, sentenceText)
+
+				return completion("```json\n" + answer + "\n```"), nil
+			}).Times(1)
+
+		for _, level := range []string{"A2", " a2"} {
+			simplification, err := service.GetSentenceSimplification(context.Background(), sentenceText, "French", level)
+			require.NoError(t, err)
+
+			assert.Equal(t, &domain.Simplification{
+				Level:       "A2",
+				Simplified:  "The weather was bad, but we still went on the trip.",
+				Explanation: "Mots difficiles remplacés.",
+			}, simplification)
+		}
+	})
+
+	t.Run("each level has its own answer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mockopenai.NewMockClient(ctrl)
+		service := newSentenceService(t, mockClient)
+
+		mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Return(completion(answer), nil).Times(2)
+
+		for _, level := range []string{"A1", "B2"} {
+			_, err := service.GetSentenceSimplification(context.Background(), sentenceText, "French", level)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("invalid level", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		service := newSentenceService(t, mockopenai.NewMockClient(ctrl))
+
+		_, err := service.GetSentenceSimplification(context.Background(), sentenceText, "French", "D1")
+		assert.ErrorIs(t, err, sentence.ErrInvalidLevel)
+	})
+
+	t.Run("unusable answers are not cached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mockopenai.NewMockClient(ctrl)
+		service := newSentenceService(t, mockClient)
+
+		gomock.InOrder(
+			mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Return(completion(`{"simplified": ""}`), nil),
+			mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Return(completion(answer), nil),
+		)
+
+		_, err := service.GetSentenceSimplification(context.Background(), sentenceText, "French", "B1")
+		assert.ErrorIs(t, err, openaierrors.ErrInvalidStructuredOutput)
+
+		_, err = service.GetSentenceSimplification(context.Background(), sentenceText, "French", "B1")
+		assert.NoError(t, err)
+	})
+}
+
+func TestGetSentenceDifficulty(t *testing.T) {
+	const sentenceText = "猫が好きです。"
+
+	t.Run("assesses once per sentence", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mockopenai.NewMockClient(ctrl)
+		service := newSentenceService(t, mockClient)
+
+		mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, request *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+				require.NotNil(t, request.ResponseFormat)
+				assert.Equal(t, "sentence_difficulty", request.ResponseFormat.JSONSchema.Name)
+				assert.Contains(t, request.Messages[len(request.Messages)-1].Content, sentenceText)
+
+				return completion(`{"cefr": "A1", "jlpt": "N5", "hsk": "", "rationale": "Vocabulaire et grammaire de base."}`), nil
+			}).Times(1)
+
+		for range 2 {
+			assessment, err := service.GetSentenceDifficulty(context.Background(), sentenceText, "French")
+			require.NoError(t, err)
+
+			assert.Equal(t, &difficulty.Assessment{CEFR: "A1", JLPT: "N5", Rationale: "Vocabulaire et grammaire de base."}, assessment)
+		}
+	})
+
+	t.Run("rejects levels outside the scales", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mockopenai.NewMockClient(ctrl)
+		service := newSentenceService(t, mockClient)
+
+		mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).
+			Return(completion(`{"cefr": "A1", "jlpt": "N6", "hsk": "", "rationale": "Facile."}`), nil)
+
+		_, err := service.GetSentenceDifficulty(context.Background(), sentenceText, "French")
+		assert.ErrorIs(t, err, openaierrors.ErrInvalidStructuredOutput)
+	})
+}
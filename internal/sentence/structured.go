@@ -0,0 +1,27 @@
+package sentence
+
+import (
+	"encoding/json"
+)
+
+// simplificationPayload mirrors simplificationJSONSchema.
+type simplificationPayload struct {
+	Simplified  string `json:"simplified" validate:"required"`
+	Explanation string `json:"explanation" validate:"required"`
+}
+
+var simplificationJSONSchema = json.RawMessage(`{
+	"type": "object",
+	"properties": {
+		"simplified": {
+			"type": "string",
+			"description": "The rewritten sentence, in the language of the original sentence."
+		},
+		"explanation": {
+			"type": "string",
+			"description": "What was simplified and why, written in the user's language."
+		}
+	},
+	"required": ["explanation", "simplified"],
+	"additionalProperties": false
+}`)
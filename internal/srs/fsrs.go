@@ -0,0 +1,99 @@
+package srs
+
+import (
+	"math"
+	"time"
+)
+
+// DefaultRetention is the probability of remembering a card on its due date that FSRS schedules for.
+const DefaultRetention = 0.9
+
+// fsrsWeights are the default parameters of FSRS-4.5, fitted on a large collection of reviews.
+var fsrsWeights = [17]float64{
+	0.4872, 1.4003, 3.7145, 13.8206, 5.1618, 1.2298, 0.8975, 0.031, 1.6474, 0.1367, 1.0461, 2.1072, 0.0793,
+	0.3246, 1.587, 0.2272, 2.8755,
+}
+
+// The forgetting curve of FSRS-4.5: retrievability is (1 + fsrsFactor*t/S) ^ fsrsDecay after t days, which is 90%
+// when t is the stability S.
+const (
+	fsrsDecay  = -0.5
+	fsrsFactor = 19.0 / 81
+)
+
+type fsrs struct {
+	retention float64
+}
+
+// NewFSRS returns the Free Spaced Repetition Scheduler, which models the stability and difficulty of each card and
+// schedules its next review for when it is remembered with probability retention, between 0 and 1.
+func NewFSRS(retention float64) Scheduler {
+	return fsrs{
+		retention: retention,
+	}
+}
+
+func (fsrs) Name() string {
+	return SchedulerFSRS
+}
+
+func (f fsrs) Review(card Card, rating Rating, now time.Time) Card {
+	w := fsrsWeights
+
+	switch {
+	case card.New():
+		card.Stability = w[rating-1]
+		card.Difficulty = initialDifficulty(rating)
+	default:
+		if card.Stability == 0 {
+			// Scheduled by SM-2 so far: its interval is the best guess of the stability.
+			card.Stability = float64(max(card.IntervalDays, 1))
+			card.Difficulty = initialDifficulty(Good)
+		}
+
+		stability, difficulty := card.Stability, card.Difficulty
+		recall := retrievability(float64(ElapsedDays(card, now)), stability)
+
+		if rating == Again {
+			card.Stability = min(stability, w[11]*math.Pow(difficulty, -w[12])*
+				(math.Pow(stability+1, w[13])-1)*math.Exp(w[14]*(1-recall)))
+		} else {
+			growth := math.Exp(w[8]) * (11 - difficulty) * math.Pow(stability, -w[9]) * (math.Exp(w[10]*(1-recall)) - 1)
+
+			switch rating {
+			case Hard:
+				growth *= w[15]
+			case Easy:
+				growth *= w[16]
+			}
+
+			card.Stability = stability * (1 + growth)
+		}
+
+		// Difficulty moves with the rating, and a little back towards that of a new card rated good.
+		next := difficulty - w[6]*float64(rating-Good)
+		card.Difficulty = clampDifficulty(w[7]*initialDifficulty(Good) + (1-w[7])*next)
+	}
+
+	card = recordRating(card, rating)
+
+	return schedule(card, f.intervalDays(card.Stability), now)
+}
+
+// intervalDays returns the number of days after which a card of that stability is remembered with probability
+// f.retention.
+func (f fsrs) intervalDays(stability float64) int {
+	return int(math.Round(stability / fsrsFactor * (math.Pow(f.retention, 1/fsrsDecay) - 1)))
+}
+
+func retrievability(elapsedDays, stability float64) float64 {
+	return math.Pow(1+fsrsFactor*elapsedDays/stability, fsrsDecay)
+}
+
+func initialDifficulty(rating Rating) float64 {
+	return clampDifficulty(fsrsWeights[4] - float64(rating-Good)*fsrsWeights[5])
+}
+
+func clampDifficulty(difficulty float64) float64 {
+	return min(max(difficulty, 1), 10)
+}
@@ -0,0 +1,50 @@
+package srs
+
+import (
+	"math"
+	"time"
+)
+
+// Ease factors of SM-2: new cards start at DefaultEaseFactor, and hard cards never go below MinEaseFactor.
+const (
+	DefaultEaseFactor = 2.5
+	MinEaseFactor     = 1.3
+)
+
+// sm2Quality maps ratings onto the 0 to 5 quality grades of SM-2, where grades below 3 are failures.
+var sm2Quality = map[Rating]float64{Again: 1, Hard: 3, Good: 4, Easy: 5}
+
+type sm2 struct{}
+
+// NewSM2 returns the SuperMemo 2 scheduler: reviewed after 1 day, then 6 days, then the previous interval times
+// an ease factor that each rating nudges up or down. Forgotten cards start over without changing their ease.
+func NewSM2() Scheduler {
+	return sm2{}
+}
+
+func (sm2) Name() string {
+	return SchedulerSM2
+}
+
+func (sm2) Review(card Card, rating Rating, now time.Time) Card {
+	if card.EaseFactor == 0 {
+		card.EaseFactor = DefaultEaseFactor
+	}
+
+	card = recordRating(card, rating)
+	if rating == Again {
+		return schedule(card, 1, now)
+	}
+
+	quality := sm2Quality[rating]
+	card.EaseFactor = max(card.EaseFactor+0.1-(5-quality)*(0.08+(5-quality)*0.02), MinEaseFactor)
+
+	switch card.Repetitions {
+	case 1:
+		return schedule(card, 1, now)
+	case 2:
+		return schedule(card, 6, now)
+	default:
+		return schedule(card, int(math.Round(float64(max(card.IntervalDays, 1))*card.EaseFactor)), now)
+	}
+}
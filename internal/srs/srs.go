@@ -0,0 +1,137 @@
+// Package srs schedules reviews of saved words by spaced repetition: the better a word is remembered, the longer it
+// is until it is reviewed again. Schedulers are pure functions of a card, a rating and the time of the review, so
+// callers own the clock.
+package srs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Names of the schedulers.
+const (
+	SchedulerSM2  = "sm2"
+	SchedulerFSRS = "fsrs"
+)
+
+// MaxIntervalDays is the longest a card waits for its next review.
+const MaxIntervalDays = 36500
+
+// Rating is how well a word was remembered at a review.
+type Rating int
+
+const (
+	// Again means the word was forgotten.
+	Again Rating = iota + 1
+	Hard
+	Good
+	Easy
+)
+
+var ErrInvalidRating = errors.New("ratings are again, hard, good or easy")
+
+var ratingNames = []string{Again: "again", Hard: "hard", Good: "good", Easy: "easy"}
+
+// ParseRating returns the rating with that name, in any case.
+func ParseRating(name string) (Rating, error) {
+	for rating := Again; rating <= Easy; rating++ {
+		if strings.EqualFold(name, ratingNames[rating]) {
+			return rating, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w, not %q", ErrInvalidRating, name)
+}
+
+func (r Rating) Valid() bool {
+	return r >= Again && r <= Easy
+}
+
+func (r Rating) String() string {
+	if !r.Valid() {
+		return fmt.Sprintf("Rating(%d)", int(r))
+	}
+
+	return ratingNames[r]
+}
+
+// Card is the review state of a word. The zero Card has never been reviewed.
+//
+// Each scheduler keeps its own fields up to date, and carries on from the interval of cards last scheduled by the
+// other one.
+type Card struct {
+	// Repetitions counts the reviews since the card was last forgotten.
+	Repetitions int
+	// Lapses counts the times the card was forgotten after its first review.
+	Lapses int
+	// EaseFactor multiplies the interval after each successful review in SM-2.
+	EaseFactor float64
+	// Stability is the interval, in days, after which FSRS expects the card to be remembered with 90% probability.
+	Stability float64
+	// Difficulty is how hard FSRS finds the card, from 1 to 10.
+	Difficulty   float64
+	IntervalDays int
+	Due          time.Time
+	LastReviewed time.Time
+}
+
+// New reports whether the card has never been reviewed.
+func (c Card) New() bool {
+	return c.LastReviewed.IsZero()
+}
+
+// Scheduler computes the next review of a card.
+type Scheduler interface {
+	// Name is SchedulerSM2 or SchedulerFSRS.
+	Name() string
+	// Review returns card after it was rated at now, due for its next review.
+	Review(card Card, rating Rating, now time.Time) Card
+}
+
+// NewScheduler returns the scheduler with that name, with its default parameters.
+func NewScheduler(name string) (Scheduler, error) {
+	switch name {
+	case SchedulerSM2:
+		return NewSM2(), nil
+	case SchedulerFSRS:
+		return NewFSRS(DefaultRetention), nil
+	default:
+		return nil, fmt.Errorf("unknown spaced repetition scheduler %q", name)
+	}
+}
+
+// ElapsedDays returns the number of whole days between the last review of card and now, 0 for new cards.
+func ElapsedDays(card Card, now time.Time) int {
+	if card.New() || !now.After(card.LastReviewed) {
+		return 0
+	}
+
+	return int(now.Sub(card.LastReviewed) / (24 * time.Hour))
+}
+
+// schedule makes card due intervalDays after now, within 1 and MaxIntervalDays days.
+func schedule(card Card, intervalDays int, now time.Time) Card {
+	card.IntervalDays = min(max(intervalDays, 1), MaxIntervalDays)
+	card.Due = now.AddDate(0, 0, card.IntervalDays)
+	card.LastReviewed = now
+
+	return card
+}
+
+// recordRating updates the repetitions and lapses of card for a review rated rating.
+func recordRating(card Card, rating Rating) Card {
+	if rating != Again {
+		card.Repetitions++
+		return card
+	}
+
+	if !card.New() {
+		card.Lapses++
+	}
+
+	card.Repetitions = 0
+
+	return card
+}
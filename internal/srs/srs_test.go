@@ -0,0 +1,127 @@
+package srs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/srs"
+)
+
+var start = time.Date(2025, 10, 17, 8, 0, 0, 0, time.UTC)
+
+// review rates card at its due date, or at start when it is new.
+func review(scheduler srs.Scheduler, card srs.Card, rating srs.Rating) srs.Card {
+	now := card.Due
+	if card.New() {
+		now = start
+	}
+
+	return scheduler.Review(card, rating, now)
+}
+
+func TestSM2(t *testing.T) {
+	sm2 := srs.NewSM2()
+
+	t.Run("intervals grow by the ease factor", func(t *testing.T) {
+		var card srs.Card
+
+		var intervals []int
+		for range 4 {
+			card = review(sm2, card, srs.Good)
+			intervals = append(intervals, card.IntervalDays)
+		}
+
+		assert.Equal(t, []int{1, 6, 15, 38}, intervals)
+		assert.Equal(t, srs.DefaultEaseFactor, card.EaseFactor)
+		assert.Equal(t, 4, card.Repetitions)
+		assert.Equal(t, start.AddDate(0, 0, 1+6+15+38), card.Due)
+	})
+
+	t.Run("forgotten cards start over with the same ease", func(t *testing.T) {
+		card := review(sm2, review(sm2, review(sm2, srs.Card{}, srs.Easy), srs.Easy), srs.Easy)
+		ease := card.EaseFactor
+		assert.InDelta(t, 2.8, ease, 1e-9)
+
+		card = review(sm2, card, srs.Again)
+
+		assert.Equal(t, 1, card.IntervalDays)
+		assert.Equal(t, 0, card.Repetitions)
+		assert.Equal(t, 1, card.Lapses)
+		assert.Equal(t, ease, card.EaseFactor)
+	})
+
+	t.Run("ease never drops below the minimum", func(t *testing.T) {
+		var card srs.Card
+		for range 10 {
+			card = review(sm2, card, srs.Hard)
+		}
+
+		assert.Equal(t, srs.MinEaseFactor, card.EaseFactor)
+	})
+}
+
+func TestFSRS(t *testing.T) {
+	fsrs := srs.NewFSRS(srs.DefaultRetention)
+
+	t.Run("first review", func(t *testing.T) {
+		testCases := map[srs.Rating]int{srs.Again: 1, srs.Hard: 1, srs.Good: 4, srs.Easy: 14}
+
+		for rating, intervalDays := range testCases {
+			card := review(fsrs, srs.Card{}, rating)
+			assert.Equal(t, intervalDays, card.IntervalDays, rating.String())
+		}
+
+		assert.Greater(t, review(fsrs, srs.Card{}, srs.Again).Difficulty, review(fsrs, srs.Card{}, srs.Easy).Difficulty)
+	})
+
+	t.Run("remembered cards become more stable", func(t *testing.T) {
+		card := review(fsrs, srs.Card{}, srs.Good)
+		next := review(fsrs, card, srs.Good)
+
+		assert.Greater(t, next.Stability, 3*card.Stability)
+		assert.Equal(t, 15, next.IntervalDays)
+		assert.Equal(t, 2, next.Repetitions)
+	})
+
+	t.Run("forgotten cards become less stable", func(t *testing.T) {
+		card := review(fsrs, review(fsrs, srs.Card{}, srs.Good), srs.Good)
+		next := review(fsrs, card, srs.Again)
+
+		assert.Less(t, next.Stability, card.Stability)
+		assert.Greater(t, next.Difficulty, card.Difficulty)
+		assert.Equal(t, 1, next.Lapses)
+		assert.Equal(t, 0, next.Repetitions)
+	})
+
+	t.Run("higher retention reviews sooner", func(t *testing.T) {
+		card := review(srs.NewFSRS(0.95), srs.Card{}, srs.Easy)
+		assert.Less(t, card.IntervalDays, 14)
+	})
+
+	t.Run("carries on from SM-2", func(t *testing.T) {
+		card := review(srs.NewSM2(), review(srs.NewSM2(), srs.Card{}, srs.Good), srs.Good)
+		next := review(fsrs, card, srs.Good)
+
+		assert.Greater(t, next.IntervalDays, card.IntervalDays)
+	})
+}
+
+func TestParseRating(t *testing.T) {
+	rating, err := srs.ParseRating("Good")
+	require.NoError(t, err)
+	assert.Equal(t, srs.Good, rating)
+
+	_, err = srs.ParseRating("perfect")
+	assert.ErrorIs(t, err, srs.ErrInvalidRating)
+}
+
+func TestElapsedDays(t *testing.T) {
+	card := srs.Card{LastReviewed: start}
+
+	assert.Equal(t, 0, srs.ElapsedDays(srs.Card{}, start))
+	assert.Equal(t, 0, srs.ElapsedDays(card, start.Add(23*time.Hour)))
+	assert.Equal(t, 3, srs.ElapsedDays(card, start.AddDate(0, 0, 3)))
+}
@@ -0,0 +1,165 @@
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/stripe/stripe-go/v82"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+type cachedSubscription struct {
+	subscription *entity.Subscription
+	// notFound is set when the user has no subscription.
+	notFound  bool
+	expiresAt time.Time
+}
+
+type cachedSubscriptionService struct {
+	SubscriptionService
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cachedSubscription
+	// sweptAt is when the expired entries were last removed.
+	sweptAt time.Time
+}
+
+// NewCachedSubscriptionService returns a SubscriptionService that keeps the result of GetUserSubscription,
+// including ErrSubscriptionNotFound, in memory for ttl. Subscriptions changed through the returned service
+// are forgotten straight away, so only changes made by other instances can be up to ttl late. Expired entries are
+// removed every ttl, so only the users seen lately are kept.
+func NewCachedSubscriptionService(service SubscriptionService, ttl time.Duration) SubscriptionService {
+	return &cachedSubscriptionService{
+		SubscriptionService: service,
+		ttl:                 ttl,
+		entries:             map[string]cachedSubscription{},
+	}
+}
+
+func (s *cachedSubscriptionService) GetUserSubscription(ctx context.Context, userID *string) (*entity.Subscription, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	cached, ok := s.entries[*userID]
+	s.mu.Unlock()
+
+	if ok && now.Before(cached.expiresAt) {
+		if cached.notFound {
+			return nil, ErrSubscriptionNotFound
+		}
+
+		return copySubscription(cached.subscription), nil
+	}
+
+	sub, err := s.SubscriptionService.GetUserSubscription(ctx, userID)
+
+	switch {
+	case errors.Is(err, ErrSubscriptionNotFound):
+		cached = cachedSubscription{notFound: true}
+	case err != nil:
+		return nil, err
+	default:
+		cached = cachedSubscription{subscription: copySubscription(sub)}
+	}
+
+	cached.expiresAt = now.Add(s.ttl)
+
+	s.mu.Lock()
+	s.entries[*userID] = cached
+	s.sweep(now)
+	s.mu.Unlock()
+
+	return sub, err
+}
+
+func (s *cachedSubscriptionService) SubscribeUser(ctx context.Context, user *entity.User) (*entity.Subscription, error) {
+	defer s.forget(user.ID)
+
+	return s.SubscriptionService.SubscribeUser(ctx, user)
+}
+
+func (s *cachedSubscriptionService) CancelSubscription(ctx context.Context, userID *string) (*entity.Subscription, error) {
+	defer s.forget(*userID)
+
+	return s.SubscriptionService.CancelSubscription(ctx, userID)
+}
+
+// The Stripe webhooks identify subscriptions by their Stripe IDs, so they forget every cached subscription.
+
+func (s *cachedSubscriptionService) HandleInvoiceSuccess(
+	ctx context.Context,
+	stripeSubID *string,
+	amount *int64,
+	currency string,
+) error {
+	defer s.forgetAll()
+
+	return s.SubscriptionService.HandleInvoiceSuccess(ctx, stripeSubID, amount, currency)
+}
+
+func (s *cachedSubscriptionService) HandleInvoiceFailed(
+	ctx context.Context,
+	stripeCustomerID string,
+	amount int64,
+	currency string,
+) error {
+	defer s.forgetAll()
+
+	return s.SubscriptionService.HandleInvoiceFailed(ctx, stripeCustomerID, amount, currency)
+}
+
+func (s *cachedSubscriptionService) HandleSubscriptionUpdated(ctx context.Context, event stripe.Event) error {
+	defer s.forgetAll()
+
+	return s.SubscriptionService.HandleSubscriptionUpdated(ctx, event)
+}
+
+func (s *cachedSubscriptionService) HandleSubscriptionDeleted(ctx context.Context, event stripe.Event) error {
+	defer s.forgetAll()
+
+	return s.SubscriptionService.HandleSubscriptionDeleted(ctx, event)
+}
+
+func (s *cachedSubscriptionService) forget(userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, userID)
+}
+
+// sweep removes the expired entries, at most once per ttl. s.mu must be held.
+func (s *cachedSubscriptionService) sweep(now time.Time) {
+	if now.Sub(s.sweptAt) < s.ttl {
+		return
+	}
+
+	s.sweptAt = now
+
+	for userID, cached := range s.entries {
+		if !now.Before(cached.expiresAt) {
+			delete(s.entries, userID)
+		}
+	}
+}
+
+func (s *cachedSubscriptionService) forgetAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.entries)
+}
+
+// copySubscription keeps callers from changing the cached subscription.
+func copySubscription(sub *entity.Subscription) *entity.Subscription {
+	if sub == nil {
+		return nil
+	}
+
+	copied := *sub
+
+	return &copied
+}
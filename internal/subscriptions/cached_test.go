@@ -0,0 +1,95 @@
+package subscriptions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions"
+	mocksubscriptions "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions/mock"
+)
+
+func TestCachedSubscriptionService(t *testing.T) {
+	userID := "user-1"
+	ctx := context.Background()
+
+	t.Run("subscriptions are read once per ttl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		service := mocksubscriptions.NewMockSubscriptionService(ctrl)
+		service.EXPECT().GetUserSubscription(gomock.Any(), &userID).Return(&entity.Subscription{Status: "active"}, nil).Times(1)
+
+		cached := subscriptions.NewCachedSubscriptionService(service, time.Minute)
+
+		for range 3 {
+			sub, err := cached.GetUserSubscription(ctx, &userID)
+			require.NoError(t, err)
+			assert.Equal(t, "active", sub.Status)
+
+			sub.Status = "changed by the caller"
+		}
+	})
+
+	t.Run("expired subscriptions are read again", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		service := mocksubscriptions.NewMockSubscriptionService(ctrl)
+		otherUserID := "user-2"
+		service.EXPECT().GetUserSubscription(gomock.Any(), &userID).Return(&entity.Subscription{Status: "active"}, nil).Times(2)
+		service.EXPECT().GetUserSubscription(gomock.Any(), &otherUserID).Return(&entity.Subscription{Status: "active"}, nil).Times(1)
+
+		cached := subscriptions.NewCachedSubscriptionService(service, 10*time.Millisecond)
+
+		_, err := cached.GetUserSubscription(ctx, &userID)
+		require.NoError(t, err)
+
+		time.Sleep(20 * time.Millisecond)
+
+		// Storing the other user removes the expired subscription of the first one.
+		_, err = cached.GetUserSubscription(ctx, &otherUserID)
+		require.NoError(t, err)
+
+		_, err = cached.GetUserSubscription(ctx, &userID)
+		require.NoError(t, err)
+	})
+
+	t.Run("users without a subscription are cached too", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		service := mocksubscriptions.NewMockSubscriptionService(ctrl)
+		service.EXPECT().GetUserSubscription(gomock.Any(), &userID).
+			Return(nil, fmt.Errorf("failed to get subscription: %w", subscriptions.ErrSubscriptionNotFound)).Times(1)
+
+		cached := subscriptions.NewCachedSubscriptionService(service, time.Minute)
+
+		for range 2 {
+			_, err := cached.GetUserSubscription(ctx, &userID)
+			assert.ErrorIs(t, err, subscriptions.ErrSubscriptionNotFound)
+		}
+	})
+
+	t.Run("changing a subscription forgets it", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		service := mocksubscriptions.NewMockSubscriptionService(ctrl)
+		gomock.InOrder(
+			service.EXPECT().GetUserSubscription(gomock.Any(), &userID).Return(&entity.Subscription{Status: "active"}, nil),
+			service.EXPECT().CancelSubscription(gomock.Any(), &userID).Return(&entity.Subscription{Status: "canceled"}, nil),
+			service.EXPECT().GetUserSubscription(gomock.Any(), &userID).Return(&entity.Subscription{Status: "canceled"}, nil),
+		)
+
+		cached := subscriptions.NewCachedSubscriptionService(service, time.Minute)
+
+		_, err := cached.GetUserSubscription(ctx, &userID)
+		require.NoError(t, err)
+
+		_, err = cached.CancelSubscription(ctx, &userID)
+		require.NoError(t, err)
+
+		sub, err := cached.GetUserSubscription(ctx, &userID)
+		require.NoError(t, err)
+		assert.Equal(t, "canceled", sub.Status)
+	})
+}
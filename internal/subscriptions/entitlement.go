@@ -0,0 +1,85 @@
+package subscriptions
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"go.uber.org/zap"
+
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/messages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+// CheckoutPath is where the frontend creates a checkout session for a user without an entitling subscription.
+const CheckoutPath = "/api/v3/subscription/checkout"
+
+// Policy is the subscription statuses entitled to a route.
+type Policy []string
+
+var (
+	// PolicySubscribed lets through trialing and paying users.
+	PolicySubscribed = Policy{"trialing", "active"}
+	// PolicyGracePeriod also lets through users whose last payment failed while Stripe retries it.
+	PolicyGracePeriod = Policy{"trialing", "active", "past_due"}
+)
+
+// SubscriptionRequiredResponse is the body of a 402 answered to a user whose subscription does not entitle them
+// to a route. Status is empty when the user has never subscribed.
+type SubscriptionRequiredResponse struct {
+	Code     string         `json:"code"`
+	Message  string         `json:"message"`
+	Status   string         `json:"subscriptionStatus"`
+	Checkout CheckoutAction `json:"checkout"`
+}
+
+// CheckoutAction is the request that starts a checkout session.
+type CheckoutAction struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+}
+
+// RequireSubscription answers 402 to users whose subscription status is not in policy. It must run after the
+// auth middleware.
+func RequireSubscription(logger *zap.Logger, service SubscriptionService, policy Policy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID, err := commonContext.GetUserIDString(r.Context())
+			if err != nil {
+				logger.Error("user ID not found in session", zap.Error(err))
+				render.Json(w, http.StatusUnauthorized, "unauthorized")
+
+				return
+			}
+
+			var status string
+
+			sub, err := service.GetUserSubscription(r.Context(), &userID)
+
+			switch {
+			case errors.Is(err, ErrSubscriptionNotFound):
+			case err != nil:
+				logger.Error("failed to get subscription", zap.String("userID", userID), zap.Error(err))
+				render.Json(w, http.StatusInternalServerError, messages.InternalServerErrorMsg)
+
+				return
+			default:
+				status = sub.Status
+			}
+
+			if !slices.Contains(policy, status) {
+				render.Json(w, http.StatusPaymentRequired, SubscriptionRequiredResponse{
+					Code:     "subscription_required",
+					Message:  "Your plan does not include this feature. Please subscribe to keep using it.",
+					Status:   status,
+					Checkout: CheckoutAction{Method: http.MethodPost, Path: CheckoutPath},
+				})
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package subscriptions_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions"
+	mocksubscriptions "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions/mock"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+)
+
+func TestRequireSubscription(t *testing.T) {
+	testCases := []struct {
+		name           string
+		policy         subscriptions.Policy
+		subscription   *entity.Subscription
+		err            error
+		expectedStatus int
+	}{
+		{
+			name:           "active",
+			policy:         subscriptions.PolicySubscribed,
+			subscription:   &entity.Subscription{Status: "active"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "trialing",
+			policy:         subscriptions.PolicySubscribed,
+			subscription:   &entity.Subscription{Status: "trialing"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "past due",
+			policy:         subscriptions.PolicySubscribed,
+			subscription:   &entity.Subscription{Status: "past_due"},
+			expectedStatus: http.StatusPaymentRequired,
+		},
+		{
+			name:           "past due during the grace period",
+			policy:         subscriptions.PolicyGracePeriod,
+			subscription:   &entity.Subscription{Status: "past_due"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "canceled",
+			policy:         subscriptions.PolicyGracePeriod,
+			subscription:   &entity.Subscription{Status: "canceled"},
+			expectedStatus: http.StatusPaymentRequired,
+		},
+		{
+			name:           "never subscribed",
+			policy:         subscriptions.PolicyGracePeriod,
+			err:            subscriptions.ErrSubscriptionNotFound,
+			expectedStatus: http.StatusPaymentRequired,
+		},
+		{
+			name:           "subscription lookup fails",
+			policy:         subscriptions.PolicyGracePeriod,
+			err:            errors.New("connection refused"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := mocksubscriptions.NewMockSubscriptionService(ctrl)
+			service.EXPECT().GetUserSubscription(gomock.Any(), gomock.Any()).Return(tc.subscription, tc.err)
+
+			handler := subscriptions.RequireSubscription(zaptest.NewLogger(t), service, tc.policy)(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}),
+			)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v3/word/synonyms", nil)
+			req = req.WithContext(commonContext.SetUserIDString(req.Context(), "user-1"))
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			if tc.expectedStatus != http.StatusPaymentRequired {
+				return
+			}
+
+			var body subscriptions.SubscriptionRequiredResponse
+
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, "subscription_required", body.Code)
+			assert.Equal(t, subscriptions.CheckoutAction{Method: http.MethodPost, Path: subscriptions.CheckoutPath}, body.Checkout)
+
+			if tc.subscription != nil {
+				assert.Equal(t, tc.subscription.Status, body.Status)
+			}
+		})
+	}
+}
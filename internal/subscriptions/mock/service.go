@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock/service.go
+//
+
+// Package mock_subscriptions is a generated GoMock package.
+package mock_subscriptions
+
+import (
+	context "context"
+	reflect "reflect"
+
+	stripe "github.com/stripe/stripe-go/v82"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+)
+
+// MockSubscriptionService is a mock of SubscriptionService interface.
+type MockSubscriptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionServiceMockRecorder
+}
+
+// MockSubscriptionServiceMockRecorder is the mock recorder for MockSubscriptionService.
+type MockSubscriptionServiceMockRecorder struct {
+	mock *MockSubscriptionService
+}
+
+// NewMockSubscriptionService creates a new mock instance.
+func NewMockSubscriptionService(ctrl *gomock.Controller) *MockSubscriptionService {
+	mock := &MockSubscriptionService{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionService) EXPECT() *MockSubscriptionServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelSubscription mocks base method.
+func (m *MockSubscriptionService) CancelSubscription(ctx context.Context, useruserID *string) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSubscription", ctx, useruserID)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSubscription indicates an expected call of CancelSubscription.
+func (mr *MockSubscriptionServiceMockRecorder) CancelSubscription(ctx, useruserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSubscription", reflect.TypeOf((*MockSubscriptionService)(nil).CancelSubscription), ctx, useruserID)
+}
+
+// CreateCheckoutSession mocks base method.
+func (m *MockSubscriptionService) CreateCheckoutSession(ctx context.Context, userID string) (*stripe.CheckoutSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCheckoutSession", ctx, userID)
+	ret0, _ := ret[0].(*stripe.CheckoutSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCheckoutSession indicates an expected call of CreateCheckoutSession.
+func (mr *MockSubscriptionServiceMockRecorder) CreateCheckoutSession(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCheckoutSession", reflect.TypeOf((*MockSubscriptionService)(nil).CreateCheckoutSession), ctx, userID)
+}
+
+// GetUserSubscription mocks base method.
+func (m *MockSubscriptionService) GetUserSubscription(ctx context.Context, userID *string) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSubscription", ctx, userID)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSubscription indicates an expected call of GetUserSubscription.
+func (mr *MockSubscriptionServiceMockRecorder) GetUserSubscription(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSubscription", reflect.TypeOf((*MockSubscriptionService)(nil).GetUserSubscription), ctx, userID)
+}
+
+// HandleInvoiceFailed mocks base method.
+func (m *MockSubscriptionService) HandleInvoiceFailed(ctx context.Context, stripeCustomerID string, amount int64, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleInvoiceFailed", ctx, stripeCustomerID, amount, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleInvoiceFailed indicates an expected call of HandleInvoiceFailed.
+func (mr *MockSubscriptionServiceMockRecorder) HandleInvoiceFailed(ctx, stripeCustomerID, amount, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleInvoiceFailed", reflect.TypeOf((*MockSubscriptionService)(nil).HandleInvoiceFailed), ctx, stripeCustomerID, amount, currency)
+}
+
+// HandleInvoiceSuccess mocks base method.
+func (m *MockSubscriptionService) HandleInvoiceSuccess(ctx context.Context, stripeSubID *string, amount *int64, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleInvoiceSuccess", ctx, stripeSubID, amount, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleInvoiceSuccess indicates an expected call of HandleInvoiceSuccess.
+func (mr *MockSubscriptionServiceMockRecorder) HandleInvoiceSuccess(ctx, stripeSubID, amount, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleInvoiceSuccess", reflect.TypeOf((*MockSubscriptionService)(nil).HandleInvoiceSuccess), ctx, stripeSubID, amount, currency)
+}
+
+// HandleSubscriptionDeleted mocks base method.
+func (m *MockSubscriptionService) HandleSubscriptionDeleted(ctx context.Context, event stripe.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleSubscriptionDeleted", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleSubscriptionDeleted indicates an expected call of HandleSubscriptionDeleted.
+func (mr *MockSubscriptionServiceMockRecorder) HandleSubscriptionDeleted(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSubscriptionDeleted", reflect.TypeOf((*MockSubscriptionService)(nil).HandleSubscriptionDeleted), ctx, event)
+}
+
+// HandleSubscriptionUpdated mocks base method.
+func (m *MockSubscriptionService) HandleSubscriptionUpdated(ctx context.Context, event stripe.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleSubscriptionUpdated", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleSubscriptionUpdated indicates an expected call of HandleSubscriptionUpdated.
+func (mr *MockSubscriptionServiceMockRecorder) HandleSubscriptionUpdated(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSubscriptionUpdated", reflect.TypeOf((*MockSubscriptionService)(nil).HandleSubscriptionUpdated), ctx, event)
+}
+
+// SubscribeUser mocks base method.
+func (m *MockSubscriptionService) SubscribeUser(ctx context.Context, user *entity.User) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeUser", ctx, user)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeUser indicates an expected call of SubscribeUser.
+func (mr *MockSubscriptionServiceMockRecorder) SubscribeUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeUser", reflect.TypeOf((*MockSubscriptionService)(nil).SubscribeUser), ctx, user)
+}
@@ -19,6 +19,11 @@ import (
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions/storage"
 )
 
+// ErrSubscriptionNotFound is returned by GetUserSubscription when the user has never subscribed.
+var ErrSubscriptionNotFound = storage.ErrSubscriptionNotFound
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+
 //todo:don't return entity from service. convert to domain object
 type SubscriptionService interface {
 	SubscribeUser(ctx context.Context, user *entity.User) (*entity.Subscription, error)
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -11,6 +12,9 @@ import (
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
 )
 
+// ErrSubscriptionNotFound is returned when a user has no subscription.
+var ErrSubscriptionNotFound = errors.New("no subscription found")
+
 type SubscriptionsRepository interface {
 	Insert(ctx context.Context, subscription *entity.Subscription) (*entity.Subscription, error)
 	GetSubscriptionByUserID(ctx context.Context, userID *string) (*entity.Subscription, error)
@@ -80,7 +84,7 @@ func (s *subscriptionsRepository) GetSubscriptionByUserID(ctx context.Context, u
 	}
 
 	if len(subscriptions) == 0 {
-		return nil, fmt.Errorf("no subscription found for user %s", *userID)
+		return nil, fmt.Errorf("%w for user %s", ErrSubscriptionNotFound, *userID)
 	}
 	// Return the first subscription found.
 	return subscriptions[0], nil
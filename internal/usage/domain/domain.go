@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// Limits are token allowances. A limit of 0 allows no tokens.
+type Limits struct {
+	Daily   int
+	Monthly int
+}
+
+// Quota is how much of their allowance a user has spent. Days and months are UTC.
+type Quota struct {
+	// Status is the subscription status the limits come from.
+	Status string
+	Limits
+	DailyUsed      int
+	MonthlyUsed    int
+	DailyResetAt   time.Time
+	MonthlyResetAt time.Time
+}
+
+func (q Quota) DailyRemaining() int {
+	return max(0, q.Daily-q.DailyUsed)
+}
+
+func (q Quota) MonthlyRemaining() int {
+	return max(0, q.Monthly-q.MonthlyUsed)
+}
+
+// Exceeded reports whether the daily or monthly allowance has been used up.
+func (q Quota) Exceeded() bool {
+	return q.DailyRemaining() == 0 || q.MonthlyRemaining() == 0
+}
+
+// ResetAt is when the exhausted allowance starts again.
+func (q Quota) ResetAt() time.Time {
+	if q.MonthlyRemaining() == 0 {
+		return q.MonthlyResetAt
+	}
+
+	return q.DailyResetAt
+}
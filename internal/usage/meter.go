@@ -0,0 +1,90 @@
+package usage
+
+import (
+	"context"
+	"sync"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+)
+
+type meterKey struct{}
+
+// meter adds up the tokens of the completions made for one request.
+type meter struct {
+	mu    sync.Mutex
+	usage openai.Usage
+}
+
+func withMeter(ctx context.Context) (context.Context, *meter) {
+	m := &meter{}
+
+	return context.WithValue(ctx, meterKey{}, m), m
+}
+
+// WithMeter returns a context whose completions are added up apart from those of the request it may come from, and
+// a function returning their usage so far. It meters work that outlives its request, which Enforce cannot record.
+func WithMeter(ctx context.Context) (context.Context, func() openai.Usage) {
+	ctx, m := withMeter(ctx)
+
+	return ctx, m.total
+}
+
+func (m *meter) add(usage openai.Usage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.usage.PromptTokens += usage.PromptTokens
+	m.usage.CompletionTokens += usage.CompletionTokens
+	m.usage.TotalTokens += usage.TotalTokens
+}
+
+func (m *meter) total() openai.Usage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.usage
+}
+
+type meteredClient struct {
+	client openai.Client
+}
+
+// NewMeteredClient returns a Client that adds the usage of every completion to the request being metered, if any.
+// Completions shared with a concurrent identical request are only counted for the request that made them.
+func NewMeteredClient(client openai.Client) openai.Client {
+	return &meteredClient{
+		client: client,
+	}
+}
+
+func (c *meteredClient) CreateChatCompletion(ctx context.Context, request *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+	completion, err := c.client.CreateChatCompletion(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	meterCompletion(ctx, completion)
+
+	return completion, nil
+}
+
+func (c *meteredClient) CreateChatCompletionStream(
+	ctx context.Context,
+	request *openai.OpenAIRequest,
+	onDelta func(delta string) error,
+) (*openai.ChatCompletion, error) {
+	completion, err := c.client.CreateChatCompletionStream(ctx, request, onDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	meterCompletion(ctx, completion)
+
+	return completion, nil
+}
+
+func meterCompletion(ctx context.Context, completion *openai.ChatCompletion) {
+	if m, ok := ctx.Value(meterKey{}).(*meter); ok {
+		m.add(completion.Usage)
+	}
+}
@@ -0,0 +1,91 @@
+package usage
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage/domain"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+// Quota headers, sent with every metered response. They describe the quota before the request.
+const (
+	HeaderDailyLimit       = "X-Quota-Daily-Limit"
+	HeaderDailyRemaining   = "X-Quota-Daily-Remaining"
+	HeaderMonthlyLimit     = "X-Quota-Monthly-Limit"
+	HeaderMonthlyRemaining = "X-Quota-Monthly-Remaining"
+)
+
+// QuotaExceededResponse is the body of a 429 answered to a user without tokens left.
+type QuotaExceededResponse struct {
+	Code    string    `json:"code"`
+	Message string    `json:"message"`
+	Status  string    `json:"subscriptionStatus"`
+	ResetAt time.Time `json:"resetAt"`
+}
+
+// Enforce answers 429 to signed-in users who have used up their daily or monthly quota, and records the
+// tokens used to answer the others under the route pattern. It must run after the auth middleware.
+// Requests are let through when the quota cannot be checked, so that a metering outage does not take the AI
+// endpoints down with it.
+func Enforce(logger *zap.Logger, service Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			userID, err := commonContext.GetUserIDString(ctx)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			quota, err := service.GetQuota(ctx, userID)
+			if err != nil {
+				logger.Error("failed to get token quota", zap.String("userID", userID), zap.Error(err))
+			}
+
+			if quota != nil {
+				setQuotaHeaders(w.Header(), quota)
+
+				if quota.Exceeded() {
+					w.Header().Set("Retry-After", strconv.Itoa(int(time.Until(quota.ResetAt()).Seconds())+1))
+					render.Json(w, http.StatusTooManyRequests, QuotaExceededResponse{
+						Code:    "quota_exceeded",
+						Message: "You have used all of your AI answers for now. Please try again later or upgrade your plan.",
+						Status:  quota.Status,
+						ResetAt: quota.ResetAt(),
+					})
+
+					return
+				}
+			}
+
+			ctx, m := withMeter(ctx)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+
+			endpoint := r.URL.Path
+			if routeContext := chi.RouteContext(ctx); routeContext != nil && routeContext.RoutePattern() != "" {
+				endpoint = routeContext.RoutePattern()
+			}
+
+			err = service.Record(context.WithoutCancel(ctx), userID, endpoint, m.total())
+			if err != nil {
+				logger.Error("failed to record token usage", zap.String("userID", userID), zap.Error(err))
+			}
+		})
+	}
+}
+
+func setQuotaHeaders(header http.Header, quota *domain.Quota) {
+	header.Set(HeaderDailyLimit, strconv.Itoa(quota.Daily))
+	header.Set(HeaderDailyRemaining, strconv.Itoa(quota.DailyRemaining()))
+	header.Set(HeaderMonthlyLimit, strconv.Itoa(quota.Monthly))
+	header.Set(HeaderMonthlyRemaining, strconv.Itoa(quota.MonthlyRemaining()))
+}
@@ -0,0 +1,110 @@
+package usage_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	mockopenai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage/domain"
+	mockusage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage/mock"
+	commonContext "github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/render"
+)
+
+// newRouter serves POST /word/definition through Enforce, answering with one metered completion.
+func newRouter(t *testing.T, service usage.Service, userID string) http.Handler {
+	ctrl := gomock.NewController(t)
+	mockClient := mockopenai.NewMockClient(ctrl)
+	mockClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Return(&openai.ChatCompletion{
+		Usage: openai.Usage{PromptTokens: 30, CompletionTokens: 12, TotalTokens: 42},
+	}, nil).AnyTimes()
+
+	client := usage.NewMeteredClient(mockClient)
+
+	router := chi.NewRouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if userID != "" {
+				r = r.WithContext(commonContext.SetUserIDString(r.Context(), userID))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	})
+	router.With(usage.Enforce(zaptest.NewLogger(t), service)).Post("/word/definition", func(w http.ResponseWriter, r *http.Request) {
+		_, err := client.CreateChatCompletion(r.Context(), &openai.OpenAIRequest{})
+		require.NoError(t, err)
+
+		render.Json(w, http.StatusOK, "A small feline.")
+	})
+
+	return router
+}
+
+func TestEnforceRecordsUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mockusage.NewMockService(ctrl)
+
+	service.EXPECT().GetQuota(gomock.Any(), "user-1").Return(&domain.Quota{
+		Limits:      domain.Limits{Daily: 100, Monthly: 1000},
+		DailyUsed:   40,
+		MonthlyUsed: 400,
+	}, nil)
+	service.EXPECT().Record(gomock.Any(), "user-1", "/word/definition", openai.Usage{
+		PromptTokens:     30,
+		CompletionTokens: 12,
+		TotalTokens:      42,
+	}).Return(nil)
+
+	rec := httptest.NewRecorder()
+	newRouter(t, service, "user-1").ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/word/definition", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "100", rec.Header().Get(usage.HeaderDailyLimit))
+	assert.Equal(t, "60", rec.Header().Get(usage.HeaderDailyRemaining))
+	assert.Equal(t, "1000", rec.Header().Get(usage.HeaderMonthlyLimit))
+	assert.Equal(t, "600", rec.Header().Get(usage.HeaderMonthlyRemaining))
+}
+
+func TestEnforceRejectsExceededQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mockusage.NewMockService(ctrl)
+
+	service.EXPECT().GetQuota(gomock.Any(), "user-1").Return(&domain.Quota{
+		Status:       usage.StatusTrialing,
+		Limits:       domain.Limits{Daily: 100, Monthly: 1000},
+		DailyUsed:    130,
+		MonthlyUsed:  130,
+		DailyResetAt: time.Now().Add(time.Hour),
+	}, nil)
+
+	rec := httptest.NewRecorder()
+	newRouter(t, service, "user-1").ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/word/definition", nil))
+
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "0", rec.Header().Get(usage.HeaderDailyRemaining))
+	assert.Equal(t, "3600", rec.Header().Get("Retry-After"))
+	assert.Contains(t, rec.Body.String(), `"code":"quota_exceeded"`)
+	assert.Contains(t, rec.Body.String(), `"subscriptionStatus":"trialing"`)
+}
+
+func TestEnforceSkipsAnonymousRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mockusage.NewMockService(ctrl)
+
+	rec := httptest.NewRecorder()
+	newRouter(t, service, "").ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/word/definition", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(usage.HeaderDailyLimit))
+}
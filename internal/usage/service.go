@@ -0,0 +1,120 @@
+// Package usage meters the tokens each signed-in user spends on AI completions and enforces
+// daily and monthly quotas that depend on their subscription status.
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/config"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage/domain"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage/storage"
+)
+
+// Subscription statuses with their own quota.
+const (
+	StatusTrialing = "trialing"
+	StatusActive   = "active"
+	StatusPastDue  = "past_due"
+	StatusCanceled = "canceled"
+)
+
+// Quotas are the limits of each subscription status. Any other status, and users without a subscription,
+// get the limits of StatusCanceled.
+type Quotas map[string]domain.Limits
+
+// NewQuotas returns the quotas configured in cfg.
+func NewQuotas(cfg *config.Config) Quotas {
+	return Quotas{
+		StatusTrialing: {Daily: cfg.QuotaTrialingDailyTokens, Monthly: cfg.QuotaTrialingMonthlyTokens},
+		StatusActive:   {Daily: cfg.QuotaActiveDailyTokens, Monthly: cfg.QuotaActiveMonthlyTokens},
+		StatusPastDue:  {Daily: cfg.QuotaPastDueDailyTokens, Monthly: cfg.QuotaPastDueMonthlyTokens},
+		StatusCanceled: {Daily: cfg.QuotaCanceledDailyTokens, Monthly: cfg.QuotaCanceledMonthlyTokens},
+	}
+}
+
+func (q Quotas) limits(status string) domain.Limits {
+	if limits, ok := q[status]; ok {
+		return limits
+	}
+
+	return q[StatusCanceled]
+}
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+type Service interface {
+	// Record adds a request to endpoint and the tokens it used to today's usage of the user.
+	Record(ctx context.Context, userID, endpoint string, usage openai.Usage) error
+	GetQuota(ctx context.Context, userID string) (*domain.Quota, error)
+}
+
+type service struct {
+	logger              *zap.Logger
+	repository          storage.UsageRepository
+	subscriptionService subscriptions.SubscriptionService
+	quotas              Quotas
+}
+
+func NewUsageService(
+	logger *zap.Logger,
+	repository storage.UsageRepository,
+	subscriptionService subscriptions.SubscriptionService,
+	quotas Quotas,
+) Service {
+	return &service{
+		logger:              logger,
+		repository:          repository,
+		subscriptionService: subscriptionService,
+		quotas:              quotas,
+	}
+}
+
+func (s *service) Record(ctx context.Context, userID, endpoint string, usage openai.Usage) error {
+	dayStart, _ := periodStarts(time.Now())
+
+	return s.repository.AddUsage(ctx, userID, endpoint, dayStart, usage.PromptTokens, usage.CompletionTokens, usage.TotalTokens)
+}
+
+func (s *service) GetQuota(ctx context.Context, userID string) (*domain.Quota, error) {
+	var status string
+
+	subscription, err := s.subscriptionService.GetUserSubscription(ctx, &userID)
+
+	switch {
+	case errors.Is(err, subscriptions.ErrSubscriptionNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get the subscription status: %w", err)
+	default:
+		status = subscription.Status
+	}
+
+	dayStart, monthStart := periodStarts(time.Now())
+
+	daily, monthly, err := s.repository.GetTotalTokens(ctx, userID, dayStart, monthStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Quota{
+		Status:         status,
+		Limits:         s.quotas.limits(status),
+		DailyUsed:      daily,
+		MonthlyUsed:    monthly,
+		DailyResetAt:   dayStart.AddDate(0, 0, 1),
+		MonthlyResetAt: monthStart.AddDate(0, 1, 0),
+	}, nil
+}
+
+// periodStarts returns the start of the UTC day and month of now.
+func periodStarts(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
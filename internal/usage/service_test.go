@@ -0,0 +1,100 @@
+package usage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/entity"
+	mocksubscriptions "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/subscriptions/storage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage/domain"
+	mockstorage "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/usage/storage/mock"
+)
+
+var quotas = usage.Quotas{
+	usage.StatusTrialing: {Daily: 100, Monthly: 1000},
+	usage.StatusActive:   {Daily: 1000, Monthly: 10000},
+	usage.StatusCanceled: {Daily: 10, Monthly: 20},
+}
+
+func TestGetQuota(t *testing.T) {
+	const userID = "user-1"
+
+	testCases := []struct {
+		name             string
+		subscription     *entity.Subscription
+		subscriptionErr  error
+		daily, monthly   int
+		expectedLimits   domain.Limits
+		expectedExceeded bool
+		expectedErr      bool
+	}{
+		{
+			name:           "active subscription",
+			subscription:   &entity.Subscription{Status: usage.StatusActive},
+			daily:          500,
+			monthly:        5000,
+			expectedLimits: domain.Limits{Daily: 1000, Monthly: 10000},
+		},
+		{
+			name:             "trial used up for today",
+			subscription:     &entity.Subscription{Status: usage.StatusTrialing},
+			daily:            120,
+			monthly:          120,
+			expectedLimits:   domain.Limits{Daily: 100, Monthly: 1000},
+			expectedExceeded: true,
+		},
+		{
+			name:           "status without its own quota",
+			subscription:   &entity.Subscription{Status: "incomplete"},
+			expectedLimits: domain.Limits{Daily: 10, Monthly: 20},
+		},
+		{
+			name:             "no subscription",
+			subscriptionErr:  storage.ErrSubscriptionNotFound,
+			daily:            5,
+			monthly:          20,
+			expectedLimits:   domain.Limits{Daily: 10, Monthly: 20},
+			expectedExceeded: true,
+		},
+		{
+			name:            "subscription lookup fails",
+			subscriptionErr: errors.New("connection refused"),
+			expectedErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			subscriptionService := mocksubscriptions.NewMockSubscriptionService(ctrl)
+			repository := mockstorage.NewMockUsageRepository(ctrl)
+
+			subscriptionService.EXPECT().GetUserSubscription(gomock.Any(), gomock.Any()).Return(tc.subscription, tc.subscriptionErr)
+
+			if !tc.expectedErr {
+				repository.EXPECT().GetTotalTokens(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return(tc.daily, tc.monthly, nil)
+			}
+
+			service := usage.NewUsageService(zaptest.NewLogger(t), repository, subscriptionService, quotas)
+
+			quota, err := service.GetQuota(context.Background(), userID)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLimits, quota.Limits)
+			assert.Equal(t, tc.expectedExceeded, quota.Exceeded())
+			assert.False(t, quota.DailyResetAt.After(quota.MonthlyResetAt))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=mock/repository.go
+//
+
+// Package mock_storage is a generated GoMock package.
+package mock_storage
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsageRepository is a mock of UsageRepository interface.
+type MockUsageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageRepositoryMockRecorder
+}
+
+// MockUsageRepositoryMockRecorder is the mock recorder for MockUsageRepository.
+type MockUsageRepositoryMockRecorder struct {
+	mock *MockUsageRepository
+}
+
+// NewMockUsageRepository creates a new mock instance.
+func NewMockUsageRepository(ctrl *gomock.Controller) *MockUsageRepository {
+	mock := &MockUsageRepository{ctrl: ctrl}
+	mock.recorder = &MockUsageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageRepository) EXPECT() *MockUsageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddUsage mocks base method.
+func (m *MockUsageRepository) AddUsage(ctx context.Context, userID, endpoint string, day time.Time, promptTokens, completionTokens, totalTokens int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUsage", ctx, userID, endpoint, day, promptTokens, completionTokens, totalTokens)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUsage indicates an expected call of AddUsage.
+func (mr *MockUsageRepositoryMockRecorder) AddUsage(ctx, userID, endpoint, day, promptTokens, completionTokens, totalTokens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUsage", reflect.TypeOf((*MockUsageRepository)(nil).AddUsage), ctx, userID, endpoint, day, promptTokens, completionTokens, totalTokens)
+}
+
+// GetTotalTokens mocks base method.
+func (m *MockUsageRepository) GetTotalTokens(ctx context.Context, userID string, dayStart, monthStart time.Time) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalTokens", ctx, userID, dayStart, monthStart)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTotalTokens indicates an expected call of GetTotalTokens.
+func (mr *MockUsageRepositoryMockRecorder) GetTotalTokens(ctx, userID, dayStart, monthStart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalTokens", reflect.TypeOf((*MockUsageRepository)(nil).GetTotalTokens), ctx, userID, dayStart, monthStart)
+}
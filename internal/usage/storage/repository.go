@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+//go:generate mockgen -source=repository.go -destination=mock/repository.go
+type UsageRepository interface {
+	// AddUsage adds one request and its tokens to the usage of the user for endpoint on day.
+	AddUsage(ctx context.Context, userID, endpoint string, day time.Time, promptTokens, completionTokens, totalTokens int) error
+	// GetTotalTokens returns the tokens the user spent since dayStart and since monthStart.
+	GetTotalTokens(ctx context.Context, userID string, dayStart, monthStart time.Time) (daily int, monthly int, err error)
+}
+
+type usageRepository struct {
+	db *sqlx.DB
+}
+
+func NewUsageRepository(db *sqlx.DB) UsageRepository {
+	return &usageRepository{
+		db: db,
+	}
+}
+
+const addUsageQuery = `
+INSERT INTO token_usages (user_id, endpoint, day, requests, prompt_tokens, completion_tokens, total_tokens)
+VALUES ($1, $2, $3, 1, $4, $5, $6)
+ON CONFLICT (user_id, endpoint, day) DO UPDATE
+SET requests          = token_usages.requests + 1,
+    prompt_tokens     = token_usages.prompt_tokens + EXCLUDED.prompt_tokens,
+    completion_tokens = token_usages.completion_tokens + EXCLUDED.completion_tokens,
+    total_tokens      = token_usages.total_tokens + EXCLUDED.total_tokens,
+    updated_at        = now()`
+
+func (r *usageRepository) AddUsage(
+	ctx context.Context,
+	userID, endpoint string,
+	day time.Time,
+	promptTokens, completionTokens, totalTokens int,
+) error {
+	_, err := queries.Raw(addUsageQuery, userID, endpoint, day, promptTokens, completionTokens, totalTokens).ExecContext(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("failed to add token usage for user %s: %w", userID, err)
+	}
+
+	return nil
+}
+
+const totalTokensQuery = `
+SELECT COALESCE(SUM(total_tokens) FILTER (WHERE day >= $2), 0) AS daily,
+       COALESCE(SUM(total_tokens), 0)                          AS monthly
+FROM token_usages
+WHERE user_id = $1 AND day >= $3`
+
+func (r *usageRepository) GetTotalTokens(ctx context.Context, userID string, dayStart, monthStart time.Time) (int, int, error) {
+	var totals struct {
+		Daily   int `boil:"daily"`
+		Monthly int `boil:"monthly"`
+	}
+
+	err := queries.Raw(totalTokensQuery, userID, dayStart, monthStart).Bind(ctx, r.db, &totals)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get token usage for user %s: %w", userID, err)
+	}
+
+	return totals.Daily, totals.Monthly, nil
+}
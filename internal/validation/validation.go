@@ -0,0 +1,33 @@
+// Package validation holds the errors services return for user input they refuse. Every error has a code,
+// which clients can rely on and the api layer translates into the user's language.
+package validation
+
+// Codes of the errors.
+const (
+	CodeInvalidWordRequest     = "invalid_word_request"
+	CodeInvalidSentenceRequest = "invalid_sentence_request"
+	CodeUnsupportedLanguage    = "unsupported_language"
+	CodeEmptyWord              = "empty_word"
+	CodeWordContainsDigits     = "word_contains_digits"
+	CodeWordTooLong            = "word_too_long"
+	CodeLooksLikePhrase        = "looks_like_phrase"
+	CodeNonsensicalWord        = "nonsensical_word"
+	CodeEmptySentence          = "empty_sentence"
+	CodeSentenceTooLong        = "sentence_too_long"
+	CodeInvalidLevel           = "invalid_level"
+)
+
+// Error is an input a service refused. Errors are compared by identity, so declare each one once as a sentinel.
+type Error struct {
+	Code string
+	// message is the English message shown to the user.
+	message string
+}
+
+func New(code, message string) *Error {
+	return &Error{Code: code, message: message}
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
@@ -1,7 +1,103 @@
 package domain
 
+import (
+	"maps"
+	"slices"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+)
+
+// Sections of a lookup.
+const (
+	SectionDefinition = "definition"
+	SectionSynonyms   = "synonyms"
+	SectionHistory    = "history"
+	SectionDifficulty = "difficulty"
+)
+
+// LookupDetails holds the sections of a lookup. A section that could not be generated is left empty
+// and the reason is stored in SectionErrors under its name.
 type LookupDetails struct {
-	Definition string
-	Synonyms   string
-	History    string
+	Definition    *Definition
+	Synonyms      []Synonym
+	History       *Etymology
+	Difficulty    *difficulty.Assessment
+	SectionErrors map[string]error
+	// CacheHits reports, per section, whether it was served from the cache.
+	CacheHits map[string]bool
+}
+
+// Clone returns a deep copy of d, which the caller may change without affecting other holders of d.
+func (d *LookupDetails) Clone() *LookupDetails {
+	clone := &LookupDetails{
+		Synonyms: slices.Clone(d.Synonyms),
+	}
+
+	if d.Definition != nil {
+		definition := *d.Definition
+		definition.Readings = slices.Clone(definition.Readings)
+		definition.Senses = slices.Clone(definition.Senses)
+		definition.Examples = slices.Clone(definition.Examples)
+		clone.Definition = &definition
+	}
+
+	if d.History != nil {
+		history := *d.History
+		clone.History = &history
+	}
+
+	if d.Difficulty != nil {
+		assessment := *d.Difficulty
+		clone.Difficulty = &assessment
+	}
+
+	if d.SectionErrors != nil {
+		clone.SectionErrors = maps.Clone(d.SectionErrors)
+	}
+
+	if d.CacheHits != nil {
+		clone.CacheHits = maps.Clone(d.CacheHits)
+	}
+
+	return clone
+}
+
+type Definition struct {
+	PartOfSpeech string
+	// Readings holds how the word is read in the writing systems that need it, e.g. furigana for Japanese or pinyin for Chinese.
+	Readings []Reading
+	IPA      string
+	Senses   []Sense
+	Examples []Example
+}
+
+type Reading struct {
+	// System names the reading, e.g. "furigana", "pinyin" or "romanization".
+	System string
+	Value  string
+}
+
+type Sense struct {
+	Gloss string
+	// Notes covers register and usage, e.g. "formal" or "mostly used in writing".
+	Notes string
+}
+
+type Example struct {
+	Sentence    string
+	Reading     string
+	Translation string
+}
+
+type Synonym struct {
+	Word    string
+	Reading string
+	// Nuance explains how the synonym differs from the looked up word.
+	Nuance string
+}
+
+type Etymology struct {
+	// Origin is the language or root the word comes from.
+	Origin      string
+	Explanation string
 }
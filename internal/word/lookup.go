@@ -0,0 +1,481 @@
+package word
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/canonical"
+	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
+)
+
+// Supported values for the LOOKUP_MODE setting.
+const (
+	// LookupModeSingle asks for every section in one structured completion and only falls back to
+	// one completion per section for the sections it could not get.
+	LookupModeSingle = "single"
+	// LookupModeFanOut always sends one completion per section in parallel.
+	LookupModeFanOut = "fanout"
+)
+
+type Details struct {
+	kind       string
+	prompt     *prompt.Prompt
+	request    *openai.OpenAIRequest
+	completion *openai.ChatCompletion
+	// target receives the parsed structured completion.
+	target any
+	done   bool
+	cached bool
+	err    error
+}
+
+type lookupPayload struct {
+	Definition json.RawMessage `json:"definition"`
+	Synonyms   json.RawMessage `json:"synonyms"`
+	History    json.RawMessage `json:"history"`
+	Difficulty json.RawMessage `json:"difficulty"`
+}
+
+// Lookup returns every section it managed to generate. A section that failed is reported in
+// domain.LookupDetails.SectionErrors, and an error is only returned when all of them failed.
+//
+// Sections are read from the content store first, and only the missing ones are requested from OpenAI.
+// Concurrent lookups of the same word share one lookup, and each gets its own copy of the result. The completions
+// of a shared lookup are metered for the request that made them only: the others are recorded as served from the
+// cache by the experiments and use none of their tokens.
+func (s *service) Lookup(ctx context.Context, word, nativeLanguage string) (*domain.LookupDetails, error) {
+	combined, err := s.lookupDetails(ctx, "lookup", prompt.WordLookup, lookupJSONSchema, word, nativeLanguage, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := []struct {
+		kind     string
+		template string
+		schema   json.RawMessage
+		target   any
+	}{
+		{kind: domain.SectionDefinition, template: prompt.WordLookupDefinition, schema: definitionJSONSchema, target: &definitionPayload{}},
+		{kind: domain.SectionSynonyms, template: prompt.WordLookupSynonyms, schema: synonymsJSONSchema, target: &synonymsPayload{}},
+		{kind: domain.SectionHistory, template: prompt.WordLookupHistory, schema: etymologyJSONSchema, target: &etymologyPayload{}},
+		{kind: domain.SectionDifficulty, template: prompt.WordLookupDifficulty, schema: difficulty.JSONSchema, target: &difficulty.Payload{}},
+	}
+
+	items := make([]*Details, 0, len(sections))
+
+	// Lookups rendered from different prompts, e.g. different experiment variants, must not be shared.
+	flight := lookupContentKey(word, nativeLanguage, combined.prompt).String()
+
+	for _, section := range sections {
+		d, err := s.lookupDetails(ctx, section.kind, section.template, section.schema, word, nativeLanguage, section.target)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, d)
+		flight += "|" + d.prompt.Revision()
+	}
+
+	leader := false
+
+	results := s.inFlight.DoChan(flight, func() (any, error) {
+		leader = true
+
+		return s.lookup(context.WithoutCancel(ctx), word, nativeLanguage, combined, items)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		details := result.Val.(*domain.LookupDetails)
+
+		if !leader {
+			for _, d := range items {
+				if _, failed := details.SectionErrors[d.kind]; !failed {
+					experiment.Observe(ctx, d.prompt, openai.Usage{})
+				}
+			}
+		}
+
+		return details.Clone(), nil
+	}
+}
+
+func (s *service) GetWordDifficulty(ctx context.Context, word string, nativeLanguage string) (*difficulty.Assessment, error) {
+	var payload difficulty.Payload
+
+	err := s.section(ctx, domain.SectionDifficulty, prompt.WordLookupDifficulty, difficulty.JSONSchema, word, nativeLanguage, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload.ToDomain(), nil
+}
+
+// section gets one section of the lookup of word, parsed into target, from the entry it shares with Lookup or from
+// its own completion.
+func (s *service) section(
+	ctx context.Context,
+	kind, template string,
+	schema json.RawMessage,
+	word, nativeLanguage string,
+	target any,
+) error {
+	d, err := s.lookupDetails(ctx, kind, template, schema, word, nativeLanguage, target)
+	if err != nil {
+		return err
+	}
+
+	var usage openai.Usage
+
+	result, err := s.store.GetOrGenerate(ctx, contentKey(d.kind, word, nativeLanguage, d.prompt), func(ctx context.Context) (string, error) {
+		completion, err := s.openAiClient.CreateChatCompletion(ctx, d.request)
+		if err != nil {
+			return "", fmt.Errorf("failed to make open ai request: %w", err)
+		}
+
+		s.logger.Info("Successfully got word "+d.kind,
+			zap.String("word", word),
+			zap.String("nativeLanguage", nativeLanguage),
+			zap.String("prompt", d.prompt.ID),
+			zap.String("promptVersion", d.prompt.Version),
+			zap.Int("promptTokens", completion.Usage.PromptTokens),
+			zap.Int("completionTokens", completion.Usage.CompletionTokens),
+			zap.Int("totalTokens", completion.Usage.TotalTokens),
+		)
+
+		err = openai.ParseStructured(completion.Content(), target)
+		if err != nil {
+			return "", err
+		}
+
+		usage = completion.Usage
+
+		raw, err := json.Marshal(target)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal word %s: %w", d.kind, err)
+		}
+
+		return string(raw), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = openai.ParseStructured(*result, target)
+	if err != nil {
+		return err
+	}
+
+	experiment.Observe(ctx, d.prompt, usage)
+
+	return nil
+}
+
+// lookup looks up the sections of items. combined is the request for all of them at once.
+func (s *service) lookup(ctx context.Context, word, nativeLanguage string, combined *Details, items []*Details) (*domain.LookupDetails, error) {
+	missing := s.lookupFromStore(ctx, word, nativeLanguage, combined, items)
+
+	if missing == len(items) && s.lookupMode != LookupModeFanOut {
+		s.lookupSingle(ctx, word, nativeLanguage, combined, items)
+	}
+
+	if missing > 0 {
+		s.lookupFanOut(ctx, items)
+		s.storeLookup(ctx, word, nativeLanguage, combined, items)
+	}
+
+	s.observeLookup(ctx, combined, items)
+
+	result := domain.LookupDetails{
+		CacheHits: make(map[string]bool, len(items)),
+	}
+
+	var errs []error
+
+	for _, d := range items {
+		result.CacheHits[d.kind] = d.cached
+
+		if d.err != nil {
+			if result.SectionErrors == nil {
+				result.SectionErrors = map[string]error{}
+			}
+
+			result.SectionErrors[d.kind] = d.err
+			errs = append(errs, fmt.Errorf("kind=%s: %w", d.kind, d.err))
+
+			continue
+		}
+
+		switch target := d.target.(type) {
+		case *definitionPayload:
+			result.Definition = target.toDomain()
+		case *synonymsPayload:
+			result.Synonyms = target.toDomain()
+		case *etymologyPayload:
+			result.History = target.toDomain()
+		case *difficulty.Payload:
+			result.Difficulty = target.ToDomain()
+		}
+	}
+
+	if len(errs) == len(items) {
+		return nil, fmt.Errorf("every lookup section failed: %w", errors.Join(errs...))
+	}
+
+	return &result, nil
+}
+
+// lookupFromStore fills the sections found in the combined lookup entry or in their own section entry,
+// and returns how many sections are still missing.
+func (s *service) lookupFromStore(ctx context.Context, word, nativeLanguage string, combined *Details, items []*Details) int {
+	var sections map[string]json.RawMessage
+
+	stored, err := s.store.Get(ctx, lookupContentKey(word, nativeLanguage, combined.prompt))
+	if err == nil {
+		var payload lookupPayload
+
+		err = json.Unmarshal([]byte(*stored), &payload)
+		if err == nil {
+			sections = payload.sections()
+		}
+	}
+
+	missing := 0
+
+	for _, d := range items {
+		raw, ok := sections[d.kind]
+		if !ok {
+			stored, err = s.store.Get(ctx, contentKey(d.kind, word, nativeLanguage, d.prompt))
+			if ok = err == nil; ok {
+				raw = json.RawMessage(*stored)
+			}
+		}
+
+		if ok && openai.ParseStructured(string(raw), d.target) == nil {
+			d.done = true
+			d.cached = true
+
+			continue
+		}
+
+		missing++
+	}
+
+	return missing
+}
+
+// storeLookup stores every generated section, and the combined lookup entry once all sections are known.
+func (s *service) storeLookup(ctx context.Context, word, nativeLanguage string, combined *Details, items []*Details) {
+	sections := make(map[string]json.RawMessage, len(items))
+
+	for _, d := range items {
+		if !d.done {
+			continue
+		}
+
+		raw, err := json.Marshal(d.target)
+		if err != nil {
+			s.logger.Warn("failed to marshal lookup section", zap.String("kind", d.kind), zap.Error(err))
+			continue
+		}
+
+		sections[d.kind] = raw
+
+		if d.cached {
+			continue
+		}
+
+		err = s.store.Set(ctx, contentKey(d.kind, word, nativeLanguage, d.prompt), string(raw))
+		if err != nil {
+			s.logger.Warn("lookup section store set failed", zap.String("kind", d.kind), zap.Error(err))
+		}
+	}
+
+	if len(sections) != len(items) {
+		return
+	}
+
+	payload, err := json.Marshal(lookupPayload{
+		Definition: sections[domain.SectionDefinition],
+		Synonyms:   sections[domain.SectionSynonyms],
+		History:    sections[domain.SectionHistory],
+		Difficulty: sections[domain.SectionDifficulty],
+	})
+	if err != nil {
+		s.logger.Warn("failed to marshal lookup", zap.Error(err))
+		return
+	}
+
+	err = s.store.Set(ctx, lookupContentKey(word, nativeLanguage, combined.prompt), string(payload))
+	if err != nil {
+		s.logger.Warn("lookup store set failed", zap.Error(err))
+	}
+}
+
+func (p lookupPayload) sections() map[string]json.RawMessage {
+	return map[string]json.RawMessage{
+		domain.SectionDefinition: p.Definition,
+		domain.SectionSynonyms:   p.Synonyms,
+		domain.SectionHistory:    p.History,
+		domain.SectionDifficulty: p.Difficulty,
+	}
+}
+
+func lookupContentKey(word, nativeLanguage string, p *prompt.Prompt) content.Key {
+	return content.Key{
+		Kind:          "word_lookup",
+		Input:         canonical.Word(word),
+		Language:      canonical.Language(nativeLanguage),
+		PromptVersion: p.Revision(),
+		Model:         p.Model,
+	}
+}
+
+// lookupSingle requests every section in one completion and marks the sections it could parse as done.
+func (s *service) lookupSingle(ctx context.Context, word, nativeLanguage string, combined *Details, items []*Details) {
+	completion, err := s.openAiClient.CreateChatCompletion(ctx, combined.request)
+	if err != nil {
+		s.logger.Warn("single call lookup failed, falling back to one request per section",
+			zap.String("word", word),
+			zap.Error(err))
+
+		return
+	}
+
+	combined.completion = completion
+
+	s.logger.Info("Successfully got word lookup",
+		zap.String("word", word),
+		zap.String("nativeLanguage", nativeLanguage),
+		zap.String("prompt", combined.prompt.ID),
+		zap.String("promptVersion", combined.prompt.Version),
+		zap.Int("promptTokens", completion.Usage.PromptTokens),
+		zap.Int("completionTokens", completion.Usage.CompletionTokens),
+		zap.Int("totalTokens", completion.Usage.TotalTokens),
+	)
+
+	var payload lookupPayload
+
+	err = openai.ParseStructured(completion.Content(), &payload)
+	if err != nil {
+		s.logger.Warn("failed to parse single call lookup, falling back to one request per section",
+			zap.String("word", word),
+			zap.String("content", completion.Content()),
+			zap.Error(err))
+
+		return
+	}
+
+	sections := payload.sections()
+
+	for _, d := range items {
+		err = openai.ParseStructured(string(sections[d.kind]), d.target)
+		if err != nil {
+			s.logger.Warn("invalid section in single call lookup", zap.String("kind", d.kind), zap.Error(err))
+			continue
+		}
+
+		d.done = true
+	}
+}
+
+// lookupFanOut requests every section that is not done yet in parallel. A failing section does not cancel the others.
+func (s *service) lookupFanOut(ctx context.Context, items []*Details) {
+	var g errgroup.Group
+
+	for i := range items {
+		d := items[i] // capture pointer
+		if d.done {
+			continue
+		}
+
+		g.Go(func() error {
+			completion, reqErr := s.openAiClient.CreateChatCompletion(ctx, d.request)
+			if reqErr != nil {
+				s.logger.Error("failed to make openai request",
+					zap.String("kind", d.kind),
+					zap.String("prompt", d.prompt.ID),
+					zap.String("promptVersion", d.prompt.Version),
+					zap.Error(reqErr))
+				d.err = reqErr
+
+				return nil
+			}
+
+			d.completion = completion
+
+			parseErr := openai.ParseStructured(completion.Content(), d.target)
+			if parseErr != nil {
+				s.logger.Error("failed to parse structured openai response",
+					zap.String("kind", d.kind),
+					zap.String("prompt", d.prompt.ID),
+					zap.String("promptVersion", d.prompt.Version),
+					zap.String("content", completion.Content()),
+					zap.Error(parseErr))
+				d.err = parseErr
+
+				return nil
+			}
+
+			d.done = true
+
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+}
+
+// observeLookup notes the prompts that served the lookup for experiments: the combined prompt when
+// it was used, and each section prompt whose section came from its own completion or from the store.
+func (s *service) observeLookup(ctx context.Context, combined *Details, items []*Details) {
+	if combined.completion != nil {
+		experiment.Observe(ctx, combined.prompt, combined.completion.Usage)
+	}
+
+	for _, d := range items {
+		switch {
+		case d.err != nil:
+		case d.completion != nil:
+			experiment.Observe(ctx, d.prompt, d.completion.Usage)
+		case d.cached:
+			experiment.Observe(ctx, d.prompt, openai.Usage{})
+		}
+	}
+}
+
+// lookupDetails renders the lookup prompt called template and asks for a completion matching schema,
+// which is parsed into target.
+func (s *service) lookupDetails(
+	ctx context.Context,
+	kind, template string,
+	schema json.RawMessage,
+	word, nativeLanguage string,
+	target any,
+) (*Details, error) {
+	p, err := s.wordPrompt(ctx, template, word, nativeLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	request := p.Request()
+	request.ResponseFormat = openai.NewJSONSchemaFormat("word_"+kind, schema)
+
+	return &Details{kind: kind, prompt: p, request: request, target: target}, nil
+}
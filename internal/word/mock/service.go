@@ -15,6 +15,7 @@ import (
 
 	gomock "go.uber.org/mock/gomock"
 
+	difficulty "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
 	domain "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
 )
 
@@ -56,6 +57,21 @@ func (mr *MockServiceMockRecorder) GetWordDefinition(ctx, word, nativeLanguage a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWordDefinition", reflect.TypeOf((*MockService)(nil).GetWordDefinition), ctx, word, nativeLanguage)
 }
 
+// GetWordDifficulty mocks base method.
+func (m *MockService) GetWordDifficulty(ctx context.Context, word, nativeLanguage string) (*difficulty.Assessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWordDifficulty", ctx, word, nativeLanguage)
+	ret0, _ := ret[0].(*difficulty.Assessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWordDifficulty indicates an expected call of GetWordDifficulty.
+func (mr *MockServiceMockRecorder) GetWordDifficulty(ctx, word, nativeLanguage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWordDifficulty", reflect.TypeOf((*MockService)(nil).GetWordDifficulty), ctx, word, nativeLanguage)
+}
+
 // GetWordHistory mocks base method.
 func (m *MockService) GetWordHistory(ctx context.Context, word, nativeLanguage string) (*string, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +117,21 @@ func (mr *MockServiceMockRecorder) Lookup(ctx, word, nativeLanguage any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockService)(nil).Lookup), ctx, word, nativeLanguage)
 }
 
+// StreamWordDefinition mocks base method.
+func (m *MockService) StreamWordDefinition(ctx context.Context, word, nativeLanguage string, onDelta func(string) error) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamWordDefinition", ctx, word, nativeLanguage, onDelta)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWordDefinition indicates an expected call of StreamWordDefinition.
+func (mr *MockServiceMockRecorder) StreamWordDefinition(ctx, word, nativeLanguage, onDelta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWordDefinition", reflect.TypeOf((*MockService)(nil).StreamWordDefinition), ctx, word, nativeLanguage, onDelta)
+}
+
 // ValidateWord mocks base method.
 func (m *MockService) ValidateWord(word string) error {
 	m.ctrl.T.Helper()
@@ -1,27 +1,36 @@
 package word
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
-	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/request"
-	"golang.org/x/sync/errgroup"
-	"io"
-	"net/http"
 	"strings"
-	"time"
 	"unicode"
 	"unicode/utf8"
 
-	"github.com/coocood/freecache"
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/canonical"
 	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
-	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/experiment"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/languages"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/utils"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/validation"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
+)
+
+// Errors returned by ValidateWord.
+var (
+	ErrEmptyWord          = validation.New(validation.CodeEmptyWord, "Please provide a word.")
+	ErrWordContainsDigits = validation.New(validation.CodeWordContainsDigits, "Words should not contain numbers.")
+	ErrWordTooLong        = validation.New(validation.CodeWordTooLong,
+		"This word is too long. It must be less than 30 characters. If this is a sentence, please use the Analyzer.")
+	ErrLooksLikePhrase = validation.New(validation.CodeLooksLikePhrase, "This looks like a phrase. Please use the Analyzer.")
+	ErrNonsensicalWord = validation.New(validation.CodeNonsensicalWord, "This doesn't look like a word. Please provide a valid word.")
 )
 
 //go:generate mockgen -source=service.go -destination=mock/service.go
@@ -31,316 +40,210 @@ type Service interface {
 	ValidateWord(word string) error
 	GetWordHistory(ctx context.Context, word string, nativeLanguage string) (*string, error)
 	Lookup(ctx context.Context, word string, nativeLanguage string) (*domain.LookupDetails, error)
+	// GetWordDifficulty assesses how hard word is for learners. It shares its cache entry with the difficulty section of Lookup.
+	GetWordDifficulty(ctx context.Context, word string, nativeLanguage string) (*difficulty.Assessment, error)
+	// StreamWordDefinition behaves like GetWordDefinition but calls onDelta with the definition as it is generated.
+	StreamWordDefinition(ctx context.Context, word string, nativeLanguage string, onDelta func(delta string) error) (*string, error)
 }
 
 type service struct {
 	logger       *zap.Logger
 	openAiClient openai.Client
-	cache        *freecache.Cache
+	store        content.Store
+	prompts      prompt.Registry
+	lookupMode   string
+	inFlight     singleflight.Group
 }
 
+// NewWordService returns the word Service. lookupMode is LookupModeSingle or LookupModeFanOut.
 func NewWordService(
 	logger *zap.Logger,
 	openAiClient openai.Client,
-	cache *freecache.Cache,
+	store content.Store,
+	prompts prompt.Registry,
+	lookupMode string,
 ) Service {
 	return &service{
 		logger:       logger,
 		openAiClient: openAiClient,
-		cache:        cache,
+		store:        store,
+		prompts:      prompts,
+		lookupMode:   lookupMode,
 	}
 }
 
-var wordCacheExpiration = int(time.Hour * 24 * 90) // 90 days
-
-type Details struct {
-	kind         string
-	requestBody  io.Reader
-	response     *http.Response
-	responseBody []byte
-	err          error
-}
+func (s *service) GetWordHistory(ctx context.Context, word string, nativeLanguage string) (*string, error) {
+	var payload etymologyPayload
 
-func (s *service) Lookup(ctx context.Context, word, nativeLanguage string) (*domain.LookupDetails, error) {
-	// 1) Build payloads sequentially (no races)
-	defBody, err := s.wordToOpenAiDefinitionRequestBody(word, nativeLanguage)
-	if err != nil {
-		return nil, fmt.Errorf("marshal word definition openai request: %w", err)
-	}
-	synBody, err := s.wordToOpenAiSynonymsRequestBody(word, nativeLanguage)
+	err := s.section(ctx, domain.SectionHistory, prompt.WordLookupHistory, etymologyJSONSchema, word, nativeLanguage, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("marshal word synonyms openai request: %w", err)
-	}
-	histBody, err := s.wordToOpenAiHistoryRequestBody(word, nativeLanguage)
-	if err != nil {
-		return nil, fmt.Errorf("marshal word history openai request: %w", err)
-	}
-
-	items := []*Details{
-		{kind: "definition", requestBody: defBody},
-		{kind: "synonyms", requestBody: synBody},
-		{kind: "history", requestBody: histBody},
+		return nil, err
 	}
 
-	// 2) Fire requests in parallel with errgroup (ctx-aware)
-	g, ctx := errgroup.WithContext(ctx)
-	for i := range items {
-		d := items[i] // capture pointer
-		g.Go(func() error {
-			resp, body, reqErr := s.openAiClient.MakeRequest(ctx, d.requestBody)
-			d.response, d.responseBody, d.err = resp, body, reqErr
-			if reqErr != nil {
-				s.logger.Error("failed to make openai request", zap.String("kind", d.kind), zap.Error(reqErr))
-				return reqErr
-			}
-			if resp.StatusCode != http.StatusOK {
-				return fmt.Errorf("openai non-OK: kind=%s status=%d body=%s", d.kind, resp.StatusCode, string(body))
-			}
-			return nil
-		})
-	}
-	err = g.Wait()
-	if err != nil {
-		return nil, fmt.Errorf("one or more OpenAI requests failed: %w", err)
-	}
-
-	// 3) Unmarshal each response and assemble result
-	var result domain.LookupDetails
-	for _, d := range items {
-		var comp openai.ChatCompletion
-		err = json.Unmarshal(d.responseBody, &comp)
-		if err != nil {
-			return nil, fmt.Errorf("failed to unmarshal %s response: %w", d.kind, err)
-		}
-		if len(comp.Choices) == 0 {
-			return nil, openaierrors.ErrNoChoicesFound
-		}
-		content := comp.Choices[0].Message.Content
-		switch d.kind {
-		case "definition":
-			result.Definition = content
-		case "synonyms":
-			result.Synonyms = content
-		case "history":
-			result.History = content
-		}
-	}
+	result := etymologyText(payload.toDomain())
 
 	return &result, nil
 }
-func (s *service) GetWordHistory(ctx context.Context, word string, nativeLanguage string) (*string, error) {
-	cacheKey := []byte(fmt.Sprintf("%s word history in %s", word, nativeLanguage))
 
-	cached, err := s.cache.Get(cacheKey)
-	if err == nil {
-		cachedResponse := string(cached)
-		return &cachedResponse, nil
-	}
+func (s *service) GetWordSynonyms(ctx context.Context, word string, nativeLanguage string) (*string, error) {
+	var payload synonymsPayload
 
-	jsonEncodedBody, err := s.wordToOpenAiHistoryRequestBody(word, nativeLanguage)
+	err := s.section(ctx, domain.SectionSynonyms, prompt.WordLookupSynonyms, synonymsJSONSchema, word, nativeLanguage, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal openai request: %w", err)
+		return nil, err
 	}
 
-	resp, responseBody, err := s.openAiClient.MakeRequest(ctx, jsonEncodedBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make open ai request: %w", err)
-	}
+	result := synonymsText(payload.toDomain())
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("openai API returned non-OK status responseBody=%v statusCode=%v", resp, resp.StatusCode)
-	}
+	return &result, nil
+}
 
-	var OpenAIApiResponse openai.ChatCompletion
+func (s *service) GetWordDefinition(ctx context.Context, word string, nativeLanguage string) (*string, error) {
+	var payload definitionPayload
 
-	err = json.Unmarshal(responseBody, &OpenAIApiResponse)
+	err := s.section(ctx, domain.SectionDefinition, prompt.WordLookupDefinition, definitionJSONSchema, word, nativeLanguage, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarhsal json body: %w", err)
+		return nil, err
 	}
 
-	if len(OpenAIApiResponse.Choices) == 0 {
-		return nil, openaierrors.ErrNoChoicesFound
-	}
+	result := definitionText(word, payload.toDomain())
 
-	s.logger.Info("Successfully got word history",
-		zap.String("word", word),
-		zap.String("nativeLanguage", nativeLanguage),
-		zap.Int("promptTokens", OpenAIApiResponse.Usage.PromptTokens),
-		zap.Int("completionTokens", OpenAIApiResponse.Usage.CompletionTokens),
-		zap.Int("totalTokens", OpenAIApiResponse.Usage.TotalTokens),
-	)
+	return &result, nil
+}
 
-	cacheValue := []byte(OpenAIApiResponse.Choices[0].Message.Content)
+func (s *service) StreamWordDefinition(
+	ctx context.Context,
+	word string,
+	nativeLanguage string,
+	onDelta func(delta string) error,
+) (*string, error) {
+	var payload definitionPayload
 
-	err = s.cache.Set(cacheKey, cacheValue, wordCacheExpiration)
+	d, err := s.lookupDetails(ctx, domain.SectionDefinition, prompt.WordLookupDefinition, definitionJSONSchema, word, nativeLanguage, &payload)
 	if err != nil {
-		s.logger.Warn("word history cache set failed", zap.Error(err))
+		return nil, err
 	}
 
-	return &OpenAIApiResponse.Choices[0].Message.Content, nil
-}
+	key := contentKey(d.kind, word, nativeLanguage, d.prompt)
 
-func (s *service) GetWordSynonyms(ctx context.Context, word string, nativeLanguage string) (*string, error) {
-	cacheKey := []byte(fmt.Sprintf("%s word synonyms in %s", word, nativeLanguage))
+	stored, err := s.store.Get(ctx, key)
+	if err == nil && openai.ParseStructured(*stored, &payload) == nil {
+		result := definitionText(word, payload.toDomain())
 
-	cached, err := s.cache.Get(cacheKey)
-	if err == nil {
-		cachedResponse := string(cached)
-		return &cachedResponse, nil
-	}
+		err = onDelta(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to relay cached word definition: %w", err)
+		}
 
-	jsonEncodedBody, err := s.wordToOpenAiSynonymsRequestBody(word, nativeLanguage)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal openai request: %w", err)
-	}
+		experiment.Observe(ctx, d.prompt, openai.Usage{})
 
-	resp, responseBody, err := s.openAiClient.MakeRequest(ctx, jsonEncodedBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make open ai request: %w", err)
+		return &result, nil
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("openai API returned non-OK status response=%v statusCode=%v", resp, resp.StatusCode)
-	}
+	payload = definitionPayload{}
 
-	var OpenAIApiResponse openai.ChatCompletion
+	// The section is streamed as JSON, and relayed as the text GetWordDefinition answers with as it completes.
+	relay := &definitionRelay{word: word}
 
-	err = json.Unmarshal(responseBody, &OpenAIApiResponse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarhsal json body: %w", err)
-	}
+	completion, err := s.openAiClient.CreateChatCompletionStream(ctx, d.request, func(delta string) error {
+		text := relay.write(delta)
+		if text == "" {
+			return nil
+		}
 
-	if len(OpenAIApiResponse.Choices) == 0 {
-		return nil, openaierrors.ErrNoChoicesFound
+		return onDelta(text)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream open ai request: %w", err)
 	}
 
-	s.logger.Info("Successfully got word synonyms",
+	s.logger.Info("Successfully streamed word definition.",
 		zap.String("word", word),
 		zap.String("nativeLanguage", nativeLanguage),
-		zap.Int("promptTokens", OpenAIApiResponse.Usage.PromptTokens),
-		zap.Int("completionTokens", OpenAIApiResponse.Usage.CompletionTokens),
-		zap.Int("totalTokens", OpenAIApiResponse.Usage.TotalTokens),
+		zap.String("prompt", d.prompt.ID),
+		zap.String("promptVersion", d.prompt.Version),
+		zap.Int("promptTokens", completion.Usage.PromptTokens),
+		zap.Int("completionTokens", completion.Usage.CompletionTokens),
+		zap.Int("totalTokens", completion.Usage.TotalTokens),
 	)
 
-	result := &OpenAIApiResponse.Choices[0].Message.Content
-
-	cacheValue := []byte(*result)
-
-	err = s.cache.Set(cacheKey, cacheValue, wordCacheExpiration)
+	err = openai.ParseStructured(completion.Content(), &payload)
 	if err != nil {
-		s.logger.Warn("word synonyms cache set failed", zap.Error(err))
+		return nil, err
 	}
 
-	return result, nil
-}
-
-func (s *service) GetWordDefinition(ctx context.Context, word string, nativeLanguage string) (*string, error) {
-	cacheKey := []byte(fmt.Sprintf("%s word definition in %s", word, nativeLanguage))
+	experiment.Observe(ctx, d.prompt, completion.Usage)
 
-	cached, err := s.cache.Get(cacheKey)
-	if err == nil {
-		cachedResponse := string(cached)
-		return &cachedResponse, nil
-	}
-
-	jsonEncodedBody, err := s.wordToOpenAiDefinitionRequestBody(word, nativeLanguage)
+	raw, err := json.Marshal(&payload)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal openai request: %w", err)
+		return nil, fmt.Errorf("failed to marshal word %s: %w", d.kind, err)
 	}
 
-	resp, responseBody, err := s.openAiClient.MakeRequest(ctx, jsonEncodedBody)
+	err = s.store.Set(ctx, key, string(raw))
 	if err != nil {
-		return nil, fmt.Errorf("failed to make open ai request: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("openai api returned non-OK status response=%v statusCode=%v", resp, resp.StatusCode)
+		s.logger.Warn("word definition store set failed", zap.Error(err))
 	}
 
-	var OpenAIApiResponse openai.ChatCompletion
+	result := definitionText(word, payload.toDomain())
 
-	err = json.Unmarshal(responseBody, &OpenAIApiResponse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarhsal json body responseBody=%v : %w", responseBody, err)
-	}
-
-	if len(OpenAIApiResponse.Choices) == 0 {
-		return nil, openaierrors.ErrNoChoicesFound
+	rest := relay.flush(result)
+	if rest != "" {
+		err = onDelta(rest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to relay word definition: %w", err)
+		}
 	}
 
-	result := &OpenAIApiResponse.Choices[0].Message.Content
-
-	s.logger.Info("Successfully got word definition.",
-		zap.String("word", word),
-		zap.String("nativeLanguage", nativeLanguage),
-		zap.Int("promptTokens", OpenAIApiResponse.Usage.PromptTokens),
-		zap.Int("completionTokens", OpenAIApiResponse.Usage.CompletionTokens),
-		zap.Int("totalTokens", OpenAIApiResponse.Usage.TotalTokens),
-	)
-
-	cacheValue := []byte(*result)
+	return &result, nil
+}
 
-	err = s.cache.Set(cacheKey, cacheValue, wordCacheExpiration)
-	if err != nil {
-		s.logger.Warn("word definition cache set failed", zap.Error(err))
+// contentKey is the key the structured answer for a section is stored under, by Lookup and the endpoints
+// answering with a single section alike. The word and language are canonicalized so that spelling variants of
+// the same question share one answer, and the prompt version is part of the key so that answers to a previous
+// version of the prompt stop being served.
+func contentKey(section, word, nativeLanguage string, p *prompt.Prompt) content.Key {
+	return content.Key{
+		Kind:          "word_" + section,
+		Input:         canonical.Word(word),
+		Language:      canonical.Language(nativeLanguage),
+		PromptVersion: p.Revision(),
+		Model:         p.Model,
 	}
-
-	return result, nil
 }
 
-// todo: return actual errors and then convert to userf friendly on handler layer
+// ValidateWord returns the error of the first rule word breaks, or nil when it can be looked up.
 func (s *service) ValidateWord(word string) error {
 	if word == "" {
-		return errors.New("please provide a word")
+		return ErrEmptyWord
 	}
 
 	if utils.ContainsNumber(word) {
-		return errors.New("words should not contain numbers")
+		return ErrWordContainsDigits
 	}
 
 	if utf8.RuneCountInString(word) > 30 {
-		return errors.New("word length too long. Must be less than 30 characters.If this is a sentence, please use the analyser")
+		return ErrWordTooLong
 	}
 
 	if isNotAWord(word) {
-		return errors.New("this looks like a phrase. Please use the 'Analyzer'")
+		return ErrLooksLikePhrase
 	}
 
 	if isNonsensical(word) {
-		return errors.New("this doesn't look like a word. Please provide a valid word")
+		return ErrNonsensicalWord
 	}
 
 	return nil
 }
 
-func (s *service) wordToOpenAiHistoryRequestBody(word, userNativeLanguage string) (*bytes.Reader, error) {
-	content := fmt.Sprintf(
-		"Give me the history and origin of the word '%s', ensuring the explanation is in %s. "+
-			"(If the word is Japanese, include furigana for any kanji used, but do not mention whether it is or isn’t Japanese.)",
-		word, userNativeLanguage,
-	)
-
-	return request.JsonReader(mapToOpenAiRequest(content))
-}
-
-func (s *service) wordToOpenAiDefinitionRequestBody(word, userNativeLanguage string) (*bytes.Reader, error) {
-	content := fmt.Sprintf(
-		"Explain the meaning of '%s'. Provide 2 example sentences using the word '%s', with translations into %s.Make sure to respond in %s.",
-		word, word, userNativeLanguage, userNativeLanguage,
-	)
-
-	return request.JsonReader(mapToOpenAiRequest(content))
-}
-
-func (s *service) wordToOpenAiSynonymsRequestBody(word, userNativeLanguage string) (*bytes.Reader, error) {
-	content := fmt.Sprintf(
-		"The user has provided the word '%s'. First, detect what language this word is in. "+
-			"Then, list some simple synonyms for it in that same language. "+
-			"Respond in %s, but make sure the synonyms themselves are written in the original language of the word.",
-		word, userNativeLanguage,
-	)
+// wordPrompt renders the word prompt called name.
+func (s *service) wordPrompt(ctx context.Context, name, word, nativeLanguage string) (*prompt.Prompt, error) {
+	p, err := s.prompts.Render(ctx, name, nativeLanguage, prompt.Vars{"Word": word, "Language": languages.Normalize(nativeLanguage)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render prompt: %w", err)
+	}
 
-	return request.JsonReader(mapToOpenAiRequest(content))
+	return p, nil
 }
 
 // isNotAWord is used to check if the user is using the dictionary to define phrases as opposed to a single word
@@ -376,17 +279,3 @@ func isNonsensical(s string) bool {
 
 	return false
 }
-
-func mapToOpenAiRequest(content string) *openai.OpenAIRequest {
-	response := openai.OpenAIRequest{
-		Model:       "gpt-4o",
-		Temperature: 0.4,
-		MaxTokens:   400,
-	}
-	response.Messages = append(response.Messages,
-		openai.Message{Role: "system", Content: "You are a helpful multilingual assistant that supports users learning foreign languages."},
-		openai.Message{Role: "user", Content: content},
-	)
-
-	return &response
-}
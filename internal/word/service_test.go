@@ -3,20 +3,38 @@ package word_test
 import (
 	"context"
 	"errors"
-	"net/http"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/coocood/freecache"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap/zaptest"
 
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/cache"
 	openai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai"
+	openaierrors "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/errors"
 	mockopenai "github.com/Lionel-Wilson/My-Language-Aibou-API/internal/clients/open-ai/mock"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/content"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/prompt"
 	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
 )
 
 // TO DO: UNIT TESTS
+func newPromptRegistry(t *testing.T) prompt.Registry {
+	t.Helper()
+
+	prompts, err := prompt.NewRegistry()
+	require.NoError(t, err)
+
+	return prompts
+}
+
 func TestValidateWord(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -24,7 +42,7 @@ func TestValidateWord(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	mockCache := freecache.NewCache(1 * 1024 * 1024)
 
-	wordService := word.NewWordService(logger, mockOpenAiClient, mockCache)
+	wordService := word.NewWordService(logger, mockOpenAiClient, content.NewStore(logger, nil, cache.NewFreecache(mockCache)), newPromptRegistry(t), word.LookupModeSingle)
 
 	testCases := []struct {
 		name        string
@@ -34,33 +52,33 @@ func TestValidateWord(t *testing.T) {
 		{
 			name:        "No word provided",
 			word:        "",
-			expectedErr: errors.New("please provide a word"),
+			expectedErr: word.ErrEmptyWord,
 		},
 		{
 			name:        "Numbers are included in the word",
 			word:        "hello123",
-			expectedErr: errors.New("words should not contain numbers"),
+			expectedErr: word.ErrWordContainsDigits,
 		},
 		{
 			name:        "Word is too long",
 			word:        "superlongwordthatexceedscharactersss",
-			expectedErr: errors.New("word length too long. Must be less than 30 characters.If this is a sentence, please use the analyser"),
+			expectedErr: word.ErrWordTooLong,
 		},
 		{
 			name:        "Is not a word",
 			word:        "This is a sentence",
-			expectedErr: errors.New("this looks like a phrase. Please use the 'Analyzer'"),
+			expectedErr: word.ErrLooksLikePhrase,
 		},
 		{
 			name:        "Is Nonsensical",
 			word:        "ssssssss!!!aaa@:{P}{}",
-			expectedErr: errors.New("this doesn't look like a word. Please provide a valid word"),
+			expectedErr: word.ErrNonsensicalWord,
 		},
 	}
 
 	for _, tt := range testCases {
 		err := wordService.ValidateWord(tt.word)
-		assert.EqualError(t, err, tt.expectedErr.Error())
+		assert.ErrorIs(t, err, tt.expectedErr)
 	}
 }
 
@@ -71,7 +89,7 @@ func TestGetWordDefinition(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	mockCache := freecache.NewCache(1 * 1024 * 1024)
 
-	wordService := word.NewWordService(logger, mockOpenAiClient, mockCache)
+	wordService := word.NewWordService(logger, mockOpenAiClient, content.NewStore(logger, nil, cache.NewFreecache(mockCache)), newPromptRegistry(t), word.LookupModeSingle)
 
 	testCases := []struct {
 		name             string
@@ -88,7 +106,7 @@ func TestGetWordDefinition(t *testing.T) {
 			expectedResponse: &openai.ChatCompletion{},
 			expectedErr:      errors.New("an error"),
 			mock: func() {
-				mockOpenAiClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any()).Return(&http.Response{}, []byte{}, errors.New("an error"))
+				mockOpenAiClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Return(nil, errors.New("an error"))
 			},
 		},
 	}
@@ -108,6 +126,490 @@ func TestGetWordDefinition(t *testing.T) {
 	}
 }
 
+func TestGetWordDefinitionSharesAnswerAcrossSpellings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockOpenAiClient := mockopenai.NewMockClient(ctrl)
+	logger := zaptest.NewLogger(t)
+
+	wordService := word.NewWordService(logger, mockOpenAiClient, content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1*1024*1024))), newPromptRegistry(t), word.LookupModeSingle)
+
+	mockOpenAiClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Times(1).Return(&openai.ChatCompletion{
+		Choices: []openai.Choice{{Message: openai.Message{
+			Role:    "assistant",
+			Content: `{"partOfSpeech":"noun","readings":[],"ipa":"kæt","senses":[{"gloss":"a small feline","notes":""}],"examples":[]}`,
+		}}},
+	}, nil)
+
+	for _, request := range []struct{ word, nativeLanguage string }{
+		{word: "Cat", nativeLanguage: "English"},
+		{word: "cat", nativeLanguage: "en"},
+		{word: "ｃａｔ ", nativeLanguage: "en-GB"},
+	} {
+		definition, err := wordService.GetWordDefinition(context.Background(), request.word, request.nativeLanguage)
+		require.NoError(t, err)
+		assert.Equal(t, request.word+" /kæt/ - noun\n\n1. a small feline", *definition)
+	}
+}
+
+func TestTextEndpointsShareLookupSections(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockOpenAiClient := mockopenai.NewMockClient(ctrl)
+	logger := zaptest.NewLogger(t)
+
+	wordService := word.NewWordService(logger, mockOpenAiClient, content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1*1024*1024))), newPromptRegistry(t), word.LookupModeFanOut)
+
+	responses := map[string]string{
+		"word_definition": `{"partOfSpeech":"noun","readings":[],"ipa":"kæt","senses":[{"gloss":"a small feline","notes":"informal"}],` +
+			`"examples":[{"sentence":"The cat sleeps.","reading":"","translation":"Le chat dort."}]}`,
+		"word_synonyms":   `{"synonyms":[{"word":"kitty","reading":"","nuance":"Affectionate."},{"word":"feline","reading":"","nuance":"More formal."}]}`,
+		"word_history":    `{"origin":"Latin","explanation":"From late Latin cattus."}`,
+		"word_difficulty": `{"cefr":"A1","jlpt":"","hsk":"","rationale":"A very common everyday word."}`,
+	}
+
+	// Only the lookup requests completions, the text endpoints are served from its sections.
+	mockOpenAiClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Times(4).
+		DoAndReturn(func(_ context.Context, request *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+			return &openai.ChatCompletion{
+				Choices: []openai.Choice{{Message: openai.Message{Role: "assistant", Content: responses[request.ResponseFormat.JSONSchema.Name]}}},
+			}, nil
+		})
+
+	details, err := wordService.Lookup(context.Background(), "cat", "english")
+	require.NoError(t, err)
+	require.Empty(t, details.SectionErrors)
+
+	definition, err := wordService.GetWordDefinition(context.Background(), "cat", "english")
+	require.NoError(t, err)
+	assert.Equal(t, "cat /kæt/ - noun\n\n1. a small feline (informal)\n\n- The cat sleeps.\n  Le chat dort.", *definition)
+
+	synonyms, err := wordService.GetWordSynonyms(context.Background(), "cat", "english")
+	require.NoError(t, err)
+	assert.Equal(t, "- kitty: Affectionate.\n- feline: More formal.", *synonyms)
+
+	history, err := wordService.GetWordHistory(context.Background(), "cat", "english")
+	require.NoError(t, err)
+	assert.Equal(t, "Latin\n\nFrom late Latin cattus.", *history)
+
+	var streamed string
+
+	relayed, err := wordService.StreamWordDefinition(context.Background(), "cat", "english", func(delta string) error {
+		streamed += delta
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, *definition, *relayed)
+	assert.Equal(t, *definition, streamed)
+}
+
+func TestStreamWordDefinitionSharesTheDefinitionSection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockOpenAiClient := mockopenai.NewMockClient(ctrl)
+	logger := zaptest.NewLogger(t)
+
+	wordService := word.NewWordService(logger, mockOpenAiClient, content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1*1024*1024))), newPromptRegistry(t), word.LookupModeSingle)
+
+	const answer = `{"readings":[{"system":"romaji","value":"neko"}],"ipa":"ne̞ko̞","partOfSpeech":"noun",` +
+		`"senses":[{"gloss":"cat","notes":""},{"gloss":"shamisen","notes":"slang, \"cat skin\""}],` +
+		`"examples":[{"sentence":"猫が好きです。","reading":"neko ga suki desu","translation":"I like cats."}]}`
+
+	mockOpenAiClient.EXPECT().CreateChatCompletionStream(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, request *openai.OpenAIRequest, onDelta func(string) error) (*openai.ChatCompletion, error) {
+			assert.Equal(t, "word_definition", request.ResponseFormat.JSONSchema.Name)
+
+			for i := 0; i < len(answer); i += 7 {
+				err := onDelta(answer[i:min(i+7, len(answer))])
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return &openai.ChatCompletion{
+				Choices: []openai.Choice{{Message: openai.Message{Role: "assistant", Content: answer}}},
+			}, nil
+		})
+
+	var deltas []string
+
+	relayed, err := wordService.StreamWordDefinition(context.Background(), "猫", "english", func(delta string) error {
+		deltas = append(deltas, delta)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	expected := "猫 (romaji: neko) /ne̞ko̞/ - noun\n\n1. cat\n2. shamisen (slang, \"cat skin\")\n\n- 猫が好きです。 (neko ga suki desu)\n  I like cats."
+	assert.Equal(t, expected, *relayed)
+	assert.Equal(t, expected, strings.Join(deltas, ""))
+	assert.Greater(t, len(deltas), 3, "the definition should be relayed as it is generated")
+
+	// The streamed answer is stored under the definition section, so it is not generated again.
+	definition, err := wordService.GetWordDefinition(context.Background(), "猫", "english")
+	require.NoError(t, err)
+	assert.Equal(t, expected, *definition)
+}
+
+func TestLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockOpenAiClient := mockopenai.NewMockClient(ctrl)
+	logger := zaptest.NewLogger(t)
+
+	definition := `{"partOfSpeech":"noun","readings":[{"system":"furigana","value":"ねこ"}],"ipa":"ne̞ko̞",` +
+		`"senses":[{"gloss":"cat","notes":""}],"examples":[{"sentence":"猫が好きです。","reading":"ねこがすきです。","translation":"I like cats."}]}`
+	synonyms := `{"synonyms":[{"word":"ニャンコ","reading":"","nuance":"Cute, childlike way to say cat."}]}`
+	history := `{"origin":"Old Japanese","explanation":"Possibly imitates the sound a cat makes."}`
+	assessment := `{"cefr":"A1","jlpt":"N5","hsk":"","rationale":"One of the first animals learners meet."}`
+
+	responses := map[string]string{
+		"word_lookup":     `{"definition":` + definition + `,"synonyms":` + synonyms + `,"history":` + history + `,"difficulty":` + assessment + `}`,
+		"word_definition": definition,
+		"word_synonyms":   synonyms,
+		"word_history":    history,
+		"word_difficulty": assessment,
+	}
+
+	expectedDefinition := &domain.Definition{
+		PartOfSpeech: "noun",
+		Readings:     []domain.Reading{{System: "furigana", Value: "ねこ"}},
+		IPA:          "ne̞ko̞",
+		Senses:       []domain.Sense{{Gloss: "cat"}},
+		Examples:     []domain.Example{{Sentence: "猫が好きです。", Reading: "ねこがすきです。", Translation: "I like cats."}},
+	}
+	expectedSynonyms := []domain.Synonym{{Word: "ニャンコ", Nuance: "Cute, childlike way to say cat."}}
+	expectedHistory := &domain.Etymology{Origin: "Old Japanese", Explanation: "Possibly imitates the sound a cat makes."}
+	expectedDifficulty := &difficulty.Assessment{CEFR: "A1", JLPT: "N5", Rationale: "One of the first animals learners meet."}
+	misses := map[string]bool{
+		domain.SectionDefinition: false,
+		domain.SectionSynonyms:   false,
+		domain.SectionHistory:    false,
+		domain.SectionDifficulty: false,
+	}
+
+	testCases := []struct {
+		name           string
+		lookupMode     string
+		overrides      map[string]string
+		failing        map[string]error
+		expectedCalls  []string
+		expected       *domain.LookupDetails
+		expectedErrors map[string]error
+		expectedErr    error
+	}{
+		{
+			name:          "single call returns every section",
+			lookupMode:    word.LookupModeSingle,
+			expectedCalls: []string{"word_lookup"},
+			expected: &domain.LookupDetails{
+				Definition: expectedDefinition,
+				Synonyms:   expectedSynonyms,
+				History:    expectedHistory,
+				Difficulty: expectedDifficulty,
+				CacheHits:  misses,
+			},
+		},
+		{
+			name:       "single call falls back for an invalid section",
+			lookupMode: word.LookupModeSingle,
+			overrides: map[string]string{
+				"word_lookup": `{"definition":` + definition + `,"synonyms":` + synonyms + `,"history":{"origin":"","explanation":""},"difficulty":` + assessment + `}`,
+			},
+			expectedCalls: []string{"word_lookup", "word_history"},
+			expected: &domain.LookupDetails{
+				Definition: expectedDefinition,
+				Synonyms:   expectedSynonyms,
+				History:    expectedHistory,
+				Difficulty: expectedDifficulty,
+				CacheHits:  misses,
+			},
+		},
+		{
+			name:          "single call failure falls back to fan-out",
+			lookupMode:    word.LookupModeSingle,
+			failing:       map[string]error{"word_lookup": errors.New("timeout")},
+			expectedCalls: []string{"word_lookup", "word_definition", "word_synonyms", "word_history", "word_difficulty"},
+			expected: &domain.LookupDetails{
+				Definition: expectedDefinition,
+				Synonyms:   expectedSynonyms,
+				History:    expectedHistory,
+				Difficulty: expectedDifficulty,
+				CacheHits:  misses,
+			},
+		},
+		{
+			name:          "fan-out returns partial results",
+			lookupMode:    word.LookupModeFanOut,
+			failing:       map[string]error{"word_history": openaierrors.ErrProviderUnavailable},
+			expectedCalls: []string{"word_definition", "word_synonyms", "word_history", "word_difficulty"},
+			expected: &domain.LookupDetails{
+				Definition:    expectedDefinition,
+				Synonyms:      expectedSynonyms,
+				Difficulty:    expectedDifficulty,
+				SectionErrors: map[string]error{domain.SectionHistory: openaierrors.ErrProviderUnavailable},
+				CacheHits:     misses,
+			},
+		},
+		{
+			name:          "rejects invalid json",
+			lookupMode:    word.LookupModeFanOut,
+			overrides:     map[string]string{"word_synonyms": "Here are some synonyms: ニャンコ"},
+			expectedCalls: []string{"word_definition", "word_synonyms", "word_history", "word_difficulty"},
+			expectedErrors: map[string]error{
+				domain.SectionSynonyms: openaierrors.ErrInvalidStructuredOutput,
+			},
+		},
+		{
+			name:       "fails when every section fails",
+			lookupMode: word.LookupModeFanOut,
+			failing: map[string]error{
+				"word_definition": openaierrors.ErrProviderUnavailable,
+				"word_synonyms":   openaierrors.ErrProviderUnavailable,
+				"word_history":    openaierrors.ErrProviderUnavailable,
+				"word_difficulty": openaierrors.ErrProviderUnavailable,
+			},
+			expectedCalls: []string{"word_definition", "word_synonyms", "word_history", "word_difficulty"},
+			expectedErr:   openaierrors.ErrProviderUnavailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wordService := word.NewWordService(logger, mockOpenAiClient, content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1*1024*1024))), newPromptRegistry(t), tc.lookupMode)
+
+			var (
+				mu    sync.Mutex
+				calls []string
+			)
+
+			mockOpenAiClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Times(len(tc.expectedCalls)).
+				DoAndReturn(func(_ context.Context, request *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+					require.NotNil(t, request.ResponseFormat)
+
+					name := request.ResponseFormat.JSONSchema.Name
+
+					mu.Lock()
+					calls = append(calls, name)
+					mu.Unlock()
+
+					if err, ok := tc.failing[name]; ok {
+						return nil, err
+					}
+
+					content, ok := tc.overrides[name]
+					if !ok {
+						content = responses[name]
+					}
+
+					return &openai.ChatCompletion{
+						Choices: []openai.Choice{{Message: openai.Message{Role: "assistant", Content: content}}},
+					}, nil
+				})
+
+			details, err := wordService.Lookup(context.Background(), "猫", "english")
+
+			assert.ElementsMatch(t, tc.expectedCalls, calls)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			for section, expectedErr := range tc.expectedErrors {
+				assert.ErrorIs(t, details.SectionErrors[section], expectedErr)
+			}
+
+			if tc.expected != nil {
+				assert.Equal(t, tc.expected, details)
+			}
+		})
+	}
+}
+
+func TestLookupUsesCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockOpenAiClient := mockopenai.NewMockClient(ctrl)
+	logger := zaptest.NewLogger(t)
+
+	wordService := word.NewWordService(logger, mockOpenAiClient, content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1*1024*1024))), newPromptRegistry(t), word.LookupModeFanOut)
+
+	responses := map[string]string{
+		"word_definition": `{"partOfSpeech":"noun","readings":[],"ipa":"kæt","senses":[{"gloss":"a small feline","notes":""}],"examples":[]}`,
+		"word_synonyms":   `{"synonyms":[{"word":"kitty","reading":"","nuance":"Affectionate."}]}`,
+		"word_history":    `{"origin":"Latin","explanation":"From late Latin cattus."}`,
+		"word_difficulty": `{"cefr":"A1","jlpt":"","hsk":"","rationale":"A very common everyday word."}`,
+	}
+
+	respond := func(historyErr error) func(context.Context, *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+		return func(_ context.Context, request *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+			name := request.ResponseFormat.JSONSchema.Name
+			if name == "word_history" && historyErr != nil {
+				return nil, historyErr
+			}
+
+			return &openai.ChatCompletion{
+				Choices: []openai.Choice{{Message: openai.Message{Role: "assistant", Content: responses[name]}}},
+			}, nil
+		}
+	}
+
+	// The first lookup misses everything and history fails.
+	mockOpenAiClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Times(4).
+		DoAndReturn(respond(errors.New("timeout")))
+
+	details, err := wordService.Lookup(context.Background(), "cat", "english")
+	require.NoError(t, err)
+	assert.Contains(t, details.SectionErrors, domain.SectionHistory)
+
+	// The second one only requests the history.
+	mockOpenAiClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(respond(nil))
+
+	details, err = wordService.Lookup(context.Background(), "cat", "english")
+	require.NoError(t, err)
+	assert.Empty(t, details.SectionErrors)
+	assert.Equal(t, map[string]bool{
+		domain.SectionDefinition: true,
+		domain.SectionSynonyms:   true,
+		domain.SectionHistory:    false,
+		domain.SectionDifficulty: true,
+	}, details.CacheHits)
+
+	// The third one is served from the combined entry.
+	details, err = wordService.Lookup(context.Background(), "cat", "english")
+	require.NoError(t, err)
+	assert.Equal(t, "From late Latin cattus.", details.History.Explanation)
+	assert.Equal(t, map[string]bool{
+		domain.SectionDefinition: true,
+		domain.SectionSynonyms:   true,
+		domain.SectionHistory:    true,
+		domain.SectionDifficulty: true,
+	}, details.CacheHits)
+}
+
+func TestGetWordDifficultySharesLookupSection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockOpenAiClient := mockopenai.NewMockClient(ctrl)
+	logger := zaptest.NewLogger(t)
+
+	wordService := word.NewWordService(logger, mockOpenAiClient, content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1*1024*1024))), newPromptRegistry(t), word.LookupModeFanOut)
+
+	responses := map[string]string{
+		"word_definition": `{"partOfSpeech":"noun","readings":[],"ipa":"kæt","senses":[{"gloss":"a small feline","notes":""}],"examples":[]}`,
+		"word_synonyms":   `{"synonyms":[]}`,
+		"word_history":    `{"origin":"Latin","explanation":"From late Latin cattus."}`,
+		"word_difficulty": "```json\n" + `{"cefr":"A1","jlpt":"","hsk":"","rationale":"A very common everyday word."}` + "\n```",
+	}
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+
+	mockOpenAiClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Times(4).
+		DoAndReturn(func(_ context.Context, request *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+			name := request.ResponseFormat.JSONSchema.Name
+
+			mu.Lock()
+			calls = append(calls, name)
+			mu.Unlock()
+
+			return &openai.ChatCompletion{
+				Choices: []openai.Choice{{Message: openai.Message{Role: "assistant", Content: responses[name]}}},
+			}, nil
+		})
+
+	for range 2 {
+		assessment, err := wordService.GetWordDifficulty(context.Background(), "cat", "english")
+		require.NoError(t, err)
+		assert.Equal(t, &difficulty.Assessment{CEFR: "A1", Rationale: "A very common everyday word."}, assessment)
+	}
+
+	details, err := wordService.Lookup(context.Background(), "Cat", "en")
+	require.NoError(t, err)
+	assert.True(t, details.CacheHits[domain.SectionDifficulty])
+	assert.Equal(t, "A1", details.Difficulty.CEFR)
+	assert.Equal(t, []string{"word_difficulty"}, calls[:1])
+}
+
+func TestLookupCoalescesConcurrentLookups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockOpenAiClient := mockopenai.NewMockClient(ctrl)
+	logger := zaptest.NewLogger(t)
+
+	wordService := word.NewWordService(logger, mockOpenAiClient, content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1024*1024))), newPromptRegistry(t), word.LookupModeSingle)
+
+	release := make(chan struct{})
+
+	mockOpenAiClient.EXPECT().CreateChatCompletion(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, _ *openai.OpenAIRequest) (*openai.ChatCompletion, error) {
+			<-release
+
+			return &openai.ChatCompletion{
+				Choices: []openai.Choice{{Message: openai.Message{Role: "assistant", Content: `{` +
+					`"definition":{"partOfSpeech":"noun","readings":[],"ipa":"kæt","senses":[{"gloss":"a small feline","notes":""}],"examples":[]},` +
+					`"synonyms":{"synonyms":[]},` +
+					`"history":{"origin":"Latin","explanation":"From late Latin cattus."},` +
+					`"difficulty":{"cefr":"A1","jlpt":"","hsk":"","rationale":"A very common everyday word."}}`}}},
+			}, nil
+		})
+
+	const callers = 5
+
+	var wg sync.WaitGroup
+
+	errs := make([]error, callers)
+	results := make([]*domain.LookupDetails, callers)
+
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i], errs[i] = wordService.Lookup(context.Background(), "cat", "english")
+		}()
+	}
+
+	// Give every lookup the time to join the in-flight one before it completes.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	// Every caller gets its own copy of the shared result.
+	results[0].Definition.Senses[0].Gloss = "changed"
+	results[0].CacheHits[domain.SectionDefinition] = true
+
+	for _, details := range results[1:] {
+		assert.Equal(t, "a small feline", details.Definition.Senses[0].Gloss)
+		assert.False(t, details.CacheHits[domain.SectionDefinition])
+	}
+}
+
+func TestLookupWithFakeClient(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	wordService := word.NewWordService(logger, openai.NewFakeClient(logger), content.NewStore(logger, nil, cache.NewFreecache(freecache.NewCache(1*1024*1024))), newPromptRegistry(t), word.LookupModeSingle)
+
+	details, err := wordService.Lookup(context.Background(), "cat", "english")
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, details.Definition.Senses)
+	assert.NotEmpty(t, details.Synonyms)
+	assert.NotEmpty(t, details.History.Explanation)
+	assert.NotEmpty(t, details.Difficulty.CEFR)
+}
+
 /*
 
 func newMockConfig() *config.Config {
@@ -152,6 +654,6 @@ func GetWordSynonyms(t *testing.T) {
 
 	for _, tt := range testCases {
 		err := wordService.ValidateWord(tt.word)
-		assert.EqualError(t, err, tt.expectedErr.Error())
+		assert.ErrorIs(t, err, tt.expectedErr)
 	}
 }*/
@@ -0,0 +1,219 @@
+package word
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/difficulty"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
+)
+
+// The payloads below mirror the JSON schemas sent to the model. The validate tags catch answers that
+// are valid JSON but unusable, e.g. a definition without any sense.
+type (
+	definitionPayload struct {
+		PartOfSpeech string           `json:"partOfSpeech" validate:"required"`
+		Readings     []readingPayload `json:"readings" validate:"dive"`
+		IPA          string           `json:"ipa"`
+		Senses       []sensePayload   `json:"senses" validate:"min=1,dive"`
+		Examples     []examplePayload `json:"examples" validate:"dive"`
+	}
+
+	readingPayload struct {
+		System string `json:"system" validate:"required"`
+		Value  string `json:"value" validate:"required"`
+	}
+
+	sensePayload struct {
+		Gloss string `json:"gloss" validate:"required"`
+		Notes string `json:"notes"`
+	}
+
+	examplePayload struct {
+		Sentence    string `json:"sentence" validate:"required"`
+		Reading     string `json:"reading"`
+		Translation string `json:"translation" validate:"required"`
+	}
+
+	synonymsPayload struct {
+		Synonyms []synonymPayload `json:"synonyms" validate:"dive"`
+	}
+
+	synonymPayload struct {
+		Word    string `json:"word" validate:"required"`
+		Reading string `json:"reading"`
+		Nuance  string `json:"nuance" validate:"required"`
+	}
+
+	etymologyPayload struct {
+		Origin      string `json:"origin"`
+		Explanation string `json:"explanation" validate:"required"`
+	}
+)
+
+var (
+	readingSchema = object(
+		property{"system", str(`Name of the reading system, e.g. "furigana", "pinyin" or "romanization".`)},
+		property{"value", str("The reading of the word in that system.")},
+	)
+
+	// The definition is answered in the order it is rendered, so that it can be relayed while it is streamed.
+	definitionSchema = object(
+		property{"readings", array(readingSchema,
+			"Readings of the word. Include furigana for kanji, pinyin for Chinese and a romanization for non-latin scripts. Empty for latin scripts.")},
+		property{"ipa", str("IPA transcription of the word.")},
+		property{"partOfSpeech", str("Part of speech of the word, written in the user's language.")},
+		property{"senses", array(object(
+			property{"gloss", str("A short definition of this sense, written in the user's language.")},
+			property{"notes", str("Register or usage notes for this sense, written in the user's language. Empty if there are none.")},
+		), "The senses of the word, most common first.")},
+		property{"examples", array(object(
+			property{"sentence", str("Example sentence written in the language of the word.")},
+			property{"reading", str("Reading of the sentence for non-latin scripts (furigana, pinyin...). Empty otherwise.")},
+			property{"translation", str("Translation of the sentence into the user's language.")},
+		), "Two example sentences using the word.")},
+	)
+
+	synonymsSchema = object(
+		property{"synonyms", array(object(
+			property{"word", str("The synonym, written in the language of the looked up word.")},
+			property{"reading", str("Reading of the synonym for non-latin scripts (furigana, pinyin...). Empty otherwise.")},
+			property{"nuance", str("How the synonym differs in meaning, register or usage, written in the user's language.")},
+		), "Simple synonyms of the word.")},
+	)
+
+	etymologySchema = object(
+		property{"origin", str("The language or root the word comes from.")},
+		property{"explanation", str("The history and origin of the word, written in the user's language.")},
+	)
+)
+
+var (
+	definitionJSONSchema = mustMarshalSchema(definitionSchema)
+	synonymsJSONSchema   = mustMarshalSchema(synonymsSchema)
+	etymologyJSONSchema  = mustMarshalSchema(etymologySchema)
+	lookupJSONSchema     = mustMarshalSchema(object(
+		property{"definition", definitionSchema},
+		property{"synonyms", synonymsSchema},
+		property{"history", etymologySchema},
+		property{"difficulty", difficulty.Schema},
+	))
+)
+
+// property is a property of a JSON schema object.
+type property struct {
+	name   string
+	schema map[string]any
+}
+
+// properties marshals as a JSON object keeping the order of its properties, which models answer in.
+type properties []property
+
+func (p properties) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+
+	b.WriteByte('{')
+
+	for i, property := range p {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		name, err := json.Marshal(property.name)
+		if err != nil {
+			return nil, err
+		}
+
+		schema, err := json.Marshal(property.schema)
+		if err != nil {
+			return nil, err
+		}
+
+		b.Write(name)
+		b.WriteByte(':')
+		b.Write(schema)
+	}
+
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+// object returns a strict JSON schema object where every property is required,
+// as required by OpenAI structured outputs.
+func object(props ...property) map[string]any {
+	required := make([]string, 0, len(props))
+	for _, property := range props {
+		required = append(required, property.name)
+	}
+
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties(props),
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+func array(items map[string]any, description string) map[string]any {
+	return map[string]any{
+		"type":        "array",
+		"items":       items,
+		"description": description,
+	}
+}
+
+func str(description string) map[string]any {
+	return map[string]any{
+		"type":        "string",
+		"description": description,
+	}
+}
+
+func mustMarshalSchema(schema map[string]any) json.RawMessage {
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		panic(fmt.Sprintf("invalid json schema: %v", err))
+	}
+
+	return raw
+}
+
+func (p definitionPayload) toDomain() *domain.Definition {
+	definition := &domain.Definition{
+		PartOfSpeech: p.PartOfSpeech,
+		IPA:          p.IPA,
+	}
+
+	for _, reading := range p.Readings {
+		definition.Readings = append(definition.Readings, domain.Reading{System: reading.System, Value: reading.Value})
+	}
+
+	for _, sense := range p.Senses {
+		definition.Senses = append(definition.Senses, domain.Sense{Gloss: sense.Gloss, Notes: sense.Notes})
+	}
+
+	for _, example := range p.Examples {
+		definition.Examples = append(definition.Examples, domain.Example{
+			Sentence:    example.Sentence,
+			Reading:     example.Reading,
+			Translation: example.Translation,
+		})
+	}
+
+	return definition
+}
+
+func (p synonymsPayload) toDomain() []domain.Synonym {
+	synonyms := make([]domain.Synonym, 0, len(p.Synonyms))
+	for _, synonym := range p.Synonyms {
+		synonyms = append(synonyms, domain.Synonym{Word: synonym.Word, Reading: synonym.Reading, Nuance: synonym.Nuance})
+	}
+
+	return synonyms
+}
+
+func (p etymologyPayload) toDomain() *domain.Etymology {
+	return &domain.Etymology{Origin: p.Origin, Explanation: p.Explanation}
+}
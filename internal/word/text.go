@@ -0,0 +1,197 @@
+package word
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/internal/word/domain"
+)
+
+// definitionText renders a definition section as the text answer of the endpoints predating Lookup.
+func definitionText(word string, definition *domain.Definition) string {
+	var b strings.Builder
+
+	b.WriteString(word)
+
+	for _, reading := range definition.Readings {
+		fmt.Fprintf(&b, " (%s: %s)", reading.System, reading.Value)
+	}
+
+	if definition.IPA != "" {
+		fmt.Fprintf(&b, " /%s/", definition.IPA)
+	}
+
+	if definition.PartOfSpeech != "" {
+		fmt.Fprintf(&b, " - %s", definition.PartOfSpeech)
+	}
+
+	b.WriteString("\n")
+
+	for i, sense := range definition.Senses {
+		fmt.Fprintf(&b, "\n%d. %s", i+1, sense.Gloss)
+
+		if sense.Notes != "" {
+			fmt.Fprintf(&b, " (%s)", sense.Notes)
+		}
+	}
+
+	if len(definition.Examples) > 0 {
+		b.WriteString("\n")
+	}
+
+	for _, example := range definition.Examples {
+		fmt.Fprintf(&b, "\n- %s", example.Sentence)
+
+		if example.Reading != "" {
+			fmt.Fprintf(&b, " (%s)", example.Reading)
+		}
+
+		fmt.Fprintf(&b, "\n  %s", example.Translation)
+	}
+
+	return b.String()
+}
+
+// synonymsText renders a synonyms section as the text answer of the endpoints predating Lookup.
+func synonymsText(synonyms []domain.Synonym) string {
+	lines := make([]string, 0, len(synonyms))
+
+	for _, synonym := range synonyms {
+		line := "- " + synonym.Word
+
+		if synonym.Reading != "" {
+			line += " (" + synonym.Reading + ")"
+		}
+
+		if synonym.Nuance != "" {
+			line += ": " + synonym.Nuance
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// etymologyText renders a history section as the text answer of the endpoints predating Lookup.
+func etymologyText(etymology *domain.Etymology) string {
+	if etymology.Origin == "" {
+		return etymology.Explanation
+	}
+
+	return etymology.Origin + "\n\n" + etymology.Explanation
+}
+
+// definitionRelay renders a definition section as text while its JSON is streamed. Only the values that are
+// complete are rendered, so that the text relayed so far is always the start of the final text.
+type definitionRelay struct {
+	word string
+	raw  strings.Builder
+	sent string
+}
+
+// write adds delta to the streamed JSON and returns the text rendered since the last call, if any.
+func (r *definitionRelay) write(delta string) string {
+	r.raw.WriteString(delta)
+
+	complete, ok := completeJSON(r.raw.String())
+	if !ok {
+		return ""
+	}
+
+	var payload definitionPayload
+
+	err := json.Unmarshal([]byte(complete), &payload)
+	if err != nil {
+		return ""
+	}
+
+	// A trailing line break may be followed by more of the same line once the next value is complete.
+	return r.next(strings.TrimRight(definitionText(r.word, payload.toDomain()), "\n"))
+}
+
+// flush returns the rest of the final text.
+func (r *definitionRelay) flush(text string) string {
+	return r.next(text)
+}
+
+func (r *definitionRelay) next(text string) string {
+	if len(text) <= len(r.sent) || !strings.HasPrefix(text, r.sent) {
+		return ""
+	}
+
+	delta := text[len(r.sent):]
+	r.sent = text
+
+	return delta
+}
+
+// completeJSON cuts the start of a JSON document after its last complete member or array element, down to the
+// elements of the arrays of the document, and closes what is still open. It reports false when nothing is complete.
+func completeJSON(raw string) (string, bool) {
+	var (
+		open     []byte
+		cutOpen  []byte
+		cut      = -1
+		inString bool
+		escaped  bool
+	)
+
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '{', '[':
+			open = append(open, c)
+		case '}', ']':
+			if len(open) == 0 {
+				return "", false
+			}
+
+			open = open[:len(open)-1]
+
+			if len(open) <= 2 {
+				cut, cutOpen = i+1, slices.Clone(open)
+			}
+		case ',':
+			if len(open) <= 2 {
+				cut, cutOpen = i, slices.Clone(open)
+			}
+		}
+	}
+
+	if cut == -1 {
+		return "", false
+	}
+
+	var b strings.Builder
+
+	b.WriteString(raw[:cut])
+
+	for i := len(cutOpen) - 1; i >= 0; i-- {
+		if cutOpen[i] == '{' {
+			b.WriteByte('}')
+		} else {
+			b.WriteByte(']')
+		}
+	}
+
+	return b.String(), true
+}
@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- Stores every piece of AI generated content (definitions, synonyms, histories, explanations, corrections...)
+CREATE TABLE generated_contents (
+                                    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                    kind VARCHAR(100) NOT NULL, -- e.g., 'word_definition', 'sentence_correction'
+                                    input TEXT NOT NULL, -- the normalized word or sentence
+                                    language VARCHAR(100) NOT NULL, -- the language the content is written in
+                                    prompt_version VARCHAR(50) NOT NULL,
+                                    model VARCHAR(100) NOT NULL,
+                                    content TEXT NOT NULL,
+                                    created_at TIMESTAMP NOT NULL DEFAULT now(),
+                                    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+                                    CONSTRAINT uq_generated_content_key
+                                        UNIQUE (kind, input, language, prompt_version, model)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS generated_contents;
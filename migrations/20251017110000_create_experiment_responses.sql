@@ -0,0 +1,29 @@
+-- +goose Up
+
+-- One row per response served by a variant of a prompt experiment, with the feedback given on it.
+CREATE TABLE experiment_responses (
+                                      id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                      experiment VARCHAR(100) NOT NULL,
+                                      variant VARCHAR(100) NOT NULL,
+                                      prompt_id VARCHAR(100) NOT NULL,
+                                      prompt_version VARCHAR(50) NOT NULL,
+                                      user_id UUID, -- NULL for anonymous requests
+                                      session_id VARCHAR(100), -- device ID of anonymous requests, only they can rate the response
+                                      prompt_tokens INTEGER NOT NULL DEFAULT 0, -- 0 when served from the cache
+                                      completion_tokens INTEGER NOT NULL DEFAULT 0,
+                                      total_tokens INTEGER NOT NULL DEFAULT 0,
+                                      rating SMALLINT, -- 1 thumbs up, -1 thumbs down, NULL without feedback
+                                      created_at TIMESTAMP NOT NULL DEFAULT now(),
+                                      updated_at TIMESTAMP NOT NULL DEFAULT now(),
+                                      CONSTRAINT fk_experiment_response_user
+                                          FOREIGN KEY(user_id)
+                                              REFERENCES users(id)
+                                              ON DELETE SET NULL,
+                                      CONSTRAINT chk_experiment_response_rating
+                                          CHECK (rating IN (-1, 1))
+);
+
+CREATE INDEX idx_experiment_responses_experiment ON experiment_responses (experiment, variant);
+
+-- +goose Down
+DROP TABLE IF EXISTS experiment_responses;
@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- Tokens spent on AI completions per user, endpoint and day (UTC).
+CREATE TABLE token_usages (
+                              user_id UUID NOT NULL,
+                              endpoint VARCHAR(200) NOT NULL, -- the route pattern, e.g. '/api/v3/word/definition'
+                              day DATE NOT NULL,
+                              requests INTEGER NOT NULL DEFAULT 0,
+                              prompt_tokens INTEGER NOT NULL DEFAULT 0,
+                              completion_tokens INTEGER NOT NULL DEFAULT 0,
+                              total_tokens INTEGER NOT NULL DEFAULT 0,
+                              created_at TIMESTAMP NOT NULL DEFAULT now(),
+                              updated_at TIMESTAMP NOT NULL DEFAULT now(),
+                              PRIMARY KEY (user_id, endpoint, day),
+                              CONSTRAINT fk_token_usage_user
+                                  FOREIGN KEY(user_id)
+                                      REFERENCES users(id)
+                                      ON DELETE CASCADE
+);
+
+CREATE INDEX idx_token_usages_user_day ON token_usages (user_id, day);
+
+-- +goose Down
+DROP TABLE IF EXISTS token_usages;
@@ -0,0 +1,22 @@
+-- +goose Up
+
+-- One row per word or sentence a signed-in user looked up, with the answer they were given.
+CREATE TABLE lookup_histories (
+                                  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                  user_id UUID NOT NULL,
+                                  kind VARCHAR(100) NOT NULL, -- e.g., 'word_definition', 'sentence_correction'
+                                  query TEXT NOT NULL, -- the word or sentence as it was sent
+                                  native_language VARCHAR(100) NOT NULL,
+                                  result TEXT NOT NULL, -- the JSON body of the response
+                                  created_at TIMESTAMP NOT NULL DEFAULT now(),
+                                  updated_at TIMESTAMP NOT NULL DEFAULT now(),
+                                  CONSTRAINT fk_lookup_history_user
+                                      FOREIGN KEY(user_id)
+                                          REFERENCES users(id)
+                                          ON DELETE CASCADE
+);
+
+CREATE INDEX idx_lookup_histories_user_created_at ON lookup_histories (user_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS lookup_histories;
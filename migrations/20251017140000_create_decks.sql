@@ -0,0 +1,41 @@
+-- +goose Up
+
+-- Named collections of words a user saved, e.g. 'JLPT N3'.
+CREATE TABLE decks (
+                       id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                       user_id UUID NOT NULL,
+                       name VARCHAR(100) NOT NULL,
+                       created_at TIMESTAMP NOT NULL DEFAULT now(),
+                       updated_at TIMESTAMP NOT NULL DEFAULT now(),
+                       CONSTRAINT fk_deck_user
+                           FOREIGN KEY(user_id)
+                               REFERENCES users(id)
+                               ON DELETE CASCADE,
+                       CONSTRAINT uq_deck_user_name
+                           UNIQUE (user_id, name)
+);
+
+-- The words of a deck, with their lookup as it was when they were saved.
+CREATE TABLE deck_entries (
+                              id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                              deck_id UUID NOT NULL,
+                              word TEXT NOT NULL, -- as the user spelled it
+                              canonical_word TEXT NOT NULL, -- canonical.Word of word, so that spellings of a word are one entry
+                              native_language VARCHAR(100) NOT NULL,
+                              details TEXT NOT NULL, -- the word.LookupDetails, as JSON
+                              position INTEGER NOT NULL, -- the order of the entry in the deck, from 1
+                              created_at TIMESTAMP NOT NULL DEFAULT now(),
+                              updated_at TIMESTAMP NOT NULL DEFAULT now(),
+                              CONSTRAINT fk_deck_entry_deck
+                                  FOREIGN KEY(deck_id)
+                                      REFERENCES decks(id)
+                                      ON DELETE CASCADE,
+                              CONSTRAINT uq_deck_entry_word
+                                  UNIQUE (deck_id, canonical_word, native_language)
+);
+
+CREATE INDEX idx_deck_entries_deck_position ON deck_entries (deck_id, position);
+
+-- +goose Down
+DROP TABLE IF EXISTS deck_entries;
+DROP TABLE IF EXISTS decks;
@@ -0,0 +1,51 @@
+-- +goose Up
+
+-- The review schedule of each deck entry. Entries without a card have never been reviewed and are due now.
+CREATE TABLE review_cards (
+                              deck_entry_id UUID PRIMARY KEY,
+                              scheduler VARCHAR(20) NOT NULL, -- the srs scheduler that computed the state, 'sm2' or 'fsrs'
+                              repetitions INTEGER NOT NULL, -- successful reviews since the last lapse
+                              lapses INTEGER NOT NULL,
+                              ease_factor DOUBLE PRECISION NOT NULL, -- SM-2
+                              stability DOUBLE PRECISION NOT NULL, -- FSRS, in days
+                              difficulty DOUBLE PRECISION NOT NULL, -- FSRS, from 1 to 10
+                              interval_days INTEGER NOT NULL,
+                              due_at TIMESTAMP NOT NULL,
+                              last_reviewed_at TIMESTAMP NOT NULL,
+                              created_at TIMESTAMP NOT NULL DEFAULT now(),
+                              updated_at TIMESTAMP NOT NULL DEFAULT now(),
+                              CONSTRAINT fk_review_card_deck_entry
+                                  FOREIGN KEY(deck_entry_id)
+                                      REFERENCES deck_entries(id)
+                                      ON DELETE CASCADE
+);
+
+CREATE INDEX idx_review_cards_due_at ON review_cards (due_at);
+
+-- Every grade a user gave, with the schedule it led to.
+CREATE TABLE review_logs (
+                             id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                             deck_entry_id UUID NOT NULL,
+                             user_id UUID NOT NULL,
+                             scheduler VARCHAR(20) NOT NULL,
+                             rating SMALLINT NOT NULL, -- 1 again, 2 hard, 3 good, 4 easy
+                             elapsed_days INTEGER NOT NULL, -- days since the previous review, 0 for the first one
+                             interval_days INTEGER NOT NULL, -- days until the next review
+                             due_at TIMESTAMP NOT NULL, -- the next review
+                             reviewed_at TIMESTAMP NOT NULL,
+                             created_at TIMESTAMP NOT NULL DEFAULT now(),
+                             CONSTRAINT fk_review_log_deck_entry
+                                 FOREIGN KEY(deck_entry_id)
+                                     REFERENCES deck_entries(id)
+                                     ON DELETE CASCADE,
+                             CONSTRAINT fk_review_log_user
+                                 FOREIGN KEY(user_id)
+                                     REFERENCES users(id)
+                                     ON DELETE CASCADE
+);
+
+CREATE INDEX idx_review_logs_user_reviewed_at ON review_logs (user_id, reviewed_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS review_logs;
+DROP TABLE IF EXISTS review_cards;
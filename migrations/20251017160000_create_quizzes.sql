@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- Quizzes generated from the words a user saved, with their answers once submitted.
+CREATE TABLE quizzes (
+                         id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                         user_id UUID NOT NULL,
+                         native_language VARCHAR(100) NOT NULL,
+                         questions TEXT NOT NULL, -- the quiz.Question list, with the correct options, as JSON
+                         question_count INTEGER NOT NULL,
+                         answers TEXT, -- the chosen option of each question, -1 when unanswered, as JSON
+                         correct_count INTEGER,
+                         submitted_at TIMESTAMP,
+                         created_at TIMESTAMP NOT NULL DEFAULT now(),
+                         updated_at TIMESTAMP NOT NULL DEFAULT now(),
+                         CONSTRAINT fk_quiz_user
+                             FOREIGN KEY(user_id)
+                                 REFERENCES users(id)
+                                 ON DELETE CASCADE
+);
+
+CREATE INDEX idx_quizzes_user_created_at ON quizzes (user_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS quizzes;
@@ -0,0 +1,32 @@
+-- +goose Up
+
+-- Word lists being added to a deck in the background, one lookup per word.
+CREATE TABLE import_jobs (
+                             id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                             user_id UUID NOT NULL,
+                             deck_id UUID NOT NULL,
+                             native_language VARCHAR(100) NOT NULL,
+                             status VARCHAR(20) NOT NULL, -- 'running', 'done' or 'failed'
+                             total INTEGER NOT NULL,
+                             imported INTEGER NOT NULL DEFAULT 0,
+                             skipped INTEGER NOT NULL DEFAULT 0, -- words already in the deck
+                             failed INTEGER NOT NULL DEFAULT 0,
+                             failures TEXT NOT NULL DEFAULT '[]', -- the words that failed with the reason, as JSON
+                             finished_at TIMESTAMP,
+                             created_at TIMESTAMP NOT NULL DEFAULT now(),
+                             updated_at TIMESTAMP NOT NULL DEFAULT now(),
+                             CONSTRAINT fk_import_job_user
+                                 FOREIGN KEY(user_id)
+                                     REFERENCES users(id)
+                                     ON DELETE CASCADE,
+                             CONSTRAINT fk_import_job_deck
+                                 FOREIGN KEY(deck_id)
+                                     REFERENCES decks(id)
+                                     ON DELETE CASCADE
+);
+
+-- A user runs one import at a time.
+CREATE UNIQUE INDEX import_jobs_one_running_per_user ON import_jobs (user_id) WHERE status = 'running';
+
+-- +goose Down
+DROP TABLE IF EXISTS import_jobs;
@@ -2,5 +2,6 @@ package messages
 
 // Author-friendly messages sent to the FE
 const (
-	InternalServerErrorMsg = "Something went wrong that is not your fault. Please try again later."
+	InternalServerErrorMsg   = "Something went wrong that is not your fault. Please try again later."
+	AIProviderUnavailableMsg = "Our AI partner is temporarily unavailable. Please try again in a few moments."
 )
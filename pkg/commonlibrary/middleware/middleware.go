@@ -82,46 +82,92 @@ func AuthMiddlewareString(jwtSecret []byte) func(http.Handler) http.Handler {
 				return
 			}
 
-			// 2. Expect header in the format: "Bearer <token>"
-			parts := strings.SplitN(authHeader, " ", 2)
-			if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
-				http.Error(w, "Invalid Authorization header format", http.StatusUnauthorized)
+			// 2. Parse the token and extract the string user_id
+			uid, message := userIDString(authHeader, jwtSecret)
+			if message != "" {
+				http.Error(w, message, http.StatusUnauthorized)
 				return
 			}
 
-			tokenString := parts[1]
+			// 3. Set string userID in context
+			ctx := context.SetUserIDString(r.Context(), uid)
 
-			// 3. Parse and validate the JWT token
-			token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-					return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-				}
+			// 4. Proceed to next handler
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
 
-				return jwtSecret, nil
-			})
-			if err != nil || !token.Valid {
-				http.Error(w, "Invalid token", http.StatusUnauthorized)
-				return
+// OptionalAuthMiddlewareString is AuthMiddlewareString for endpoints that are also open to anonymous callers:
+// the user ID is set in the context only when the request carries a valid token. Requests without one, including
+// those with an invalid or expired token, are let through as anonymous rather than refused.
+func OptionalAuthMiddlewareString(jwtSecret []byte) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if authHeader := r.Header.Get("Authorization"); authHeader != "" {
+				if uid, message := userIDString(authHeader, jwtSecret); message == "" {
+					r = r.WithContext(context.SetUserIDString(r.Context(), uid))
+				}
 			}
 
-			// 4. Extract user_id from token claims as string
-			claims, ok := token.Claims.(jwt.MapClaims)
-			if !ok {
-				http.Error(w, "Invalid token claims", http.StatusUnauthorized)
-				return
-			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// AdminMiddlewareString only lets through the users of adminUserIDs. It must run after AuthMiddlewareString,
+// and refuses every other caller with a 403.
+func AdminMiddlewareString(adminUserIDs []string) func(http.Handler) http.Handler {
+	admins := make(map[string]bool, len(adminUserIDs))
+	for _, uid := range adminUserIDs {
+		admins[uid] = true
+	}
 
-			uid, ok := claims["user_id"].(string)
-			if !ok || uid == "" {
-				http.Error(w, "user_id not found or not a string in token", http.StatusUnauthorized)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			uid, err := context.GetUserIDString(r.Context())
+			if err != nil || !admins[uid] {
+				http.Error(w, "Forbidden", http.StatusForbidden)
 				return
 			}
 
-			// 5. Set string userID in context
-			ctx := context.SetUserIDString(r.Context(), uid)
-
-			// 6. Proceed to next handler
-			next.ServeHTTP(w, r.WithContext(ctx))
+			next.ServeHTTP(w, r)
 		})
 	}
 }
+
+// userIDString returns the string user_id of the "Bearer <token>" authHeader, or why it has none.
+func userIDString(authHeader string, jwtSecret []byte) (string, string) {
+	// Expect header in the format: "Bearer <token>"
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return "", "Invalid Authorization header format"
+	}
+
+	tokenString := parts[1]
+
+	// Parse and validate the JWT token
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return jwtSecret, nil
+	})
+	if err != nil || !token.Valid {
+		return "", "Invalid token"
+	}
+
+	// Extract user_id from token claims as string
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return "", "Invalid token claims"
+	}
+
+	uid, ok := claims["user_id"].(string)
+	if !ok || uid == "" {
+		return "", "user_id not found or not a string in token"
+	}
+
+	return uid, ""
+}
@@ -0,0 +1,109 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/context"
+	"github.com/Lionel-Wilson/My-Language-Aibou-API/pkg/commonlibrary/middleware"
+)
+
+var jwtSecret = []byte("secret")
+
+func signedToken(t *testing.T, secret []byte, expiresAt time.Time) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": "user-1",
+		"exp":     expiresAt.Unix(),
+	}).SignedString(secret)
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestOptionalAuthMiddlewareString(t *testing.T) {
+	testCases := map[string]struct {
+		authHeader string
+		wantUserID string
+	}{
+		"valid token":           {"Bearer " + signedToken(t, jwtSecret, time.Now().Add(time.Hour)), "user-1"},
+		"no token":              {"", ""},
+		"expired token":         {"Bearer " + signedToken(t, jwtSecret, time.Now().Add(-time.Hour)), ""},
+		"token of other secret": {"Bearer " + signedToken(t, []byte("other"), time.Now().Add(time.Hour)), ""},
+		"malformed header":      {"Token abc", ""},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			handler := middleware.OptionalAuthMiddlewareString(jwtSecret)(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					called = true
+					userID, _ := context.GetUserIDString(r.Context())
+					assert.Equal(t, tc.wantUserID, userID)
+				},
+			))
+
+			r := httptest.NewRequest(http.MethodPost, "/api/v1/search/word", nil)
+			if tc.authHeader != "" {
+				r.Header.Set("Authorization", tc.authHeader)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			assert.True(t, called, "the request must reach the handler as anonymous")
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
+
+func TestAdminMiddlewareString(t *testing.T) {
+	testCases := map[string]struct {
+		authHeader string
+		wantStatus int
+	}{
+		"admin":         {"Bearer " + signedToken(t, jwtSecret, time.Now().Add(time.Hour)), http.StatusOK},
+		"no token":      {"", http.StatusUnauthorized},
+		"expired token": {"Bearer " + signedToken(t, jwtSecret, time.Now().Add(-time.Hour)), http.StatusUnauthorized},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			handler := middleware.AuthMiddlewareString(jwtSecret)(middleware.AdminMiddlewareString([]string{"user-1"})(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {},
+			)))
+
+			r := httptest.NewRequest(http.MethodGet, "/api/v4/experiments/word_definition_concise/results", nil)
+			if tc.authHeader != "" {
+				r.Header.Set("Authorization", tc.authHeader)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+		})
+	}
+
+	t.Run("signed in user", func(t *testing.T) {
+		handler := middleware.AuthMiddlewareString(jwtSecret)(middleware.AdminMiddlewareString([]string{"user-2"})(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				t.Error("the request must not reach the handler")
+			},
+		)))
+
+		r := httptest.NewRequest(http.MethodGet, "/api/v4/experiments/word_definition_concise/results", nil)
+		r.Header.Set("Authorization", "Bearer "+signedToken(t, jwtSecret, time.Now().Add(time.Hour)))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -0,0 +1,63 @@
+package render
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const contentTypeEventStream = "text/event-stream"
+
+var ErrStreamingUnsupported = errors.New("response writer does not support streaming")
+
+// EventStream writes Server-Sent Events. The response headers are only sent with the first event,
+// so a handler can still answer with a regular JSON error until it has started streaming.
+type EventStream struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	started bool
+}
+
+func NewEventStream(w http.ResponseWriter) (*EventStream, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, ErrStreamingUnsupported
+	}
+
+	return &EventStream{w: w, flusher: flusher}, nil
+}
+
+// Started reports whether the first event, and with it the response headers, has been written.
+func (s *EventStream) Started() bool {
+	return s.started
+}
+
+// Event writes a named event whose data is the JSON encoding of payload and flushes it to the client.
+func (s *EventStream) Event(name string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s event: %w", name, err)
+	}
+
+	if !s.started {
+		header := s.w.Header()
+		header.Set(headerContentType, contentTypeEventStream)
+		header.Set("Cache-Control", "no-cache")
+		header.Set("Connection", "keep-alive")
+		// Stops nginx style proxies from buffering the stream.
+		header.Set("X-Accel-Buffering", "no")
+
+		s.w.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+
+	_, err = fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", name, data)
+	if err != nil {
+		return fmt.Errorf("failed to write %s event: %w", name, err)
+	}
+
+	s.flusher.Flush()
+
+	return nil
+}